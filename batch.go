@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"strconv"
+)
+
+// pendingBatch holds the values of the fields to validate with a batch validator and the same arguments.
+type pendingBatch struct {
+	validate core.BatchValidatorFn
+	method   *parser.Method
+	fields   []*core.ReflectedField
+	values   []interface{}
+	reports  []*ValidatorReport
+}
+
+// deferredValue is the value of a field to add to a batch once the validator group of the field has passed.
+type deferredValue struct {
+	validate core.BatchValidatorFn
+	method   *parser.Method
+	value    interface{}
+	report   *ValidatorReport
+}
+
+// deferBatch adds the value of a field to the batch of the validator and its arguments, to be run by runBatches.
+func (this *context) deferBatch(value deferredValue, field *core.ReflectedField) {
+	key := value.method.String()
+
+	if this.batchIndex == nil {
+		this.batchIndex = make(map[string]*pendingBatch)
+	}
+
+	batch, ok := this.batchIndex[key]
+
+	if !ok {
+		batch = &pendingBatch{validate: value.validate, method: value.method}
+		this.batchIndex[key] = batch
+		this.batches = append(this.batches, batch)
+	}
+
+	batch.fields = append(batch.fields, field)
+	batch.values = append(batch.values, value.value)
+	batch.reports = append(batch.reports, value.report)
+}
+
+// runBatches runs each pending batch once, in the order in which the batches were first deferred, and adds an error
+// for each value that failed.
+func (this *context) runBatches() {
+	batches := this.batches
+	this.batches = nil
+	this.batchIndex = nil
+
+	for _, batch := range batches {
+		if this.isDone() {
+			return
+		}
+
+		errs, err := batch.validate(this.ctx, batch.values, batch.method.Arguments)
+
+		if err == nil && len(errs) != len(batch.values) {
+			err = errors.New("Batch validator '" + batch.method.Name + "' returned " + strconv.Itoa(len(errs)) + " errors for " + strconv.Itoa(len(batch.values)) + " values.")
+		}
+
+		for i, field := range batch.fields {
+			valueErr := err
+
+			if valueErr == nil {
+				valueErr = errs[i]
+			}
+
+			if messageErr, ok := valueErr.(*core.MessageError); ok {
+				valueErr = core.NewMessageError(this.translator, messageErr.Key, messageErr.Args...)
+			}
+
+			if valueErr != nil {
+				fieldErr := core.NewError(field, batch.method, valueErr)
+				fieldErr.SetValue(batch.values[i])
+				batch.reports[i].fail(fieldErr)
+				this.errors.AddMany(this.sampler.sample(core.ErrorList{fieldErr}))
+			} else {
+				batch.reports[i].pass()
+			}
+		}
+	}
+}
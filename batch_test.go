@@ -0,0 +1,142 @@
+package validator_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/validators"
+	"strconv"
+	"testing"
+)
+
+type batchTestUser struct {
+	Email string `validate:"not_empty,unique(users)"`
+}
+
+func newBatchTestValidator(calls *int, batches *[][]interface{}) Validator {
+	validator := New()
+
+	validator.RegisterBatch("unique", validators.UniqueValidator(func(ctx context.Context, values []interface{}, args []interface{}) ([]bool, error) {
+		*calls++
+		*batches = append(*batches, values)
+		existing := make([]bool, len(values))
+		for i, value := range values {
+			existing[i] = value == "taken@doe.com"
+		}
+		return existing, nil
+	}))
+
+	return validator
+}
+
+func TestThatBatchValidatorsAreCalledOnceForAllValues(t *testing.T) {
+	var calls int
+	var batches [][]interface{}
+
+	validator := newBatchTestValidator(&calls, &batches)
+
+	users := make([]batchTestUser, 1000)
+
+	for i := range users {
+		users[i].Email = "user" + strconv.Itoa(i) + "@doe.com"
+	}
+
+	if errs := validator.Validate(users); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d.", calls)
+	}
+
+	if len(batches[0]) != 1000 {
+		t.Fatalf("Expected 1000 values, got %d.", len(batches[0]))
+	}
+}
+
+func TestThatBatchValidatorErrorsAreAttributedToFields(t *testing.T) {
+	var calls int
+	var batches [][]interface{}
+
+	validator := newBatchTestValidator(&calls, &batches)
+
+	users := []*batchTestUser{{Email: "john@doe.com"}, {Email: "taken@doe.com"}, {Email: "john@doe.com"}}
+
+	errs := validator.Validate(users)
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	if name := errs.First().GetValidatorName(); name != "unique" {
+		t.Fatalf("Expected error to be attributed to 'unique', got '%s'.", name)
+	}
+
+	if expectedErr := "Email must be unique."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+
+	if value := errs.First().Value(); value != "taken@doe.com" {
+		t.Fatalf("Expected value 'taken@doe.com', got '%v'.", value)
+	}
+}
+
+func TestThatBatchValidatorsSkipValuesThatFailOtherValidators(t *testing.T) {
+	var calls int
+	var batches [][]interface{}
+
+	validator := newBatchTestValidator(&calls, &batches)
+
+	errs := validator.Validate([]*batchTestUser{{Email: ""}, {Email: "john@doe.com"}})
+
+	if errs.Length() != 1 || errs.First().GetValidatorName() != "not_empty" {
+		t.Fatalf("Expected 1 error of 'not_empty', got %d errors.", errs.Length())
+	}
+
+	if len(batches) != 1 || len(batches[0]) != 1 {
+		t.Fatalf("Expected a batch of 1 value, got %v.", batches)
+	}
+}
+
+func TestThatBatchValidatorsAreBatchedByArguments(t *testing.T) {
+	var calls int
+	var batches [][]interface{}
+
+	validator := newBatchTestValidator(&calls, &batches)
+
+	type Dummy struct {
+		Email    string `validate:"unique(users)"`
+		Username string `validate:"unique(accounts)"`
+	}
+
+	if errs := validator.Validate([]*Dummy{{Email: "a", Username: "b"}, {Email: "c", Username: "d"}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls, got %d.", calls)
+	}
+}
+
+func TestThatBatchValidatorFailuresFailAllValues(t *testing.T) {
+	validator := New()
+
+	validator.RegisterBatch("unique", func(ctx context.Context, values []interface{}, args []interface{}) ([]error, error) {
+		return nil, errors.New("Unable to connect.")
+	})
+
+	if errs := validator.Validate([]*batchTestUser{{Email: "a"}, {Email: "b"}}); errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+}
+
+func TestThatBatchValidatorsAreRunByValidateValue(t *testing.T) {
+	var calls int
+	var batches [][]interface{}
+
+	validator := newBatchTestValidator(&calls, &batches)
+
+	if err := validator.ValidateValue("taken@doe.com", "unique(users)"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
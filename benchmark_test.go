@@ -0,0 +1,87 @@
+package validator_test
+
+import (
+	"github.com/typerandom/validator"
+	"testing"
+)
+
+type benchmarkStruct struct {
+	String1  string  `validate:"not_empty,min(2),max(20)"`
+	String2  string  `validate:"not_empty,min(2),max(20)"`
+	String3  string  `validate:"not_empty,min(2),max(20)"`
+	String4  string  `validate:"not_empty,min(2),max(20)"`
+	String5  string  `validate:"not_empty,min(2),max(20)"`
+	Email1   string  `validate:"empty|email"`
+	Email2   string  `validate:"empty|email"`
+	Int1     int     `validate:"min(1),max(100)"`
+	Int2     int     `validate:"min(1),max(100)"`
+	Int3     int     `validate:"min(1),max(100)"`
+	Int4     int64   `validate:"min(1),max(100)"`
+	Int5     int64   `validate:"min(1),max(100)"`
+	Uint1    uint    `validate:"min(1),max(100)"`
+	Uint2    uint    `validate:"min(1),max(100)"`
+	Float1   float64 `validate:"min(1),max(100)"`
+	Float2   float64 `validate:"min(1),max(100)"`
+	Pointer1 *string `validate:"not_empty"`
+	Pointer2 *int    `validate:"min(1)"`
+	Bool1    bool
+	Bool2    bool
+}
+
+func newBenchmarkStruct() *benchmarkStruct {
+	value := "value"
+	number := 10
+
+	return &benchmarkStruct{
+		String1:  "value",
+		String2:  "value",
+		String3:  "value",
+		String4:  "value",
+		String5:  "value",
+		Email1:   "",
+		Email2:   "john@example.com",
+		Int1:     10,
+		Int2:     10,
+		Int3:     10,
+		Int4:     10,
+		Int5:     10,
+		Uint1:    10,
+		Uint2:    10,
+		Float1:   10,
+		Float2:   10,
+		Pointer1: &value,
+		Pointer2: &number,
+	}
+}
+
+func BenchmarkValidateValidStruct(b *testing.B) {
+	value := newBenchmarkStruct()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if errs := validator.Validate(value); errs.Any() {
+			b.Fatalf("Didn't expect errors, got %s.", errs)
+		}
+	}
+}
+
+func BenchmarkValidateInvalidStruct(b *testing.B) {
+	value := &benchmarkStruct{}
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if errs := validator.Validate(value); !errs.Any() {
+			b.Fatalf("Expected errors, got none.")
+		}
+	}
+}
+
+func BenchmarkValidateValue(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if err := validator.ValidateValue("value", "not_empty,min(2),max(20)"); err != nil {
+			b.Fatalf("Didn't expect error, got %s.", err)
+		}
+	}
+}
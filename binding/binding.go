@@ -0,0 +1,153 @@
+// Package binding decodes HTTP requests into structures and validates them.
+package binding
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"mime"
+	"net/http"
+	"reflect"
+)
+
+// Error is returned when a request cannot be bound or is invalid. It's ready to be written as a response with Write.
+type Error struct {
+	// StatusCode is the HTTP status code of the response, i.e. http.StatusUnprocessableEntity for validation errors.
+	StatusCode int `json:"-"`
+	// Errors are the errors of the request.
+	Errors core.ErrorList `json:"errors"`
+}
+
+func newError(statusCode int, err error) *Error {
+	var errs core.ErrorList
+	errs.AddPlain(err)
+
+	return &Error{
+		StatusCode: statusCode,
+		Errors:     errs,
+	}
+}
+
+func (this *Error) Error() string {
+	return this.Errors.Error()
+}
+
+// Unwrap returns the errors of the request, so that errors.Is and errors.As can be used to find them.
+func (this *Error) Unwrap() error {
+	return this.Errors
+}
+
+// Write writes the errors as JSON with the status code of the error, i.e. `{"errors":[...]}`.
+func (this *Error) Write(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(this.StatusCode)
+	return json.NewEncoder(w).Encode(this)
+}
+
+// BindAndValidate decodes the body of the request into dst, which must be a pointer to a struct, and validates it
+// with the default validator. JSON and form bodies are supported. Requests without a body are bound from the query,
+// and fields with a `query` tag are bound from the query of any request. Returns *Error if the request cannot be
+// decoded (400 or 415) or is invalid (422). Values that can't be converted to the type of their field are reported
+// as errors of the field, with the errors of the other fields, and the rules of the field are not validated.
+func BindAndValidate(r *http.Request, dst interface{}, options ...validator.Option) error {
+	return BindAndValidateWith(validator.Default(), r, dst, options...)
+}
+
+// BindAndValidateWith decodes the request into dst like BindAndValidate, and validates it with v.
+func BindAndValidateWith(v validator.Validator, r *http.Request, dst interface{}, options ...validator.Option) error {
+	conversionErrs, err := bind(v, r, dst)
+
+	if err != nil {
+		return err
+	}
+
+	errs := v.ValidateCtx(r.Context(), dst, options...)
+
+	if conversionErrs.Any() {
+		converted := conversionErrs
+
+		for _, err := range errs {
+			if !err.IsFieldError() || conversionErrs.WithField(err.GetFieldName()).Length() == 0 {
+				converted.Add(err)
+			}
+		}
+
+		errs = converted
+	}
+
+	if errs.Any() {
+		return &Error{
+			StatusCode: http.StatusUnprocessableEntity,
+			Errors:     errs,
+		}
+	}
+
+	return nil
+}
+
+// Bind decodes the request into dst without validating it. Returns *Error if it cannot be decoded, or if values can't
+// be converted to the types of their fields.
+func Bind(r *http.Request, dst interface{}) error {
+	conversionErrs, err := bind(validator.Default(), r, dst)
+
+	if err != nil {
+		return err
+	}
+
+	if conversionErrs.Any() {
+		return &Error{
+			StatusCode: http.StatusBadRequest,
+			Errors:     conversionErrs,
+		}
+	}
+
+	return nil
+}
+
+// bind decodes the request into dst. Values that can't be converted to the types of their fields are returned as
+// errors of the fields, with the messages of the locale of v.
+func bind(v validator.Validator, r *http.Request, dst interface{}) (core.ErrorList, error) {
+	if value := reflect.ValueOf(dst); value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, newError(http.StatusInternalServerError, errors.New("Unable to bind request to non struct pointer."))
+	}
+
+	errs, err := bindBody(v, r, dst)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests without a body are bound from the query already, so fields are only reported once.
+	for _, queryErr := range bindQuery(v, r, dst) {
+		if errs.WithField(queryErr.GetFieldName()).Length() == 0 {
+			errs.Add(queryErr)
+		}
+	}
+
+	return errs, nil
+}
+
+func bindBody(v validator.Validator, r *http.Request, dst interface{}) (core.ErrorList, error) {
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		return bindForm(v, r, dst)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	if err != nil {
+		return nil, newError(http.StatusUnsupportedMediaType, errors.New("Unable to parse content type of request."))
+	}
+
+	switch mediaType {
+	case "application/json":
+		if err := json.NewDecoder(r.Body).Decode(dst); err != nil {
+			return nil, newError(http.StatusBadRequest, errors.New("Unable to decode JSON body of request. "+err.Error()))
+		}
+		return nil, nil
+	case "application/x-www-form-urlencoded", "multipart/form-data":
+		return bindForm(v, r, dst)
+	}
+
+	return nil, newError(http.StatusUnsupportedMediaType, errors.New("Content type '"+mediaType+"' is not supported."))
+}
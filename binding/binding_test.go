@@ -0,0 +1,206 @@
+package binding_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	. "github.com/typerandom/validator/binding"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type bindingUser struct {
+	Name string   `json:"name" form:"name" validate:"not_empty,min(3)"`
+	Age  int      `json:"age" form:"age" validate:"min(18)"`
+	Tags []string `json:"tags" form:"tag"`
+}
+
+func newJSONRequest(body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	return r
+}
+
+func TestThatValidJSONRequestIsBound(t *testing.T) {
+	var user bindingUser
+
+	if err := BindAndValidate(newJSONRequest(`{"name":"John","age":30}`), &user); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if user.Name != "John" || user.Age != 30 {
+		t.Fatalf("Expected bound user, got %+v.", user)
+	}
+}
+
+func TestThatInvalidJSONRequestReturnsUnprocessableEntity(t *testing.T) {
+	var user bindingUser
+
+	err := BindAndValidate(newJSONRequest(`{"name":"Jo","age":30}`), &user)
+
+	var bindingErr *Error
+
+	if !errors.As(err, &bindingErr) {
+		t.Fatalf("Expected binding error, got %v.", err)
+	}
+
+	if bindingErr.StatusCode != http.StatusUnprocessableEntity || bindingErr.Errors.Length() != 1 {
+		t.Fatalf("Expected 422 with 1 error, got %d with %d errors.", bindingErr.StatusCode, bindingErr.Errors.Length())
+	}
+
+	recorder := httptest.NewRecorder()
+
+	if err := bindingErr.Write(recorder); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	var response struct {
+		Errors []map[string]string `json:"errors"`
+	}
+
+	if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if recorder.Code != http.StatusUnprocessableEntity || len(response.Errors) != 1 || response.Errors[0]["field"] != "Name" {
+		t.Fatalf("Expected 422 response with error for 'Name', got %d: %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestThatMalformedJSONRequestReturnsBadRequest(t *testing.T) {
+	var user bindingUser
+
+	err := BindAndValidate(newJSONRequest(`{"name":`), &user)
+
+	if bindingErr, ok := err.(*Error); !ok || bindingErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected 400 binding error, got %v.", err)
+	}
+}
+
+func TestThatUnsupportedContentTypeReturnsUnsupportedMediaType(t *testing.T) {
+	var user bindingUser
+
+	r := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("<user/>"))
+	r.Header.Set("Content-Type", "application/xml")
+
+	if bindingErr, ok := BindAndValidate(r, &user).(*Error); !ok || bindingErr.StatusCode != http.StatusUnsupportedMediaType {
+		t.Fatalf("Expected 415 binding error, got %v.", bindingErr)
+	}
+}
+
+func TestThatFormRequestIsBound(t *testing.T) {
+	var user bindingUser
+
+	form := url.Values{"name": {"John"}, "age": {"30"}, "tag": {"a", "b"}}
+	r := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	if err := BindAndValidate(r, &user); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if user.Name != "John" || user.Age != 30 || len(user.Tags) != 2 {
+		t.Fatalf("Expected bound user, got %+v.", user)
+	}
+}
+
+func TestThatQueryIsBoundForRequestsWithoutBody(t *testing.T) {
+	var user bindingUser
+
+	r := httptest.NewRequest(http.MethodGet, "/users?name=John&age=30", nil)
+
+	if err := BindAndValidate(r, &user); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if user.Name != "John" || user.Age != 30 {
+		t.Fatalf("Expected bound user, got %+v.", user)
+	}
+}
+
+func TestThatConversionFailuresAreReportedWithRuleFailures(t *testing.T) {
+	var user bindingUser
+
+	r := httptest.NewRequest(http.MethodGet, "/users?name=Jo&age=abc", nil)
+
+	bindingErr, ok := BindAndValidate(r, &user).(*Error)
+
+	if !ok || bindingErr.StatusCode != http.StatusUnprocessableEntity || bindingErr.Errors.Length() != 2 {
+		t.Fatalf("Expected 422 binding error with 2 errors, got %v.", bindingErr)
+	}
+
+	if ageErrs := bindingErr.Errors.WithField("Age"); ageErrs.Length() != 1 || ageErrs.First().GetValidatorName() != ConversionValidator {
+		t.Fatalf("Expected conversion error of 'Age', got %v.", bindingErr.Errors.ByField())
+	}
+
+	if message := bindingErr.Errors.WithField("Age").First().Error(); message != "Age must be an integer." {
+		t.Fatalf("Expected conversion message, got '%s'.", message)
+	}
+
+	if bindingErr.Errors.WithField("Name").WithValidator("min").Length() != 1 {
+		t.Fatalf("Expected rule error of 'Name', got %v.", bindingErr.Errors.ByField())
+	}
+
+	if bindingErr, ok := Bind(httptest.NewRequest(http.MethodGet, "/users?age=abc", nil), &user).(*Error); !ok || bindingErr.StatusCode != http.StatusBadRequest {
+		t.Fatalf("Expected 400 binding error, got %v.", bindingErr)
+	}
+}
+
+type bindingSearch struct {
+	Query string    `json:"query" validate:"not_empty"`
+	Page  int       `query:"page" validate:"min(1)"`
+	Since time.Time `query:"since"`
+}
+
+func TestThatTaggedQueryParametersAreBoundWithBody(t *testing.T) {
+	var search bindingSearch
+
+	r := newJSONRequest(`{"query":"go"}`)
+	r.URL.RawQuery = "page=2&since=2024-01-02T15:04:05Z"
+
+	if err := BindAndValidate(r, &search); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if search.Query != "go" || search.Page != 2 || search.Since.Year() != 2024 {
+		t.Fatalf("Expected bound search, got %+v.", search)
+	}
+}
+
+type bindingUpload struct {
+	Title       string                  `form:"title" validate:"not_empty"`
+	Attachment  *multipart.FileHeader   `form:"attachment" validate:"required"`
+	Attachments []*multipart.FileHeader `form:"attachments"`
+}
+
+func TestThatMultipartFormIsBoundWithFiles(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	writer.WriteField("title", "Report")
+
+	for _, name := range []string{"attachment", "attachments", "attachments"} {
+		part, _ := writer.CreateFormFile(name, name+".txt")
+		part.Write([]byte("content"))
+	}
+
+	writer.Close()
+
+	r := httptest.NewRequest(http.MethodPost, "/uploads", &body)
+	r.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var upload bindingUpload
+
+	if err := BindAndValidate(r, &upload); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if upload.Title != "Report" || upload.Attachment == nil || upload.Attachment.Filename != "attachment.txt" || len(upload.Attachments) != 2 {
+		t.Fatalf("Expected bound upload, got %+v.", upload)
+	}
+}
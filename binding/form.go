@@ -0,0 +1,220 @@
+package binding
+
+import (
+	"errors"
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// FormTag is the tag used for the names of form values, i.e. `form:"user_name"`. Fields without the tag are bound
+// by their field name.
+const FormTag = "form"
+
+// QueryTag is the tag used for the names of query parameters, i.e. `query:"page"`. Fields with the tag are bound from
+// the query of the request, whatever the type of its body.
+const QueryTag = "query"
+
+// ConversionValidator is the name of the validator of the errors of form values and query parameters that can't be
+// converted to the type of their field, i.e. `age=abc` for an int.
+const ConversionValidator = "bind"
+
+const maxMultipartMemory = 32 << 20
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	fileHeaderType  = reflect.TypeOf(&multipart.FileHeader{})
+	fileHeadersType = reflect.TypeOf([]*multipart.FileHeader{})
+)
+
+func bindForm(v validator.Validator, r *http.Request, dst interface{}) (core.ErrorList, error) {
+	var err error
+	var files map[string][]*multipart.FileHeader
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		if err = r.ParseMultipartForm(maxMultipartMemory); err == nil {
+			files = r.MultipartForm.File
+		}
+	} else {
+		err = r.ParseForm()
+	}
+
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, errors.New("Unable to parse form of request. "+err.Error()))
+	}
+
+	return bindValues(v, dst, FormTag, r.Form, files), nil
+}
+
+func bindQuery(v validator.Validator, r *http.Request, dst interface{}) core.ErrorList {
+	return bindValues(v, dst, QueryTag, r.URL.Query(), nil)
+}
+
+// bindValues binds values, and files, to the exported fields of dst by the names in their tag. Fields without the
+// tag are bound by their field name, except for query parameters. Returns an error for each field whose values can't
+// be converted to its type.
+func bindValues(v validator.Validator, dst interface{}, tagName string, values url.Values, files map[string][]*multipart.FileHeader) core.ErrorList {
+	var errs core.ErrorList
+	var fields []*core.ReflectedField
+
+	target := reflect.ValueOf(dst).Elem()
+	targetType := target.Type()
+
+	for i := 0; i < targetType.NumField(); i++ {
+		field := targetType.Field(i)
+
+		if len(field.PkgPath) > 0 {
+			continue
+		}
+
+		name, ok := field.Tag.Lookup(tagName)
+
+		if index := strings.Index(name, ","); index != -1 {
+			name = name[:index]
+		}
+
+		if name == "-" || !ok && tagName == QueryTag {
+			continue
+		} else if len(name) == 0 {
+			name = field.Name
+		}
+
+		if field.Type == fileHeaderType || field.Type == fileHeadersType {
+			bindFiles(target.Field(i), files[name])
+			continue
+		}
+
+		fieldValues, ok := values[name]
+
+		if !ok || len(fieldValues) == 0 {
+			continue
+		}
+
+		if err := bindFormValues(target.Field(i), fieldValues); err != nil {
+			if fields == nil {
+				fields = reflectFields(v, dst)
+			}
+
+			errs.Add(newConversionError(v, fields, targetType, i))
+		}
+	}
+
+	return errs
+}
+
+func bindFiles(target reflect.Value, files []*multipart.FileHeader) {
+	if len(files) == 0 {
+		return
+	}
+
+	if target.Type() == fileHeaderType {
+		target.Set(reflect.ValueOf(files[0]))
+	} else {
+		target.Set(reflect.ValueOf(files))
+	}
+}
+
+func bindFormValues(target reflect.Value, values []string) error {
+	if target.Kind() == reflect.Slice && target.Type().Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(target.Type(), len(values), len(values))
+
+		for i, value := range values {
+			if err := bindFormValue(slice.Index(i), value); err != nil {
+				return err
+			}
+		}
+
+		target.Set(slice)
+		return nil
+	}
+
+	return bindFormValue(target, values[0])
+}
+
+// bindFormValue assigns a value to target like core.AssignValue. Times are parsed as RFC 3339.
+func bindFormValue(target reflect.Value, value string) error {
+	if indirectType(target.Type()) == timeType {
+		timeValue, err := time.Parse(time.RFC3339, value)
+
+		if err != nil {
+			return err
+		}
+
+		return core.AssignValue(target, timeValue)
+	}
+
+	return core.AssignValue(target, value)
+}
+
+// reflectFields returns the reflected fields of dst by their index, as named by the validator, i.e. with the display
+// names of its tag. Returns no fields if the struct can't be compiled.
+func reflectFields(v validator.Validator, dst interface{}) []*core.ReflectedField {
+	plan, err := v.Compile(dst)
+
+	if err != nil {
+		return []*core.ReflectedField{}
+	}
+
+	compiledType := plan.Type(reflect.TypeOf(dst).Elem())
+	fields := make([]*core.ReflectedField, reflect.TypeOf(dst).Elem().NumField())
+
+	for _, compiledField := range compiledType.Fields {
+		fields[compiledField.Field.Index] = compiledField.Field
+	}
+
+	return fields
+}
+
+// newConversionError returns the error of a field whose values can't be converted to its type, with the message of
+// the to_int, to_float, to_bool and to_time converters.
+func newConversionError(v validator.Validator, fields []*core.ReflectedField, structType reflect.Type, index int) *core.Error {
+	field := structType.Field(index)
+
+	var reflectedField *core.ReflectedField
+
+	if index < len(fields) {
+		reflectedField = fields[index]
+	}
+
+	if reflectedField == nil {
+		reflectedField = &core.ReflectedField{Index: index, Name: field.Name, StructName: structType.Name()}
+	}
+
+	fieldType := indirectType(field.Type)
+
+	if fieldType.Kind() == reflect.Slice {
+		fieldType = indirectType(fieldType.Elem())
+	}
+
+	var err error
+
+	switch fieldType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		err = core.NewMessageError(v.Locale(), "toInt.mustBeInteger")
+	case reflect.Float32, reflect.Float64:
+		err = core.NewMessageError(v.Locale(), "toFloat.mustBeNumber")
+	case reflect.Bool:
+		err = core.NewMessageError(v.Locale(), "toBool.mustBeBoolean")
+	default:
+		if fieldType == timeType {
+			err = core.NewMessageError(v.Locale(), "toTime.mustBeTime")
+		} else {
+			err = core.NewMessageError(v.Locale(), "type.invalid", fieldType.String())
+		}
+	}
+
+	return core.NewError(reflectedField, &parser.Method{Name: ConversionValidator}, err)
+}
+
+func indirectType(reflectedType reflect.Type) reflect.Type {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+	return reflectedType
+}
@@ -0,0 +1,67 @@
+// Command cocoon-gen generates code that validates the structs of a package without reflection, from their validate
+// tags. The generated code registers itself, so Validate uses it for structs passed by pointer, and adds a
+// ValidateT function for each struct T.
+//
+// Usage:
+//
+//	//go:generate cocoon-gen [-type T,...] [-output cocoon_validation.go] [dir]
+//
+// Without -type, code is generated for all supported structs with validate tags. Structs with embedded fields,
+// fields of types of other packages (except time.Time), collections of structs, or rules that modify fields
+// (i.e. `default` or transformers) are validated by reflection instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/typerandom/validator/gen"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	types := flag.String("type", "", "comma separated names of the struct types to generate")
+	output := flag.String("output", "cocoon_validation.go", "name of the generated file")
+	flag.Parse()
+
+	dir := "."
+
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	pkg, err := gen.Load(dir)
+
+	if err != nil {
+		exit(err)
+	}
+
+	var typeNames []string
+
+	for _, name := range strings.Split(*types, ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			typeNames = append(typeNames, name)
+		}
+	}
+
+	source, skipped, err := pkg.Generate(typeNames...)
+
+	if err != nil {
+		exit(err)
+	}
+
+	for _, reason := range skipped {
+		fmt.Fprintln(os.Stderr, "Skipped:", reason)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, *output), source, 0644); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
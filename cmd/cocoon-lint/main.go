@@ -0,0 +1,80 @@
+// Command cocoon-lint reports unknown validators, malformed arguments and unsupported validator and type
+// combinations in the validation tags of Go source files.
+//
+// Usage:
+//
+//	cocoon-lint [-tag validate] [-ignore name,...] [dir|dir/...]...
+//	cocoon-lint -list
+//
+// Directories ending with `/...` are linted recursively. Custom validators can be listed with -ignore, so that they
+// aren't reported as unknown. Exits with status 1 if any issues are found. -list prints the usage and summary of the
+// known validators instead.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/lint"
+	"os"
+	"strings"
+)
+
+func main() {
+	tagName := flag.String("tag", "validate", "name of the tag holding the validation rules")
+	ignore := flag.String("ignore", "", "comma separated names of custom validators")
+	list := flag.Bool("list", false, "print the known validators")
+	flag.Parse()
+
+	linter := lint.New()
+	linter.TagName = *tagName
+
+	for _, name := range strings.Split(*ignore, ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			linter.Registry().Register(name, func(context core.ValidatorContext, args []interface{}) error {
+				return nil
+			})
+		}
+	}
+
+	if *list {
+		for _, description := range linter.Registry().Describe() {
+			fmt.Printf("%-16s %-36s %s\n", description.Name, description.Usage, description.Summary)
+		}
+		return
+	}
+
+	dirs := flag.Args()
+
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	found := false
+
+	for _, dir := range dirs {
+		recursive := strings.HasSuffix(dir, "/...") || dir == "..."
+
+		if recursive {
+			if dir = strings.TrimSuffix(strings.TrimSuffix(dir, "..."), "/"); len(dir) == 0 {
+				dir = "."
+			}
+		}
+
+		issues, err := linter.LintDir(dir, recursive)
+
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+
+		for _, issue := range issues {
+			fmt.Println(issue)
+			found = true
+		}
+	}
+
+	if found {
+		os.Exit(1)
+	}
+}
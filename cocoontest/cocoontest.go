@@ -0,0 +1,210 @@
+// Package cocoontest asserts the results of validation in tests, so that the rules of the tags of a struct can be
+// unit tested, i.e. `cocoontest.AssertFails(t, user, "Email", "email")`. Failed assertions report the expected and
+// the actual errors by field.
+package cocoontest
+
+import (
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Asserter asserts the results of validation by a validator.
+type Asserter struct {
+	validator validator.Validator
+}
+
+// New creates an asserter that validates by the validator, i.e. a validator with custom validators.
+func New(validator validator.Validator) *Asserter {
+	return &Asserter{validator: validator}
+}
+
+// AssertPasses asserts that the value passes validation. Warnings don't fail the assertion.
+func (this *Asserter) AssertPasses(t testing.TB, value interface{}) bool {
+	t.Helper()
+
+	actual := collectErrors(this.validator.Validate(value))
+
+	if len(actual) > 0 {
+		t.Errorf("Expected value to pass validation, got:\n%s", formatErrors(actual))
+		return false
+	}
+
+	return true
+}
+
+// AssertFails asserts that the field fails validation with each of the validators, i.e. "Address.Street" and
+// "not_empty". The field may fail with other validators too. If no validators are passed, the field must fail with
+// any validator.
+func (this *Asserter) AssertFails(t testing.TB, value interface{}, fieldName string, validatorNames ...string) bool {
+	t.Helper()
+
+	actual := collectErrors(this.validator.Validate(value))
+	failed := actual[fieldName]
+
+	missing := validatorNames
+
+	if len(failed) > 0 {
+		missing = nil
+
+		for _, validatorName := range validatorNames {
+			if !contains(failed, validatorName) {
+				missing = append(missing, validatorName)
+			}
+		}
+
+		if len(missing) == 0 {
+			return true
+		}
+	}
+
+	t.Errorf("Expected field '%s' to fail validation%s, got:\n%s", fieldName, formatWith(missing), formatErrors(actual))
+	return false
+}
+
+// AssertErrors asserts that the value fails validation with exactly the validators of each field, i.e.
+// `map[string][]string{"Email": {"email"}}`. Fields that aren't in the map must pass.
+func (this *Asserter) AssertErrors(t testing.TB, value interface{}, expected map[string][]string) bool {
+	t.Helper()
+
+	actual := collectErrors(this.validator.Validate(value))
+
+	var fieldNames []string
+
+	for fieldName := range expected {
+		fieldNames = append(fieldNames, fieldName)
+	}
+
+	for fieldName := range actual {
+		if _, ok := expected[fieldName]; !ok {
+			fieldNames = append(fieldNames, fieldName)
+		}
+	}
+
+	sort.Strings(fieldNames)
+
+	var diff []string
+
+	for _, fieldName := range fieldNames {
+		expectedNames := uniqueSorted(expected[fieldName])
+
+		if actualNames := actual[fieldName]; formatNames(expectedNames) != formatNames(actualNames) {
+			diff = append(diff, "  "+formatFieldName(fieldName)+": expected "+formatNames(expectedNames)+
+				", got "+formatNames(actualNames))
+		}
+	}
+
+	if len(diff) > 0 {
+		t.Errorf("Expected errors didn't match:\n%s", strings.Join(diff, "\n"))
+		return false
+	}
+
+	return true
+}
+
+// AssertPasses asserts that the value passes validation by the default validator.
+func AssertPasses(t testing.TB, value interface{}) bool {
+	t.Helper()
+	return New(validator.Default()).AssertPasses(t, value)
+}
+
+// AssertFails asserts that the field fails validation by the default validator with each of the validators.
+func AssertFails(t testing.TB, value interface{}, fieldName string, validatorNames ...string) bool {
+	t.Helper()
+	return New(validator.Default()).AssertFails(t, value, fieldName, validatorNames...)
+}
+
+// AssertErrors asserts that the value fails validation by the default validator with exactly the validators of each
+// field.
+func AssertErrors(t testing.TB, value interface{}, expected map[string][]string) bool {
+	t.Helper()
+	return New(validator.Default()).AssertErrors(t, value, expected)
+}
+
+// collectErrors maps the full names of failed fields to the sorted names of the validators they failed. Plain errors
+// are mapped by an empty field name to their messages.
+func collectErrors(errs core.ErrorList) map[string][]string {
+	collected := make(map[string][]string)
+
+	for _, err := range errs.Errors() {
+		if err.IsFieldError() {
+			collected[err.GetFieldName()] = append(collected[err.GetFieldName()], err.GetValidatorName())
+		} else {
+			collected[""] = append(collected[""], err.Error())
+		}
+	}
+
+	for fieldName, names := range collected {
+		collected[fieldName] = uniqueSorted(names)
+	}
+
+	return collected
+}
+
+func uniqueSorted(names []string) []string {
+	var unique []string
+
+	for _, name := range names {
+		if !contains(unique, name) {
+			unique = append(unique, name)
+		}
+	}
+
+	sort.Strings(unique)
+
+	return unique
+}
+
+func contains(names []string, name string) bool {
+	for _, current := range names {
+		if current == name {
+			return true
+		}
+	}
+	return false
+}
+
+func formatErrors(errs map[string][]string) string {
+	if len(errs) == 0 {
+		return "  (none)"
+	}
+
+	var fieldNames []string
+
+	for fieldName := range errs {
+		fieldNames = append(fieldNames, fieldName)
+	}
+
+	sort.Strings(fieldNames)
+
+	lines := make([]string, len(fieldNames))
+
+	for i, fieldName := range fieldNames {
+		lines[i] = "  " + formatFieldName(fieldName) + ": " + formatNames(errs[fieldName])
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func formatFieldName(fieldName string) string {
+	if fieldName == "" {
+		return "(no field)"
+	}
+	return fieldName
+}
+
+func formatNames(names []string) string {
+	if len(names) == 0 {
+		return "(none)"
+	}
+	return strings.Join(names, ", ")
+}
+
+func formatWith(validatorNames []string) string {
+	if len(validatorNames) == 0 {
+		return ""
+	}
+	return " with " + strings.Join(validatorNames, ", ")
+}
@@ -0,0 +1,139 @@
+package cocoontest_test
+
+import (
+	"fmt"
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/cocoontest"
+	"github.com/typerandom/validator/core"
+	"testing"
+)
+
+type recordingT struct {
+	testing.TB
+	messages []string
+}
+
+func (this *recordingT) Helper() {}
+
+func (this *recordingT) Errorf(format string, args ...interface{}) {
+	this.messages = append(this.messages, fmt.Sprintf(format, args...))
+}
+
+type testAddress struct {
+	Street string `validate:"not_empty"`
+}
+
+type testUser struct {
+	Name    string `validate:"not_empty,min(3)"`
+	Email   string `validate:"email"`
+	Address testAddress
+}
+
+func TestThatAssertPassesPassesForValidValue(t *testing.T) {
+	recorder := &recordingT{}
+	user := &testUser{Name: "Jane", Email: "jane@example.com", Address: testAddress{Street: "Main"}}
+
+	if !cocoontest.AssertPasses(recorder, user) {
+		t.Fatalf("Expected assertion to pass, got %v.", recorder.messages)
+	}
+}
+
+func TestThatAssertPassesReportsErrorsOfInvalidValue(t *testing.T) {
+	recorder := &recordingT{}
+
+	if cocoontest.AssertPasses(recorder, &testUser{Name: "Jane", Email: "jane"}) {
+		t.Fatalf("Expected assertion to fail.")
+	}
+
+	expected := "Expected value to pass validation, got:\n  Address.Street: not_empty\n  Email: email"
+
+	if len(recorder.messages) != 1 || recorder.messages[0] != expected {
+		t.Fatalf("Expected '%s', got %v.", expected, recorder.messages)
+	}
+}
+
+func TestThatAssertFailsPassesIfFieldFailsWithValidators(t *testing.T) {
+	recorder := &recordingT{}
+	user := &testUser{Email: "jane"}
+
+	if !cocoontest.AssertFails(recorder, user, "Name", "not_empty", "min") {
+		t.Fatalf("Expected assertion to pass, got %v.", recorder.messages)
+	}
+
+	if !cocoontest.AssertFails(recorder, user, "Address.Street") {
+		t.Fatalf("Expected assertion to pass, got %v.", recorder.messages)
+	}
+}
+
+func TestThatAssertFailsReportsMissingValidators(t *testing.T) {
+	recorder := &recordingT{}
+	user := &testUser{Name: "Jo", Email: "jane", Address: testAddress{Street: "Main"}}
+
+	if cocoontest.AssertFails(recorder, user, "Name", "not_empty", "min") {
+		t.Fatalf("Expected assertion to fail.")
+	}
+
+	expected := "Expected field 'Name' to fail validation with not_empty, got:\n  Email: email\n  Name: min"
+
+	if len(recorder.messages) != 1 || recorder.messages[0] != expected {
+		t.Fatalf("Expected '%s', got %v.", expected, recorder.messages)
+	}
+}
+
+func TestThatAssertFailsReportsPassingField(t *testing.T) {
+	recorder := &recordingT{}
+	user := &testUser{Name: "Jane", Email: "jane@example.com", Address: testAddress{Street: "Main"}}
+
+	if cocoontest.AssertFails(recorder, user, "Email", "email") {
+		t.Fatalf("Expected assertion to fail.")
+	}
+
+	expected := "Expected field 'Email' to fail validation with email, got:\n  (none)"
+
+	if len(recorder.messages) != 1 || recorder.messages[0] != expected {
+		t.Fatalf("Expected '%s', got %v.", expected, recorder.messages)
+	}
+}
+
+func TestThatAssertErrorsReportsDiffOfFields(t *testing.T) {
+	recorder := &recordingT{}
+	user := &testUser{Name: "Jo", Email: "jane"}
+
+	expected := map[string][]string{"Name": {"min"}, "Email": {"email"}, "Address.Street": {"not_empty"}}
+
+	if !cocoontest.AssertErrors(recorder, user, expected) {
+		t.Fatalf("Expected assertion to pass, got %v.", recorder.messages)
+	}
+
+	expected = map[string][]string{"Name": {"not_empty"}, "Address.Street": {"not_empty"}}
+
+	if cocoontest.AssertErrors(recorder, user, expected) {
+		t.Fatalf("Expected assertion to fail.")
+	}
+
+	diff := "Expected errors didn't match:\n  Email: expected (none), got email\n  Name: expected not_empty, got min"
+
+	if len(recorder.messages) != 1 || recorder.messages[0] != diff {
+		t.Fatalf("Expected '%s', got %v.", diff, recorder.messages)
+	}
+}
+
+func TestThatAsserterUsesValidator(t *testing.T) {
+	recorder := &recordingT{}
+	instance := validator.New()
+
+	instance.Register("jane", func(context core.ValidatorContext, args []interface{}) error {
+		if context.Value() != "Jane" {
+			return context.NewError("jane.invalid")
+		}
+		return nil
+	})
+
+	type person struct {
+		Name string `validate:"jane"`
+	}
+
+	if !cocoontest.New(instance).AssertFails(recorder, &person{Name: "John"}, "Name", "jane") {
+		t.Fatalf("Expected assertion to pass, got %v.", recorder.messages)
+	}
+}
@@ -0,0 +1,79 @@
+package validator_test
+
+import (
+	"fmt"
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"sync"
+	"testing"
+)
+
+type concurrencyDummy struct {
+	Name  string `validate:"not_empty,min(3)"`
+	Email string `validate:"empty|email"`
+	Age   int    `validate:"min(18),max(65)"`
+	Tags  []*concurrencyTagDummy
+}
+
+type concurrencyTagDummy struct {
+	Value string `validate:"lowercase"`
+}
+
+// Run with `go test -race` to detect data races.
+func TestThatValidatorCanValidateAndRegisterConcurrently(t *testing.T) {
+	validator := New()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+
+			dummy := &concurrencyDummy{
+				Name:  "Bo",
+				Email: "bob",
+				Age:   17,
+				Tags:  []*concurrencyTagDummy{{Value: "ABC"}},
+			}
+
+			for j := 0; j < 50; j++ {
+				if errs := validator.Validate(dummy); errs.Length() != 4 {
+					t.Errorf("Expected 4 errors, got %d.", errs.Length())
+					return
+				}
+			}
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+
+			validator.Register(fmt.Sprintf("custom_%d", i), func(context core.ValidatorContext, args []interface{}) error {
+				return nil
+			})
+			validator.Locale().Set(fmt.Sprintf("custom_%d.error", i), "{field} is invalid.")
+			validator.SetDisplayNameTag("json")
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestThatDefaultValidatorCanValidateConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if errs := Validate(&concurrencyDummy{Name: "Bob", Age: 18}); errs.Any() {
+				t.Errorf("Didn't expect error, got %s.", errs.First())
+			}
+		}()
+	}
+
+	wg.Wait()
+}
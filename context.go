@@ -1,22 +1,362 @@
 package validator
 
 import (
+	gocontext "context"
 	"errors"
-	"fmt"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"reflect"
+	"strconv"
+	"time"
 )
 
 type context struct {
-	validator *validator
+	ctx        gocontext.Context
+	cancelled  bool
+	validator  *validator
+	fieldCache *core.FieldCache
+	translator core.Translator
+	selection  *fieldSelection
+	groups     []string
+	maxErrors  int
+	limits     limits
+	nilPolicy  core.NilPolicy
+	hooks      hookList
+	recorder   Recorder
+	sampler    *errorSampler
+
+	// unsampled holds the errors of a fork that are sampled once it's joined, and whether they're warnings.
+	unsampled map[*core.Error]bool
+
+	// overrides holds the validator groups that replace those of fields, by the name of their struct and field.
+	overrides map[string][]parser.Methods
+
+	// unsupportedPolicy decides how fields of channels, functions and unsafe pointers are treated.
+	unsupportedPolicy core.UnsupportedPolicy
+
+	// memo caches the results of pure validators, if memoization is enabled.
+	memo *memoCache
+
+	// unexportedPolicy decides how unexported fields of structs are treated.
+	unexportedPolicy core.UnexportedPolicy
+
+	flattenEmbedded bool
+
+	// report collects the outcome of the validators of each field, if requested with WithReport.
+	report *Report
+
+	// structs holds the structs being validated on the current path, if there are hooks or a recorder.
+	structs []startedStruct
+
+	// started and span are the start and span of the validation, if there is a recorder or tracer.
+	started time.Time
+	span    Span
+
+	// walking holds the values being walked on the current path, in order to detect cycles.
+	walking       []walkKey
+	walkingBuffer [8]walkKey
+
+	// traversalDepth and visits are counted against the limits, and limitExceeded stops validation once the maximum
+	// number of visits is exceeded.
+	traversalDepth int
+	visits         int
+	limitExceeded  bool
+
+	// fieldWorkers is the number of fields of a struct that are validated concurrently, if more than one.
+	fieldWorkers int
 
 	value        interface{}
 	originalKind reflect.Kind
 	field        *core.ReflectedField
 	isNil        bool
 
-	errors core.ErrorList
-	source interface{}
+	namedArguments map[string]interface{}
+
+	// skipping is set by SkipField and AbortStruct to skip the remaining validators of the field, and aborting is set
+	// by AbortStruct to skip the remaining fields of the struct until it's left.
+	skipping bool
+	aborting bool
+
+	// batches holds the values of batch validators, which are run after the other validators.
+	batches    []*pendingBatch
+	batchIndex map[string]*pendingBatch
+
+	errors   core.ErrorList
+	warnings int
+	source   interface{}
+}
+
+func (this *context) Context() gocontext.Context {
+	return this.ctx
+}
+
+// setOverrides parses the rules of Override options. Invalid rules are added as errors.
+func (this *context) setOverrides(overrides map[string]string) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	this.overrides = make(map[string][]parser.Methods, len(overrides))
+
+	for name, rules := range overrides {
+		methodGroups, err := parser.Parse(rules)
+
+		if err == nil {
+			methodGroups, err = this.validator.registry.ExpandAliases(methodGroups)
+		}
+
+		if err == nil {
+			methodGroups, err = this.validator.registry.ConvertArguments(methodGroups)
+		}
+
+		if err != nil {
+			this.errors.AddPlain(errors.New("Unable to override rules of field '" + name + "'. " + err.Error()))
+			continue
+		}
+
+		this.overrides[name] = methodGroups
+	}
+}
+
+// fieldOf returns a cached field of a struct on the current path. The cached field is shared, so it's copied before
+// setting its parent or overriding its validator groups.
+func (this *context) fieldOf(cachedField *core.ReflectedField, parentField *core.ReflectedField) *core.ReflectedField {
+	var methodGroups []parser.Methods
+	overridden := false
+
+	if this.overrides != nil {
+		methodGroups, overridden = this.overrides[cachedField.StructName+"."+cachedField.Name]
+	}
+
+	if parentField == nil && !overridden {
+		return cachedField
+	}
+
+	field := &core.ReflectedField{}
+	*field = *cachedField
+	field.Parent = parentField
+
+	if overridden {
+		field.MethodGroups = methodGroups
+
+		// The label is copied, so that it's only allocated for fields that have one.
+		if label, ok := core.GetLabel(methodGroups); ok {
+			displayName := label
+			field.DisplayName = &displayName
+		}
+	}
+
+	return field
+}
+
+// isCancelled checks whether the context.Context has been cancelled. The error of the context is added once.
+func (this *context) isCancelled() bool {
+	if this.cancelled {
+		return true
+	}
+
+	if err := this.ctx.Err(); err != nil {
+		this.cancelled = true
+		this.errors.AddPlain(err)
+		return true
+	}
+
+	return false
+}
+
+// isDone checks whether validation should stop, either because the maximum number of errors or visits has been
+// reached or because the context.Context has been cancelled.
+func (this *context) isDone() bool {
+	if this.maxErrors > 0 && len(this.errors)-this.warnings >= this.maxErrors {
+		return true
+	}
+
+	if this.limitExceeded {
+		return true
+	}
+
+	return this.isCancelled()
+}
+
+// sample returns the errors of a field that are within the limit of the sampler. Forks keep all errors until they're
+// joined, so that the errors are sampled in the order in which the fields are declared.
+func (this *context) sample(errs core.ErrorList, warnings bool) core.ErrorList {
+	if this.unsampled == nil {
+		return this.sampler.sample(errs)
+	}
+
+	for _, err := range errs {
+		this.unsampled[err] = warnings
+	}
+
+	return errs
+}
+
+// addWarnings adds warnings to the errors, which are not counted towards the maximum number of errors.
+func (this *context) addWarnings(warnings core.ErrorList) {
+	this.errors.AddMany(warnings)
+	this.warnings += len(warnings)
+}
+
+// walkKey identifies a value by its type and address, and length for slices which share arrays.
+type walkKey struct {
+	valueType reflect.Type
+	pointer   uintptr
+	length    int
+}
+
+// enter marks an array, map or struct as being walked, until leave is called with the same value. Returns false if
+// the value is already being walked on the current path, i.e. a cycle such as a pointer back to a parent, or if it
+// exceeds the maximum depth or collection size.
+func (this *context) enter(value reflect.Value, parentField *core.ReflectedField) bool {
+	key, trackable := newWalkKey(value)
+
+	if trackable {
+		for _, walkingKey := range this.walking {
+			if walkingKey == key {
+				return false
+			}
+		}
+	}
+
+	if this.limits.maxCollectionSize > 0 && value.Kind() != reflect.Struct && value.Len() > this.limits.maxCollectionSize {
+		this.errors.AddPlain(&LimitExceededError{Limit: CollectionSizeLimit, Max: this.limits.maxCollectionSize, Path: pathOf(parentField)})
+		return false
+	}
+
+	if this.limits.maxDepth > 0 && this.traversalDepth >= this.limits.maxDepth {
+		this.errors.AddPlain(&LimitExceededError{Limit: DepthLimit, Max: this.limits.maxDepth, Path: pathOf(parentField)})
+		return false
+	}
+
+	this.traversalDepth++
+
+	if trackable {
+		if this.walking == nil {
+			this.walking = this.walkingBuffer[:0]
+		}
+		this.walking = append(this.walking, key)
+	}
+
+	return true
+}
+
+// leave marks a value that was entered as walked.
+func (this *context) leave(value reflect.Value) {
+	if _, trackable := newWalkKey(value); trackable {
+		this.walking = this.walking[:len(this.walking)-1]
+	}
+
+	this.traversalDepth--
+}
+
+// visit counts a field, or an item of a collection by its field, as visited. Returns false, and stops validation, once
+// the maximum number of visits is exceeded.
+func (this *context) visit(field *core.ReflectedField) bool {
+	if this.limits.maxVisits == 0 {
+		return true
+	}
+
+	if this.visits++; this.visits > this.limits.maxVisits {
+		if !this.limitExceeded {
+			this.errors.AddPlain(&LimitExceededError{Limit: VisitLimit, Max: this.limits.maxVisits, Path: pathOf(field)})
+			this.limitExceeded = true
+		}
+		return false
+	}
+
+	return true
+}
+
+// pathOf returns the full name of a field, or an empty path for the value passed to Validate.
+func pathOf(field *core.ReflectedField) string {
+	if field == nil {
+		return ""
+	}
+	return field.FullName()
+}
+
+func newWalkKey(value reflect.Value) (walkKey, bool) {
+	switch value.Kind() {
+	case reflect.Map:
+		return walkKey{valueType: value.Type(), pointer: value.Pointer()}, !value.IsNil()
+	case reflect.Slice:
+		return walkKey{valueType: value.Type(), pointer: value.Pointer(), length: value.Len()}, !value.IsNil()
+	case reflect.Struct, reflect.Array:
+		if value.CanAddr() {
+			return walkKey{valueType: value.Type(), pointer: value.UnsafeAddr()}, true
+		}
+	}
+	return walkKey{}, false
+}
+
+// limits guard the validation of untrusted payloads, i.e. deeply nested or giant collections. Zero means no limit.
+type limits struct {
+	maxDepth          int
+	maxVisits         int
+	maxCollectionSize int
+}
+
+// Limits of the MaxDepth, MaxVisits and MaxCollectionSize options.
+const (
+	DepthLimit          = "depth"
+	VisitLimit          = "visits"
+	CollectionSizeLimit = "collection size"
+)
+
+// LimitExceededError is the error of validating a value that exceeds one of the limits, i.e. of MaxVisits.
+type LimitExceededError struct {
+	Limit string
+	Max   int
+	Path  string
+}
+
+func (this *LimitExceededError) Error() string {
+	switch this.Limit {
+	case DepthLimit:
+		return "Maximum depth of " + strconv.Itoa(this.Max) + " exceeded at '" + this.Path + "'."
+	case VisitLimit:
+		return "Maximum of " + strconv.Itoa(this.Max) + " visited fields and items exceeded at '" + this.Path + "'."
+	default:
+		return "Maximum " + this.Limit + " of " + strconv.Itoa(this.Max) + " exceeded at '" + this.Path + "'."
+	}
+}
+
+// result runs the pending batch validators and returns the errors of the validation. A single field can have several errors, so the maximum number of
+// errors may be exceeded before validation stops.
+func (this *context) result() core.ErrorList {
+	this.runBatches()
+
+	errs := this.limitErrors()
+	this.doneValidation(errs)
+
+	return errs
+}
+
+// limitErrors returns the errors limited to the maximum number of errors. Warnings are not limited.
+func (this *context) limitErrors() core.ErrorList {
+	if this.maxErrors == 0 || len(this.errors)-this.warnings <= this.maxErrors {
+		return this.errors
+	}
+
+	if this.warnings == 0 {
+		return this.errors[:this.maxErrors]
+	}
+
+	var errs core.ErrorList
+	count := 0
+
+	for _, err := range this.errors {
+		if !err.IsWarning() {
+			if count == this.maxErrors {
+				continue
+			}
+			count++
+		}
+		errs.Add(err)
+	}
+
+	return errs
 }
 
 func (this *context) Source() interface{} {
@@ -53,18 +393,37 @@ func (this *context) IsNil() bool {
 	return this.isNil
 }
 
-func (this *context) NewError(localeKey string, args ...interface{}) error {
-	message, err := this.validator.locale.Get(localeKey)
+func (this *context) SkipField() {
+	this.skipping = true
+}
+
+func (this *context) AbortStruct() {
+	this.skipping = true
+	this.aborting = true
+}
+
+func (this *context) NamedArguments() map[string]interface{} {
+	return this.namedArguments
+}
+
+func (this *context) SiblingValue(name string) (*core.NormalizedValue, error) {
+	field, err := core.GetSiblingField(this.source, name)
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if len(args) > 0 {
-		message = fmt.Sprintf(message, args...)
+	normalized, err := this.validator.registry.Normalize(field)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return errors.New(message)
+	return &normalized, nil
+}
+
+func (this *context) NewError(localeKey string, args ...interface{}) error {
+	return core.NewMessageError(this.translator, localeKey, args...)
 }
 
 func (this *context) setValue(normalized *core.NormalizedValue) {
@@ -80,3 +439,7 @@ func (this *context) setSource(source interface{}) {
 func (this *context) setField(field *core.ReflectedField) {
 	this.field = field
 }
+
+func (this *context) setNamedArguments(args map[string]interface{}) {
+	this.namedArguments = args
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"reflect"
+)
+
+// TypeAdapter converts a value of a type that validators don't understand, i.e. a decimal of a third party package,
+// into a value that they do, i.e. a float64 or string. Returns false if the value can't be converted, in which case
+// the value is validated as is.
+type TypeAdapter func(value interface{}) (interface{}, bool)
+
+// RegisterTypeAdapter registers the adapter of a type. Values of the type, and pointers to it, are converted by the
+// adapter before they're normalized.
+func (r *ValidatorRegistry) RegisterTypeAdapter(reflectedType reflect.Type, adapter TypeAdapter) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.adapters[reflectedType] = adapter
+}
+
+// GetTypeAdapter returns the adapter of a type, if registered.
+func (r *ValidatorRegistry) GetTypeAdapter(reflectedType reflect.Type) (TypeAdapter, bool) {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		adapter, ok := registry.adapters[reflectedType]
+		registry.lock.RUnlock()
+
+		if ok {
+			return adapter, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *ValidatorRegistry) hasTypeAdapters() bool {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		count := len(registry.adapters) + len(registry.options)
+		registry.lock.RUnlock()
+
+		if count > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Normalize normalizes a value like NormalizeReflected, but converts values of types with adapters and option types
+// first.
+func (r *ValidatorRegistry) Normalize(reflectedValue reflect.Value) (NormalizedValue, error) {
+	if !reflectedValue.IsValid() || !r.hasTypeAdapters() {
+		return NormalizeReflected(reflectedValue)
+	}
+
+	isNil := false
+	adaptedValue := reflectedValue
+
+	for {
+		if adapter, ok := r.GetOptionAdapter(adaptedValue.Type()); ok && adaptedValue.CanInterface() {
+			value, valid := adapter(adaptedValue.Interface())
+			normalized, err := normalizeInternal(value, isNil || !valid)
+
+			if err != nil {
+				return NormalizedValue{}, err
+			}
+
+			return *normalized, nil
+		}
+
+		if adapter, ok := r.GetTypeAdapter(adaptedValue.Type()); ok && adaptedValue.CanInterface() {
+			if value, ok := adapter(adaptedValue.Interface()); ok {
+				normalized, err := normalizeInternal(value, isNil)
+
+				if err != nil {
+					return NormalizedValue{}, err
+				}
+
+				return *normalized, nil
+			}
+			break
+		}
+
+		if adaptedValue.Kind() != reflect.Ptr {
+			break
+		}
+
+		// Adapt the zero value of nil pointers, and flag it as nil.
+		if adaptedValue.IsNil() {
+			isNil = true
+			adaptedValue = reflect.Zero(adaptedValue.Type().Elem())
+		} else {
+			adaptedValue = adaptedValue.Elem()
+		}
+	}
+
+	return NormalizeReflected(reflectedValue)
+}
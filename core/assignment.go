@@ -0,0 +1,82 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// AssignValue assigns value to target, converting it to the type of target. Pointers are allocated if they are nil.
+// Strings, numbers and booleans are converted between each other when possible, i.e. "10" or 10.0 can be assigned to an int.
+func AssignValue(target reflect.Value, value interface{}) error {
+	if target.Kind() == reflect.Ptr {
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return AssignValue(target.Elem(), value)
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(fmt.Sprint(value))
+		return nil
+
+	case reflect.Bool:
+		switch typedValue := value.(type) {
+		case bool:
+			target.SetBool(typedValue)
+			return nil
+		case string:
+			if boolValue, err := strconv.ParseBool(typedValue); err == nil {
+				target.SetBool(boolValue)
+				return nil
+			}
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if floatValue, ok := toAssignableFloat(value); ok && floatValue == math.Trunc(floatValue) {
+			if intValue := int64(floatValue); float64(intValue) == floatValue && !target.OverflowInt(intValue) {
+				target.SetInt(intValue)
+				return nil
+			}
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if floatValue, ok := toAssignableFloat(value); ok && floatValue >= 0 && floatValue == math.Trunc(floatValue) {
+			if uintValue := uint64(floatValue); float64(uintValue) == floatValue && !target.OverflowUint(uintValue) {
+				target.SetUint(uintValue)
+				return nil
+			}
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if floatValue, ok := toAssignableFloat(value); ok && !target.OverflowFloat(floatValue) {
+			target.SetFloat(floatValue)
+			return nil
+		}
+
+	default:
+		if reflectedValue := reflect.ValueOf(value); reflectedValue.IsValid() && reflectedValue.Type().AssignableTo(target.Type()) {
+			target.Set(reflectedValue)
+			return nil
+		}
+	}
+
+	return errors.New("Unable to assign value '" + fmt.Sprint(value) + "' to type '" + target.Type().String() + "'.")
+}
+
+func toAssignableFloat(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, true
+	case int64:
+		return float64(typedValue), true
+	case string:
+		if floatValue, err := strconv.ParseFloat(typedValue, 64); err == nil {
+			return floatValue, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,54 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"reflect"
+	"testing"
+)
+
+func TestThatAssignValueConvertsNumbers(t *testing.T) {
+	var intValue int8
+	var uintValue uint
+	var floatValue float32
+
+	if err := AssignValue(reflect.ValueOf(&intValue).Elem(), float64(10)); err != nil || intValue != 10 {
+		t.Fatalf("Expected 10, got %d (%v).", intValue, err)
+	}
+
+	if err := AssignValue(reflect.ValueOf(&uintValue).Elem(), "20"); err != nil || uintValue != 20 {
+		t.Fatalf("Expected 20, got %d (%v).", uintValue, err)
+	}
+
+	if err := AssignValue(reflect.ValueOf(&floatValue).Elem(), float64(1.5)); err != nil || floatValue != 1.5 {
+		t.Fatalf("Expected 1.5, got %f (%v).", floatValue, err)
+	}
+}
+
+func TestThatAssignValueFailsForInvalidNumbers(t *testing.T) {
+	var intValue int8
+	var uintValue uint
+
+	if err := AssignValue(reflect.ValueOf(&intValue).Elem(), float64(1000)); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if err := AssignValue(reflect.ValueOf(&intValue).Elem(), float64(1.5)); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if err := AssignValue(reflect.ValueOf(&uintValue).Elem(), float64(-1)); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatAssignValueAllocatesNilPointers(t *testing.T) {
+	var stringValue *string
+
+	if err := AssignValue(reflect.ValueOf(&stringValue).Elem(), "test"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if stringValue == nil || *stringValue != "test" {
+		t.Fatalf("Expected 'test', got %v.", stringValue)
+	}
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+)
+
+// BatchValidatorFn validates the values of all fields that use a batch validator with the same arguments at once,
+// i.e. a single query that checks the uniqueness of all values of a slice of records. Returns an error for each value,
+// in the order of values, that is nil if the value is valid. If an error is returned instead, then all values fail.
+type BatchValidatorFn func(ctx context.Context, values []interface{}, args []interface{}) ([]error, error)
+
+// RegisterBatch registers a validator by name that is run once per validation, after the other validators, with the
+// values of all fields that use it.
+func (r *ValidatorRegistry) RegisterBatch(name string, validator BatchValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = deferredValidator
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	r.batches[name] = validator
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.schemas, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
+}
+
+// GetBatch returns the batch validator with name, if registered.
+func (r *ValidatorRegistry) GetBatch(name string) (BatchValidatorFn, bool) {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	validator, ok := registry.batches[name]
+	return validator, ok
+}
+
+// Lookup returns the validator with name like Get, and the batch validator with name if it was registered with
+// RegisterBatch, with a single lock.
+func (r *ValidatorRegistry) Lookup(name string) (ValidatorFn, BatchValidatorFn, error) {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	validator, ok := registry.validators[name]
+	batch := registry.batches[name]
+	registry.lock.RUnlock()
+
+	if !ok {
+		return nil, nil, notRegisteredError(name)
+	}
+
+	return validator, batch, nil
+}
+
+// NewBatchError returns an error of a batch validator from a locale key, which is translated by the translator of
+// the validation, i.e. `NewBatchError("unique.mustBeUnique")`.
+func NewBatchError(key string, args ...interface{}) error {
+	return &MessageError{Key: key, Args: args}
+}
+
+// deferredValidator stands in for batch validators, which are not run with the other validators of a field.
+func deferredValidator(context ValidatorContext, args []interface{}) error {
+	return nil
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sync"
+)
+
+// FieldCache caches the reflected fields of struct types, so that tags only has to be parsed once per type.
+// It's safe for concurrent use.
+type FieldCache struct {
+	tags                []Tag
+	displayNameResolver DisplayNameResolver
+	registry            *ValidatorRegistry
+	includeUnexported   bool
+	fields              sync.Map
+}
+
+// NewFieldCache creates a cache of struct fields.
+func NewFieldCache(tagName string, displayNameResolver DisplayNameResolver) *FieldCache {
+	return NewFieldCacheWithRegistry(tagName, displayNameResolver, nil)
+}
+
+// NewFieldCacheWithRegistry creates a cache of struct fields. If registry is not nil, then aliases of the registry are
+// expanded and arguments are converted by the schemas of its validators.
+func NewFieldCacheWithRegistry(tagName string, displayNameResolver DisplayNameResolver, registry *ValidatorRegistry) *FieldCache {
+	return NewFieldCacheWithTags([]Tag{{Name: tagName}}, displayNameResolver, registry)
+}
+
+// NewFieldCacheWithTags creates a cache of struct fields with the rules of several tags, i.e. `binding` and
+// `validate`. The rules of all tags are required to pass.
+func NewFieldCacheWithTags(tags []Tag, displayNameResolver DisplayNameResolver, registry *ValidatorRegistry) *FieldCache {
+	return &FieldCache{
+		tags:                tags,
+		displayNameResolver: displayNameResolver,
+		registry:            registry,
+	}
+}
+
+// IncludeUnexported makes the cache reflect the unexported fields of struct types, in addition to their exported
+// fields. It must be called before the cache is used.
+func (this *FieldCache) IncludeUnexported() {
+	this.includeUnexported = true
+}
+
+// GetStructFields retrieves the reflected fields of a struct (or pointer to struct) from the cache, or reflects
+// and caches them if they haven't been reflected before. The cached fields are shared and must not be modified.
+func (this *FieldCache) GetStructFields(value interface{}) ([]*ReflectedField, error) {
+	reflectedType, err := structTypeOf(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return this.GetTypeFields(reflectedType)
+}
+
+// GetTypeFields retrieves the reflected fields of a struct type from the cache, like GetStructFields.
+func (this *FieldCache) GetTypeFields(reflectedType reflect.Type) ([]*ReflectedField, error) {
+	if cachedFields, ok := this.fields.Load(reflectedType); ok {
+		return cachedFields.([]*ReflectedField), nil
+	}
+
+	fields, err := getTypeFields(reflectedType, this.tags, this.displayNameResolver, this.includeUnexported)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if this.registry != nil {
+		if err := mergeFieldRules(fields, this.registry.GetFieldRules(reflectedType)); err != nil {
+			return nil, err
+		}
+
+		for _, field := range fields {
+			if label, ok := GetLabel(field.MethodGroups); ok {
+				field.DisplayName = &label
+			}
+
+			if field.MethodGroups, err = this.registry.ExpandAliases(field.MethodGroups); err != nil {
+				return nil, errors.New("Unable to expand aliases of field '" + field.Name + "'. " + err.Error())
+			}
+
+			if field.MethodGroups, err = this.registry.ConvertArguments(field.MethodGroups); err != nil {
+				return nil, errors.New("Unable to parse validators of field '" + field.Name + "'. " + err.Error())
+			}
+		}
+	}
+
+	cachedFields, _ := this.fields.LoadOrStore(reflectedType, fields)
+
+	return cachedFields.([]*ReflectedField), nil
+}
+
+// mergeFieldRules merges programmatically registered rules with the rules of the field tags. Both are required to
+// pass, so every group of the tag is combined with every group of the rules.
+func mergeFieldRules(fields []*ReflectedField, fieldRules map[string][]string) error {
+	for fieldName, rules := range fieldRules {
+		var field *ReflectedField
+
+		for _, reflectedField := range fields {
+			if reflectedField.Name == fieldName {
+				field = reflectedField
+				break
+			}
+		}
+
+		if field == nil {
+			return errors.New("Unable to register rules of field '" + fieldName + "'. Field does not exist.")
+		}
+
+		for _, rule := range rules {
+			methodGroups, err := parser.Parse(rule)
+
+			if err != nil {
+				return errors.New("Unable to parse rules of field '" + fieldName + "'. " + err.Error())
+			}
+
+			field.MethodGroups = combineMethodGroups(field.MethodGroups, methodGroups)
+		}
+	}
+
+	return nil
+}
+
+func combineMethodGroups(groupsA []parser.Methods, groupsB []parser.Methods) []parser.Methods {
+	if len(groupsA) == 0 {
+		return groupsB
+	}
+
+	if len(groupsB) == 0 {
+		return groupsA
+	}
+
+	var combinedGroups []parser.Methods
+
+	for _, methodsA := range groupsA {
+		for _, methodsB := range groupsB {
+			methods := make(parser.Methods, 0, len(methodsA)+len(methodsB))
+			methods = append(methods, methodsA...)
+			methods = append(methods, methodsB...)
+			combinedGroups = append(combinedGroups, methods)
+		}
+	}
+
+	return combinedGroups
+}
+
+// Len returns the number of struct types in the cache.
+func (this *FieldCache) Len() int {
+	var length int
+
+	this.fields.Range(func(key, value interface{}) bool {
+		length++
+		return true
+	})
+
+	return length
+}
@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"testing"
+)
+
+func TestThatFieldCacheReturnsSameFieldsForSameType(t *testing.T) {
+	type Foo struct {
+		Value string `validate:"not_empty"`
+	}
+
+	cache := NewFieldCache("validate", nil)
+
+	fieldsA, err := cache.GetStructFields(&Foo{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	fieldsB, err := cache.GetStructFields(Foo{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	if len(fieldsA) != 1 || len(fieldsB) != 1 || fieldsA[0] != fieldsB[0] {
+		t.Fatal("Expected cached fields to be the same, but they weren't.")
+	}
+
+	if cache.Len() != 1 {
+		t.Fatalf("Expected 1 cached type, but got %d.", cache.Len())
+	}
+}
+
+func TestThatFieldCacheDoesNotCacheInvalidTags(t *testing.T) {
+	type Foo struct {
+		Value string `validate:"not_empty("`
+	}
+
+	cache := NewFieldCache("validate", nil)
+
+	if _, err := cache.GetStructFields(&Foo{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if cache.Len() != 0 {
+		t.Fatalf("Expected no cached types, but got %d.", cache.Len())
+	}
+}
+
+func TestThatFieldCacheWithRegistryExpandsAliases(t *testing.T) {
+	type Foo struct {
+		Value string `validate:"name"`
+	}
+
+	registry := NewValidatorRegistry()
+
+	if err := registry.RegisterAlias("name", "not_empty,max(30)"); err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	fields, err := NewFieldCacheWithRegistry("validate", nil, registry).GetStructFields(&Foo{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	if methods := fields[0].MethodGroups[0]; len(methods) != 2 || methods[0].Name != "not_empty" || methods[1].Name != "max" {
+		t.Fatalf("Expected expanded alias, but got %s.", methods)
+	}
+}
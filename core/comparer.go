@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+	"reflect"
+)
+
+// Comparer compares a value of a type that validators can't order, i.e. a decimal of a third party package, with an
+// argument of a tag, i.e. the `10.5` of `min(10.5)`. Returns a negative number if the value is less than the
+// argument, zero if they're equal and a positive number if it's greater, or false if the argument can't be compared
+// with the value.
+type Comparer func(value interface{}, argument interface{}) (int, bool)
+
+// RegisterComparer registers the comparer of a type, which is used by `min`, `max` and `between` to compare values
+// of the type. Only types whose values aren't normalized to strings or numbers can have comparers.
+func (r *ValidatorRegistry) RegisterComparer(reflectedType reflect.Type, comparer Comparer) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.comparers[reflectedType] = comparer
+}
+
+// GetComparer returns the comparer of a type, if registered.
+func (r *ValidatorRegistry) GetComparer(reflectedType reflect.Type) (Comparer, bool) {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		comparer, ok := registry.comparers[reflectedType]
+		registry.lock.RUnlock()
+
+		if ok {
+			return comparer, true
+		}
+	}
+
+	return nil, false
+}
+
+// HasComparers checks whether the registry, or one of its bases, has registered comparers.
+func (r *ValidatorRegistry) HasComparers() bool {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		count := len(registry.comparers)
+		registry.lock.RUnlock()
+
+		if count > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+type comparersKey struct{}
+
+// WithComparers returns a context in which validators compare values with the comparers of registry.
+func WithComparers(ctx context.Context, registry *ValidatorRegistry) context.Context {
+	return context.WithValue(ctx, comparersKey{}, registry)
+}
+
+// ComparerOf returns the comparer of the type of value, if the registry of ctx has one.
+func ComparerOf(ctx context.Context, value interface{}) (Comparer, bool) {
+	registry, ok := ctx.Value(comparersKey{}).(*ValidatorRegistry)
+
+	if !ok || value == nil {
+		return nil, false
+	}
+
+	return registry.GetComparer(reflect.TypeOf(value))
+}
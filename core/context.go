@@ -1,10 +1,15 @@
 package core
 
 import (
+	"context"
 	"reflect"
 )
 
 type ValidatorContext interface {
+	// Context returns the context.Context passed to ValidateCtx, or context.Background() if Validate was used.
+	// Long running validators should honor its deadline and cancellation.
+	Context() context.Context
+
 	// Source returns the object from which the field is referenced from.
 	Source() interface{}
 
@@ -26,7 +31,29 @@ type ValidatorContext interface {
 	// I.e. if the type of the value set was *int8, then the OriginalKind would be int8.
 	OriginalKind() reflect.Kind
 
+	// NamedArguments returns the named arguments of the validator, i.e. `min` and `max` of `between(min=1,max=10)`.
+	// Positional arguments are passed to the validator as args.
+	NamedArguments() map[string]interface{}
+
+	// SiblingValue returns the normalized value of another field, by name, of the struct that the field belongs to.
+	// Returns error if the field does not exist.
+	SiblingValue(name string) (*NormalizedValue, error)
+
+	// SkipField skips the remaining validators of the field, i.e. `omitempty` when the value is empty. The field passes,
+	// unless a validator of the field has already failed. Nested structs of the field are still validated.
+	SkipField()
+
+	// AbortStruct skips the remaining validators of the field, and the remaining fields of the struct that the field
+	// belongs to. Nested structs of the field and ValidateStruct of the struct are not validated.
+	AbortStruct()
+
 	// NewError returns a formatted error based on a locale key and format arguments.
 	// If the locale key does not exist, then an error is returned.
 	NewError(localeKey string, args ...interface{}) error
 }
+
+// Validatable can be implemented by structures to validate invariants that span multiple fields.
+// ValidateStruct is called after the validators of the fields of the structure has been run.
+type Validatable interface {
+	ValidateStruct(context ValidatorContext) error
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sort"
+)
+
+// Description documents a validator or alias of a registry, i.e. for generated documentation, linters and editor
+// tooling.
+type Description struct {
+	Name string
+
+	// Summary describes what the validator validates, i.e. "Validates that a string is an email address.".
+	Summary string
+
+	// Usage shows the arguments of the validator, i.e. `min(n)`.
+	Usage string
+
+	// Kinds are the kinds of normalized values that the validator supports, i.e. reflect.Int64 for integers and
+	// reflect.Struct for time.Time. Empty if the validator supports any kind.
+	Kinds []reflect.Kind
+
+	// Schema is the argument schema that the validator was registered with, if any.
+	Schema *ArgumentSchema
+
+	// Alias holds the validators of an alias, and is nil for validators.
+	Alias parser.Methods
+
+	Transformer bool
+	Converter   bool
+	Warning     bool
+	NilHandler  bool
+	Batch       bool
+	Pure        bool
+}
+
+// SetDescription documents a registered validator by name. The schema and flags of the description are set by the
+// registry when it's described, and the description is removed if the validator is registered again.
+func (r *ValidatorRegistry) SetDescription(name string, description Description) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	description.Name = name
+	r.descriptions[name] = description
+}
+
+// Describe returns the descriptions of all validators and aliases of the registry and its base registries, sorted by
+// name. Validators without descriptions are described by their schema and flags only.
+func (r *ValidatorRegistry) Describe() []Description {
+	described := make(map[string]Description)
+
+	// Layers are described from the base up, so that validators override those of their base.
+	var layers []*ValidatorRegistry
+
+	for registry := r; registry != nil; registry = registry.base {
+		layers = append([]*ValidatorRegistry{registry}, layers...)
+	}
+
+	for _, registry := range layers {
+		registry.describeInto(described)
+	}
+
+	descriptions := make([]Description, 0, len(described))
+
+	for _, description := range described {
+		descriptions = append(descriptions, description)
+	}
+
+	sort.Slice(descriptions, func(i, j int) bool {
+		return descriptions[i].Name < descriptions[j].Name
+	})
+
+	return descriptions
+}
+
+func (r *ValidatorRegistry) describeInto(described map[string]Description) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for name := range r.validators {
+		description := r.descriptions[name]
+		description.Name = name
+		description.Schema = r.schemas[name]
+		description.Transformer = r.transforms[name]
+		description.Converter = r.converters[name]
+		description.Warning = r.warnings[name]
+		description.NilHandler = r.nilHandlers[name]
+		description.Batch = r.batches[name] != nil
+		description.Pure = r.pure[name]
+		described[name] = description
+	}
+
+	for name, methods := range r.aliases {
+		description := r.descriptions[name]
+		description.Name = name
+		description.Alias = methods
+		described[name] = description
+	}
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"encoding/json"
+)
+
+// jsonError is the JSON representation of an Error. Plain errors have no field or code, and only warnings have a
+// severity.
+type jsonError struct {
+	Field    string `json:"field,omitempty"`
+	Code     string `json:"code,omitempty"`
+	Message  string `json:"message"`
+	Severity string `json:"severity,omitempty"`
+}
+
+// MarshalJSON encodes the error as `{"field":"Name","code":"min","message":"Name cannot be shorter than 3 characters."}`.
+func (this *Error) MarshalJSON() ([]byte, error) {
+	encoded := jsonError{
+		Field:   this.GetFieldName(),
+		Code:    this.GetValidatorName(),
+		Message: this.Error(),
+	}
+
+	if this.IsWarning() {
+		encoded.Severity = this.severity.String()
+	}
+
+	return json.Marshal(encoded)
+}
+
+// MarshalJSON encodes the list as an array of errors. An empty list is encoded as `[]`, rather than `null`.
+func (this ErrorList) MarshalJSON() ([]byte, error) {
+	if this == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]*Error(this))
+}
+
+// JSON encodes the list as an array of errors, see Error.MarshalJSON.
+func (this ErrorList) JSON() ([]byte, error) {
+	return json.Marshal(this)
+}
+
+// Map returns the messages of the list by the full name of the field they belong to, i.e. `Address.City`.
+// Messages of plain errors are keyed by an empty string.
+func (this ErrorList) Map() map[string][]string {
+	messages := make(map[string][]string)
+
+	for _, err := range this {
+		fieldName := err.GetFieldName()
+		messages[fieldName] = append(messages[fieldName], err.Error())
+	}
+
+	return messages
+}
+
+// Unwrap returns the error that caused the error, so that errors.Is and errors.As can be used to find it.
+func (this *Error) Unwrap() error {
+	return this.src
+}
+
+// Is checks whether the error is an error of the validator of target, if target is a ValidatorError, so that
+// errors.Is can be used to find the errors of a validator, i.e. `errors.Is(err, validator.ErrNotEmpty)`.
+func (this *Error) Is(target error) bool {
+	validatorErr, ok := target.(ValidatorError)
+	return ok && this.IsFieldError() && this.validator.Name == string(validatorErr)
+}
+
+// ValidatorError matches the errors of a validator by its name with errors.Is, i.e. `ValidatorError("not_empty")`.
+type ValidatorError string
+
+func (this ValidatorError) Error() string {
+	return "Validator '" + string(this) + "' failed."
+}
+
+// Unwrap returns the errors of the list, so that errors.Is and errors.As can be used to find errors in the list.
+func (this ErrorList) Unwrap() []error {
+	errs := make([]error, len(this))
+
+	for i, err := range this {
+		errs[i] = err
+	}
+
+	return errs
+}
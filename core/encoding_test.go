@@ -0,0 +1,94 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func newEncodingErrorList() ErrorList {
+	var errs ErrorList
+	errs.Add(NewError(&ReflectedField{Name: "City", Parent: &ReflectedField{Name: "Address"}}, &parser.Method{Name: "min"}, errors.New("{field} is too short.")))
+	errs.Add(NewError(&ReflectedField{Name: "City", Parent: &ReflectedField{Name: "Address"}}, &parser.Method{Name: "lowercase"}, errors.New("{field} must be lower case.")))
+	errs.AddPlain(context.Canceled)
+	return errs
+}
+
+func TestThatErrorListIsEncodedAsJSON(t *testing.T) {
+	data, err := newEncodingErrorList().JSON()
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := `[{"field":"Address.City","code":"min","message":"Address.City is too short."},` +
+		`{"field":"Address.City","code":"lowercase","message":"Address.City must be lower case."},` +
+		`{"message":"context canceled"}]`
+
+	if string(data) != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, data)
+	}
+}
+
+func TestThatEmptyErrorListIsEncodedAsEmptyArray(t *testing.T) {
+	var errs ErrorList
+
+	if data, _ := errs.JSON(); string(data) != "[]" {
+		t.Fatalf("Expected '[]', got '%s'.", data)
+	}
+}
+
+func TestThatErrorListMapGroupsMessagesByField(t *testing.T) {
+	messages := newEncodingErrorList().Map()
+
+	if len(messages["Address.City"]) != 2 || messages["Address.City"][1] != "Address.City must be lower case." {
+		t.Fatalf("Expected 2 messages for 'Address.City', got %v.", messages)
+	}
+
+	if len(messages[""]) != 1 {
+		t.Fatalf("Expected 1 plain message, got %v.", messages)
+	}
+}
+
+func TestThatErrorListCanBeUnwrapped(t *testing.T) {
+	var err error = newEncodingErrorList()
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatal("Expected error list to contain context.Canceled.")
+	}
+
+	var fieldErr *Error
+
+	if !errors.As(err, &fieldErr) || fieldErr.GetValidatorName() != "min" {
+		t.Fatalf("Expected first error to be found, got %v.", fieldErr)
+	}
+}
+
+func TestThatErrorsOfValidatorsCanBeFound(t *testing.T) {
+	var err error = newEncodingErrorList()
+
+	if !errors.Is(err, ValidatorError("min")) {
+		t.Fatal("Expected error list to contain error of 'min'.")
+	}
+
+	if errors.Is(err, ValidatorError("max")) {
+		t.Fatal("Didn't expect error list to contain error of 'max'.")
+	}
+}
+
+func TestThatWarningsAreEncodedWithSeverity(t *testing.T) {
+	warning := NewError(&ReflectedField{Name: "Phone"}, &parser.Method{Name: "deprecated_format"}, errors.New("{field} uses a deprecated format."))
+	warning.SetSeverity(SeverityWarning)
+
+	data, err := warning.MarshalJSON()
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if expected := `{"field":"Phone","code":"deprecated_format","message":"Phone uses a deprecated format.","severity":"warning"}`; string(data) != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, data)
+	}
+}
@@ -6,10 +6,29 @@ import (
 	"strings"
 )
 
+// Severity indicates whether an error fails validation, or is only reported as a warning.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (this Severity) String() string {
+	if this == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
 type Error struct {
 	field     *ReflectedField
 	validator *parser.Method
 	src       error
+	severity  Severity
+
+	value        interface{}
+	alternatives ErrorList
 }
 
 func NewError(field *ReflectedField, validator *parser.Method, err error) *Error {
@@ -51,17 +70,67 @@ func (this *Error) GetValidatorName() string {
 	return this.validator.Name
 }
 
+// Alternatives returns the errors of the other validator groups of the field, if all of them failed.
+// I.e. for `empty|email`, the error of `email` would have the error of `empty` as an alternative.
+func (this *Error) Alternatives() ErrorList {
+	return this.alternatives
+}
+
+func (this *Error) SetAlternatives(errs ErrorList) {
+	this.alternatives = errs
+}
+
+// Value returns the value of the field that failed validation.
+func (this *Error) Value() interface{} {
+	return this.value
+}
+
+func (this *Error) SetValue(value interface{}) {
+	this.value = value
+}
+
+// Severity returns whether the error fails validation, or is a warning.
+func (this *Error) Severity() Severity {
+	return this.severity
+}
+
+func (this *Error) SetSeverity(severity Severity) {
+	this.severity = severity
+}
+
+// IsWarning checks whether the error is a warning, which doesn't fail validation.
+func (this *Error) IsWarning() bool {
+	return this.severity == SeverityWarning
+}
+
 func (this *Error) String() string {
 	return this.Error()
 }
 
 func (this *Error) Error() string {
+	return this.format(this.src.Error())
+}
+
+// Translate returns the error message translated by the translator. Only errors that were created from a locale
+// key (i.e. by ValidatorContext.NewError) can be translated, other errors return the same message as Error().
+func (this *Error) Translate(translator Translator) string {
+	if messageErr, ok := this.src.(*MessageError); ok {
+		if message, err := translator.Translate(messageErr.Key, messageErr.Args...); err == nil {
+			return this.format(message)
+		}
+	}
+	return this.Error()
+}
+
+func (this *Error) format(message string) string {
 	if this.IsFieldError() {
-		message := strings.Replace(this.src.Error(), "{field}", this.GetFieldDisplayName(), 1)
-		message = strings.Replace(message, "{validator}", this.GetValidatorName(), 1)
-		return message
+		if this.field.ErrorMessage != nil {
+			message = *this.field.ErrorMessage
+		}
+
+		return formatMessage(message, this)
 	} else {
-		return this.src.Error()
+		return message
 	}
 }
 
@@ -109,14 +178,71 @@ func (this ErrorList) WithValidator(validatorTagName string) ErrorList {
 	return errs
 }
 
+// ByField groups the field errors of the list by the full name of the field they belong to.
+// Plain errors are not part of the result.
+func (this ErrorList) ByField() map[string]ErrorList {
+	fields := make(map[string]ErrorList)
+
+	for _, err := range this {
+		if err.IsFieldError() {
+			fieldName := err.GetFieldName()
+			errs := fields[fieldName]
+			errs.Add(err)
+			fields[fieldName] = errs
+		}
+	}
+
+	return fields
+}
+
+// Errors returns the errors of the list that fail validation.
+func (this ErrorList) Errors() ErrorList {
+	var errs ErrorList
+
+	for _, err := range this {
+		if !err.IsWarning() {
+			errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+// Warnings returns the warnings of the list, which don't fail validation.
+func (this ErrorList) Warnings() ErrorList {
+	var errs ErrorList
+
+	for _, err := range this {
+		if err.IsWarning() {
+			errs.Add(err)
+		}
+	}
+
+	return errs
+}
+
+// Any checks whether the list contains errors that fail validation. Warnings are not counted.
 func (this ErrorList) Any() bool {
-	return len(this) > 0
+	for _, err := range this {
+		if !err.IsWarning() {
+			return true
+		}
+	}
+	return false
 }
 
+// First returns the first error of the list, or the first warning if the list only contains warnings.
 func (this ErrorList) First() *Error {
-	if this.Any() {
+	for _, err := range this {
+		if !err.IsWarning() {
+			return err
+		}
+	}
+
+	if len(this) > 0 {
 		return this[0]
 	}
+
 	return nil
 }
 
@@ -124,6 +250,18 @@ func (this ErrorList) Length() int {
 	return len(this)
 }
 
+// Error returns the messages of all errors in the list, separated by newlines. This allows the list to be
+// returned as an error.
+func (this ErrorList) Error() string {
+	messages := make([]string, len(this))
+
+	for i, err := range this {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
 func (this ErrorList) PrintAll() {
 	for _, err := range this {
 		fmt.Println(err)
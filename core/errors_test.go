@@ -4,6 +4,7 @@ import (
 	"errors"
 	. "github.com/typerandom/validator/core"
 	"github.com/typerandom/validator/core/parser"
+	"strings"
 	"testing"
 )
 
@@ -166,3 +167,138 @@ func TestThatErrorListForFieldOnlyReturnsTheSpecifiedField(t *testing.T) {
 		t.Fatalf("Expected one error, but got %d.", len(userFieldFirstNameErrors))
 	}
 }
+
+func TestThatErrorListByFieldGroupsErrorsByFieldName(t *testing.T) {
+	var errs ErrorList
+
+	parentField := &ReflectedField{Name: "User"}
+	firstNameField := &ReflectedField{Parent: parentField, Name: "FirstName"}
+	lastNameField := &ReflectedField{Parent: parentField, Name: "LastName"}
+
+	errs.Add(NewPlainError(errors.New("Ooops.")))
+	errs.Add(NewError(firstNameField, &parser.Method{Name: "not_empty"}, errors.New("")))
+	errs.Add(NewError(firstNameField, &parser.Method{Name: "min"}, errors.New("")))
+	errs.Add(NewError(lastNameField, &parser.Method{Name: "not_empty"}, errors.New("")))
+
+	fields := errs.ByField()
+
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, but got %d.", len(fields))
+	}
+
+	if len(fields["User.FirstName"]) != 2 {
+		t.Fatalf("Expected 2 errors for 'User.FirstName', but got %d.", len(fields["User.FirstName"]))
+	}
+
+	if len(fields["User.LastName"]) != 1 {
+		t.Fatalf("Expected 1 error for 'User.LastName', but got %d.", len(fields["User.LastName"]))
+	}
+}
+
+func TestThatFieldErrorMessageCanBeOverridden(t *testing.T) {
+	message := "{field} is too short, it fails {validator}."
+	field := &ReflectedField{Name: "Password", ErrorMessage: &message}
+
+	err := NewError(field, &parser.Method{Name: "min"}, errors.New("Password cannot be shorter than 8 characters."))
+
+	if expectedErr := "Password is too short, it fails min."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+}
+
+func TestThatFieldErrorMessagePlaceholdersAreReplaced(t *testing.T) {
+	field := &ReflectedField{Name: "Age", StructName: "User"}
+	validator := &parser.Method{Name: "min", Arguments: parser.Arguments{float64(18)}}
+
+	err := NewError(field, validator, errors.New("{struct}.{field} is {value}, {validator} is {arg0}. {field} {arg1} {unknown} {"))
+	err.SetValue(int64(17))
+
+	if expectedErr := "User.Age is 17, min is 18. Age {arg1} {unknown} {"; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+}
+
+func TestThatFieldErrorMessagePlaceholdersAreNotReplacedWithinValues(t *testing.T) {
+	field := &ReflectedField{Name: "Name"}
+
+	err := NewError(field, &parser.Method{Name: "max"}, errors.New("{field} cannot be '{value}'."))
+	err.SetValue("{field}")
+
+	if expectedErr := "Name cannot be '{field}'."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+}
+
+func TestThatCustomPlaceholderCanBeRegistered(t *testing.T) {
+	RegisterPlaceholder("upperField", func(err *Error) string {
+		return strings.ToUpper(err.GetFieldName())
+	})
+
+	err := NewError(&ReflectedField{Name: "Name"}, &parser.Method{Name: "max"}, errors.New("{upperField} is invalid."))
+
+	if expectedErr := "NAME is invalid."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+}
+
+func TestThatFieldErrorCanBeTranslated(t *testing.T) {
+	english := NewLocale()
+	english.Set("min", "{field} cannot be less than %v.")
+
+	swedish := NewLocale()
+	swedish.Set("min", "{field} får inte vara mindre än %v.")
+
+	err := NewError(&ReflectedField{Name: "Age"}, &parser.Method{Name: "min"}, NewMessageError(english, "min", 18))
+
+	if expectedErr := "Age cannot be less than 18."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+
+	if expectedErr := "Age får inte vara mindre än 18."; err.Translate(swedish) != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err.Translate(swedish))
+	}
+
+	if expectedErr := "Age cannot be less than 18."; err.Translate(NewLocale()) != expectedErr {
+		t.Fatalf("Expected untranslatable error to fall back to '%s', got '%s'.", expectedErr, err.Translate(NewLocale()))
+	}
+}
+
+func newSeverityErrorList() ErrorList {
+	var errs ErrorList
+
+	warning := NewError(&ReflectedField{Name: "Phone"}, &parser.Method{Name: "deprecated_format"}, errors.New("{field} uses a deprecated format."))
+	warning.SetSeverity(SeverityWarning)
+
+	errs.Add(warning)
+	errs.Add(NewError(&ReflectedField{Name: "Name"}, &parser.Method{Name: "min"}, errors.New("{field} is too short.")))
+
+	return errs
+}
+
+func TestThatErrorListSeparatesErrorsAndWarnings(t *testing.T) {
+	errs := newSeverityErrorList()
+
+	if errs.Errors().Length() != 1 || errs.Errors().First().GetValidatorName() != "min" {
+		t.Fatalf("Expected 1 error of 'min', got %d errors.", errs.Errors().Length())
+	}
+
+	if errs.Warnings().Length() != 1 || !errs.Warnings().First().IsWarning() {
+		t.Fatalf("Expected 1 warning, got %d warnings.", errs.Warnings().Length())
+	}
+
+	if name := errs.First().GetValidatorName(); name != "min" {
+		t.Fatalf("Expected first error to be of 'min', got '%s'.", name)
+	}
+}
+
+func TestThatErrorListOfWarningsHasNoErrors(t *testing.T) {
+	errs := newSeverityErrorList().Warnings()
+
+	if errs.Any() {
+		t.Fatal("Expected warnings not to count as errors, but they did.")
+	}
+
+	if errs.First() == nil || !errs.First().IsWarning() {
+		t.Fatal("Expected first of warnings to be a warning, but it wasn't.")
+	}
+}
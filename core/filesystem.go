@@ -0,0 +1,18 @@
+package core
+
+import (
+	"context"
+)
+
+type filesystemAccessKey struct{}
+
+// WithFilesystemAccess returns a context that allows validators to access the filesystem, i.e. `file_exists`.
+func WithFilesystemAccess(ctx context.Context) context.Context {
+	return context.WithValue(ctx, filesystemAccessKey{}, true)
+}
+
+// HasFilesystemAccess checks whether validators are allowed to access the filesystem.
+func HasFilesystemAccess(ctx context.Context) bool {
+	allowed, _ := ctx.Value(filesystemAccessKey{}).(bool)
+	return allowed
+}
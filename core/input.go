@@ -0,0 +1,98 @@
+package core
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	// ErrNilValue is the error of validating nil, or a nil pointer.
+	ErrNilValue = errors.New("Unable to validate nil value.")
+
+	// ErrNotAStruct is matched by the errors of validating values that aren't structs, or arrays, slices or maps of
+	// them, i.e. an int or a string.
+	ErrNotAStruct = errors.New("Unable to validate value that isn't a struct.")
+)
+
+// kindError is the error of validating a value of a kind that can't be walked. It's matched by ErrNotAStruct.
+type kindError struct {
+	kind reflect.Kind
+}
+
+func (this *kindError) Error() string {
+	return "Unable to directly validate type '" + this.kind.String() + "'."
+}
+
+func (this *kindError) Is(target error) bool {
+	return target == ErrNotAStruct
+}
+
+// NewInputError returns the error of validating a value of kind directly, ErrNilValue for reflect.Invalid (nil).
+func NewInputError(kind reflect.Kind) error {
+	if kind == reflect.Invalid {
+		return ErrNilValue
+	}
+	return &kindError{kind: kind}
+}
+
+// CheckInput checks whether value can be validated. Structs, and arrays, slices and maps, whose items are validated,
+// can be validated directly or by pointer. Nil values and nil pointers fail with ErrNilValue, other values fail with
+// an error that is matched by ErrNotAStruct.
+func CheckInput(value interface{}) error {
+	reflected := reflect.ValueOf(value)
+
+	for reflected.Kind() == reflect.Ptr || reflected.Kind() == reflect.Interface {
+		if reflected.IsNil() {
+			return ErrNilValue
+		}
+		reflected = reflected.Elem()
+	}
+
+	if !reflected.IsValid() {
+		return ErrNilValue
+	}
+
+	return checkType(reflected.Type())
+}
+
+// CheckInputType checks whether values of a type can be validated, like CheckInput. Pointer types are checked by the
+// types they point to.
+func CheckInputType(reflectedType reflect.Type) error {
+	if reflectedType == nil {
+		return ErrNilValue
+	}
+
+	return checkType(reflectedType)
+}
+
+// checkType checks whether values of a type are structs, or arrays, slices or maps of them. Items of interfaces are
+// checked once they're validated.
+func checkType(reflectedType reflect.Type) error {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+
+	switch reflectedType.Kind() {
+	case reflect.Struct, reflect.Interface:
+		return nil
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return checkType(reflectedType.Elem())
+	default:
+		return NewInputError(reflectedType.Kind())
+	}
+}
+
+// structTypeOf returns the struct type of a struct or pointer to struct.
+func structTypeOf(value interface{}) (reflect.Type, error) {
+	reflectedType := reflectValue(value)
+
+	if reflectedType == nil {
+		return nil, ErrNilValue
+	}
+
+	if reflectedType.Kind() != reflect.Struct {
+		return nil, NewInputError(reflectedType.Kind())
+	}
+
+	return reflectedType, nil
+}
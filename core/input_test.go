@@ -0,0 +1,101 @@
+package core_test
+
+import (
+	"errors"
+	. "github.com/typerandom/validator/core"
+	"reflect"
+	"testing"
+	"unsafe"
+)
+
+type inputDummy struct {
+	Name string
+}
+
+func TestThatInputIsClassifiedByKind(t *testing.T) {
+	var nilDummy *inputDummy
+	var nilError error
+	number := 1
+
+	for _, test := range []struct {
+		value    interface{}
+		expected error
+	}{
+		{nil, ErrNilValue},
+		{nilDummy, ErrNilValue},
+		{&nilDummy, ErrNilValue},
+		{nilError, ErrNilValue},
+		{inputDummy{}, nil},
+		{&inputDummy{}, nil},
+		{[]inputDummy{}, nil},
+		{[]*inputDummy{}, nil},
+		{[][]inputDummy{}, nil},
+		{[]interface{}{}, nil},
+		{[1]inputDummy{}, nil},
+		{map[string]inputDummy{}, nil},
+		{[]int(nil), ErrNotAStruct},
+		{[]int{1}, ErrNotAStruct},
+		{[][]string{}, ErrNotAStruct},
+		{[1]int{}, ErrNotAStruct},
+		{map[string]int{}, ErrNotAStruct},
+		{true, ErrNotAStruct},
+		{1, ErrNotAStruct},
+		{int8(1), ErrNotAStruct},
+		{int16(1), ErrNotAStruct},
+		{int32(1), ErrNotAStruct},
+		{int64(1), ErrNotAStruct},
+		{uint(1), ErrNotAStruct},
+		{uint8(1), ErrNotAStruct},
+		{uint16(1), ErrNotAStruct},
+		{uint32(1), ErrNotAStruct},
+		{uint64(1), ErrNotAStruct},
+		{uintptr(1), ErrNotAStruct},
+		{float32(1), ErrNotAStruct},
+		{float64(1), ErrNotAStruct},
+		{complex64(1), ErrNotAStruct},
+		{complex128(1), ErrNotAStruct},
+		{make(chan int), ErrNotAStruct},
+		{func() {}, ErrNotAStruct},
+		{"test", ErrNotAStruct},
+		{&number, ErrNotAStruct},
+		{unsafe.Pointer(&number), ErrNotAStruct},
+	} {
+		err := CheckInput(test.value)
+
+		if !errors.Is(err, test.expected) || (test.expected == nil && err != nil) {
+			t.Fatalf("Expected %v for %T, got %v.", test.expected, test.value, err)
+		}
+	}
+}
+
+func TestThatInputTypeIsClassifiedByKind(t *testing.T) {
+	if err := CheckInputType(nil); err != ErrNilValue {
+		t.Fatalf("Expected nil value error, got %v.", err)
+	}
+
+	if err := CheckInputType(reflect.TypeOf((**inputDummy)(nil))); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := CheckInputType(reflect.TypeOf("test")); !errors.Is(err, ErrNotAStruct) {
+		t.Fatalf("Expected not a struct error, got %v.", err)
+	}
+}
+
+func TestThatErrorOfInputThatIsntStructHasKind(t *testing.T) {
+	expected := "Unable to directly validate type 'int'."
+
+	if err := CheckInput(1); err.Error() != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, err)
+	}
+}
+
+func TestThatFieldsOfValuesThatArentStructsCantBeRetrieved(t *testing.T) {
+	if _, err := GetStructFields(nil, "validate", nil); err != ErrNilValue {
+		t.Fatalf("Expected nil value error, got %v.", err)
+	}
+
+	if _, err := GetStructFields([]inputDummy{}, "validate", nil); !errors.Is(err, ErrNotAStruct) {
+		t.Fatalf("Expected not a struct error, got %v.", err)
+	}
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"context"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LengthUnit decides how the length of strings is counted by length based validators, i.e. `min`, `max`, `length`
+// and `truncate`.
+type LengthUnit int
+
+const (
+	// ByteLength counts the bytes of strings, so that "héllo" has a length of 6. It's the default.
+	ByteLength LengthUnit = iota
+
+	// RuneLength counts the code points of strings, so that "héllo" has a length of 5.
+	RuneLength
+
+	// GraphemeLength counts user-perceived characters, so that a letter followed by combining accents, a flag or an
+	// emoji joined by zero width joiners have a length of 1. Grapheme clusters are approximated by attaching marks,
+	// variation selectors, emoji modifiers and joined characters to the preceding character, which is sufficient for
+	// most text, but does not combine i.e. Hangul jamo.
+	GraphemeLength
+)
+
+type lengthUnitKey struct{}
+
+// WithLengthUnit returns a context in which validators count the length of strings in unit.
+func WithLengthUnit(ctx context.Context, unit LengthUnit) context.Context {
+	return context.WithValue(ctx, lengthUnitKey{}, unit)
+}
+
+// GetLengthUnit returns the unit in which validators count the length of strings. Defaults to ByteLength.
+func GetLengthUnit(ctx context.Context) LengthUnit {
+	unit, _ := ctx.Value(lengthUnitKey{}).(LengthUnit)
+	return unit
+}
+
+// StringLength counts the length of a string in the length unit of ctx.
+func StringLength(ctx context.Context, value string) int {
+	switch GetLengthUnit(ctx) {
+	case RuneLength:
+		return utf8.RuneCountInString(value)
+	case GraphemeLength:
+		length := 0
+		for len(value) > 0 {
+			value = value[graphemeSize(value):]
+			length++
+		}
+		return length
+	}
+	return len(value)
+}
+
+// TruncateString truncates a string to at most length units of the length unit of ctx. Strings are never cut within
+// a character, so a string truncated by bytes may be shorter than length.
+func TruncateString(ctx context.Context, value string, length int) string {
+	offset := 0
+
+	switch GetLengthUnit(ctx) {
+	case RuneLength:
+		for i := 0; i < length && offset < len(value); i++ {
+			_, size := utf8.DecodeRuneInString(value[offset:])
+			offset += size
+		}
+	case GraphemeLength:
+		for i := 0; i < length && offset < len(value); i++ {
+			offset += graphemeSize(value[offset:])
+		}
+	default:
+		if len(value) <= length {
+			return value
+		}
+		offset = length
+		for offset > 0 && !utf8.RuneStart(value[offset]) {
+			offset--
+		}
+	}
+
+	return value[:offset]
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// graphemeSize returns the size in bytes of the grapheme cluster at the start of a non-empty string.
+func graphemeSize(value string) int {
+	char, size := utf8.DecodeRuneInString(value)
+
+	if char == '\r' && size < len(value) && value[size] == '\n' {
+		return size + 1
+	}
+
+	if unicode.IsControl(char) {
+		return size
+	}
+
+	if isRegionalIndicator(char) {
+		if next, nextSize := utf8.DecodeRuneInString(value[size:]); isRegionalIndicator(next) {
+			size += nextSize
+		}
+	}
+
+	joined := false
+
+	for size < len(value) {
+		next, nextSize := utf8.DecodeRuneInString(value[size:])
+
+		if !joined && !isGraphemeExtend(next) {
+			break
+		}
+
+		if joined && unicode.IsControl(next) {
+			break
+		}
+
+		joined = next == zeroWidthJoiner
+		size += nextSize
+	}
+
+	return size
+}
+
+// isGraphemeExtend checks whether a character extends the preceding character, i.e. a combining accent.
+func isGraphemeExtend(char rune) bool {
+	return char == zeroWidthJoiner || unicode.In(char, unicode.Mn, unicode.Me, unicode.Mc) ||
+		(char >= 0x1f3fb && char <= 0x1f3ff)
+}
+
+func isRegionalIndicator(char rune) bool {
+	return char >= 0x1f1e6 && char <= 0x1f1ff
+}
@@ -0,0 +1,69 @@
+package core_test
+
+import (
+	"context"
+	. "github.com/typerandom/validator/core"
+	"testing"
+)
+
+func TestThatStringLengthCountsBytesByDefault(t *testing.T) {
+	if length := StringLength(context.Background(), "héllo"); length != 6 {
+		t.Fatalf("Expected length 6, got %d.", length)
+	}
+
+	if unit := GetLengthUnit(context.Background()); unit != ByteLength {
+		t.Fatalf("Expected byte length, got %d.", unit)
+	}
+}
+
+func TestThatStringLengthCountsInLengthUnitOfContext(t *testing.T) {
+	tests := []struct {
+		value    string
+		unit     LengthUnit
+		expected int
+	}{
+		{"héllo", ByteLength, 6},
+		{"héllo", GraphemeLength, 5},
+		{"he\u0301llo", RuneLength, 6},
+		{"he\u0301llo", GraphemeLength, 5},
+		{"👍🏽", RuneLength, 2},
+		{"👍🏽", GraphemeLength, 1},
+		{"👨\u200d👩\u200d👧", GraphemeLength, 1},
+		{"🇸🇪🇳🇴", GraphemeLength, 2},
+		{"a\r\nb", GraphemeLength, 3},
+		{"\u0301a", GraphemeLength, 2},
+		{"", GraphemeLength, 0},
+	}
+
+	for _, test := range tests {
+		ctx := WithLengthUnit(context.Background(), test.unit)
+
+		if length := StringLength(ctx, test.value); length != test.expected {
+			t.Fatalf("Expected length of %q to be %d, got %d.", test.value, test.expected, length)
+		}
+	}
+}
+
+func TestThatTruncateStringNeverCutsWithinCharacters(t *testing.T) {
+	tests := []struct {
+		value    string
+		unit     LengthUnit
+		length   int
+		expected string
+	}{
+		{"héllo", RuneLength, 2, "hé"},
+		{"héllo", ByteLength, 2, "h"},
+		{"héllo", ByteLength, 3, "hé"},
+		{"he\u0301llo", GraphemeLength, 2, "he\u0301"},
+		{"👍🏽👍", GraphemeLength, 1, "👍🏽"},
+		{"abc", GraphemeLength, 5, "abc"},
+	}
+
+	for _, test := range tests {
+		ctx := WithLengthUnit(context.Background(), test.unit)
+
+		if value := TruncateString(ctx, test.value, test.length); value != test.expected {
+			t.Fatalf("Expected %q truncated to %d to be %q, got %q.", test.value, test.length, test.expected, value)
+		}
+	}
+}
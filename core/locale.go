@@ -3,11 +3,51 @@ package core
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"sync"
 )
 
+// Translator translates locale keys and format arguments into messages.
+type Translator interface {
+	Translate(key string, args ...interface{}) (string, error)
+}
+
+// MessageError is an error created from a locale key and format arguments, so that it can be translated again later.
+type MessageError struct {
+	Key     string
+	Args    []interface{}
+	message string
+}
+
+// NewMessageError creates an error with the message of the locale key translated by the translator.
+// If the locale key cannot be translated, then that error is returned instead.
+func NewMessageError(translator Translator, key string, args ...interface{}) error {
+	message, err := translator.Translate(key, args...)
+
+	if err != nil {
+		return err
+	}
+
+	return &MessageError{
+		Key:     key,
+		Args:    args,
+		message: message,
+	}
+}
+
+// Error returns the translated message, or the locale key if the error hasn't been translated.
+func (this *MessageError) Error() string {
+	if this.message == "" {
+		return this.Key
+	}
+	return this.message
+}
+
+// Locale holds messages by locale key. It's safe for concurrent use.
 type Locale struct {
 	messages map[string]string
+	lock     sync.RWMutex
 }
 
 func NewLocale() *Locale {
@@ -17,16 +57,35 @@ func NewLocale() *Locale {
 }
 
 func (this *Locale) Set(key string, value string) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
 	this.messages[key] = value
 }
 
 func (this *Locale) Get(key string) (string, error) {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
 	if val, ok := this.messages[key]; ok {
 		return val, nil
 	}
 	return "", errors.New("Locale " + key + " does not exist.")
 }
 
+// Translate gets the message of the locale key and formats it with the arguments.
+func (this *Locale) Translate(key string, args ...interface{}) (string, error) {
+	message, err := this.Get(key)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) > 0 {
+		message = fmt.Sprintf(message, args...)
+	}
+
+	return message, nil
+}
+
 func (this *Locale) LoadJson(filePath string) error {
 	rawJson, err := ioutil.ReadFile(filePath)
 
@@ -48,7 +107,14 @@ func (this *Locale) LoadJson(filePath string) error {
 }
 
 func (this *Locale) Copy() *Locale {
-	return &Locale{
-		messages: this.messages,
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	locale := NewLocale()
+
+	for key, value := range this.messages {
+		locale.Set(key, value)
 	}
+
+	return locale
 }
@@ -0,0 +1,44 @@
+package core
+
+// NilPolicy decides how validators treat nil values, i.e. nil pointers. It's applied before validators run, so
+// validators don't check for nil values themselves. Validators that handle nil values, such as `nil` and `not_empty`,
+// transformers and converters always receive them regardless of the policy.
+type NilPolicy int
+
+const (
+	// FailNil fails validators for nil values without running them, i.e. both `min(1)` and `max(10)` fail for a nil
+	// *int. Optional fields are declared with `nil|max(10)`. It's the default.
+	FailNil NilPolicy = iota
+
+	// SkipNil passes validators for nil values without running them, so that only validators that handle nil
+	// values, such as `not_empty`, can fail them.
+	SkipNil
+
+	// ZeroValueNil runs validators against the zero value of the type of nil values, i.e. `0` for a nil *int.
+	ZeroValueNil
+)
+
+// RegisterNilHandler registers a validator that handles nil values itself, i.e. `not_empty`. It receives nil values
+// regardless of the nil policy, and can check them with ValidatorContext.IsNil().
+func (r *ValidatorRegistry) RegisterNilHandler(name string, validator ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = validator
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	r.nilHandlers[name] = true
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.schemas, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+}
+
+// HandlesNil checks whether the validator with name handles nil values itself, that is if it was registered with
+// RegisterNilHandler or as a transformer or converter.
+func (r *ValidatorRegistry) HandlesNil(name string) bool {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	return registry.nilHandlers[name] || registry.transforms[name] || registry.converters[name]
+}
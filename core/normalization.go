@@ -1,6 +1,8 @@
 package core
 
 import (
+	"errors"
+	"math"
 	"reflect"
 )
 
@@ -10,10 +12,38 @@ type NormalizedValue struct {
 	IsNil        bool
 }
 
+// ValueProvider can be implemented by custom types to provide the value that validators should validate.
+// I.e. a `Money` struct could provide its amount in cents as an int64.
+type ValueProvider interface {
+	ValidatableValue() interface{}
+}
+
 // TODO: Normalize slices to arrays?
 
+// maxValueProviders is the maximum number of value providers that provide the values of each other, i.e. so that a
+// provider that provides itself fails instead of overflowing the stack.
+const maxValueProviders = 32
+
 func normalizeInternal(value interface{}, isNil bool) (*NormalizedValue, error) {
+	return normalizeProvided(value, isNil, 0)
+}
+
+// normalizeProvided normalizes a value that was provided by a number of value providers.
+func normalizeProvided(value interface{}, isNil bool, providers int) (*NormalizedValue, error) {
 	reflectedValue := reflect.ValueOf(value)
+
+	if provider, ok := value.(ValueProvider); ok && !(reflectedValue.Kind() == reflect.Ptr && reflectedValue.IsNil()) {
+		if providers >= maxValueProviders {
+			return nil, errors.New("Unable to normalize value of type '" + reflectedValue.Type().String() + "'. Its ValidatableValue is cyclic or nested too deeply.")
+		}
+		return normalizeProvided(provider.ValidatableValue(), isNil, providers+1)
+	}
+
+	// Options that aren't valid are nil, i.e. a sql.NullString from a NULL column.
+	if optionValue, valid, ok := sqlOptionOf(value); ok {
+		return normalizeProvided(optionValue, isNil || !valid, providers)
+	}
+
 	kind := reflectedValue.Kind()
 
 	switch reflectedValue.Kind() {
@@ -32,7 +62,7 @@ func normalizeInternal(value interface{}, isNil bool) (*NormalizedValue, error)
 			value = reflectedValue.Elem().Interface()
 		}
 
-		return normalizeInternal(value, isNil)
+		return normalizeProvided(value, isNil, providers)
 
 	// Convert any number to its 64-bit counterpart. Also normalize according to kind. I.e. any string, int, float or bool kind will be normalized to its base type.
 	// This means that a type, lets say `type Id int64` would instead of having the type `main.Id` be normalized to `int64`.
@@ -45,8 +75,14 @@ func normalizeInternal(value interface{}, isNil bool) (*NormalizedValue, error)
 	case reflect.Bool:
 		value = reflectedValue.Bool()
 
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		value = int64(reflectedValue.Uint())
+	// Unsigned values that don't fit into an int64 are normalized to uint64 instead, so validators that support
+	// numbers should handle uint64 as well as int64 and float64.
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if uintValue := reflectedValue.Uint(); uintValue > math.MaxInt64 {
+			value = uintValue
+		} else {
+			value = int64(uintValue)
+		}
 
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		value = reflectedValue.Int()
@@ -69,6 +105,89 @@ func normalizeInternal(value interface{}, isNil bool) (*NormalizedValue, error)
 	return normalized, nil
 }
 
+// Length returns the number of items of arrays and slices, or keys of maps. Returns false for other kinds, so that
+// validators can apply length based semantics to any collection, i.e. `min(1)` on a `[]string`.
+func Length(value interface{}) (int, bool) {
+	reflectedValue := reflect.ValueOf(value)
+
+	switch reflectedValue.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map:
+		return reflectedValue.Len(), true
+	}
+
+	return 0, false
+}
+
 func Normalize(value interface{}) (*NormalizedValue, error) {
 	return normalizeInternal(value, false)
 }
+
+var valueProviderType = reflect.TypeOf((*ValueProvider)(nil)).Elem()
+
+// NormalizeReflected normalizes a reflected value like Normalize, but avoids allocating an interface of the value
+// before normalizing it, as well as the normalized value itself, i.e. for the fields of structs.
+func NormalizeReflected(reflectedValue reflect.Value) (NormalizedValue, error) {
+	return normalizeReflected(reflectedValue, false)
+}
+
+func normalizeReflected(reflectedValue reflect.Value, isNil bool) (NormalizedValue, error) {
+	if !reflectedValue.IsValid() || reflectedValue.Type().Implements(valueProviderType) {
+		return normalizeFallback(interfaceOf(reflectedValue), isNil)
+	}
+
+	kind := reflectedValue.Kind()
+	var value interface{}
+
+	switch kind {
+	case reflect.Ptr:
+		if reflectedValue.IsNil() {
+			return normalizeReflected(reflect.Zero(reflectedValue.Type().Elem()), true)
+		}
+		return normalizeReflected(reflectedValue.Elem(), isNil)
+
+	case reflect.String:
+		value = reflectedValue.String()
+
+	case reflect.Bool:
+		value = reflectedValue.Bool()
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if uintValue := reflectedValue.Uint(); uintValue > math.MaxInt64 {
+			value = uintValue
+		} else {
+			value = int64(uintValue)
+		}
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = reflectedValue.Int()
+
+	case reflect.Float32, reflect.Float64:
+		value = reflectedValue.Float()
+
+	default:
+		return normalizeFallback(interfaceOf(reflectedValue), isNil)
+	}
+
+	return NormalizedValue{
+		Value:        value,
+		OriginalKind: kind,
+		IsNil:        isNil,
+	}, nil
+}
+
+func normalizeFallback(value interface{}, isNil bool) (NormalizedValue, error) {
+	normalized, err := normalizeInternal(value, isNil)
+
+	if err != nil {
+		return NormalizedValue{}, err
+	}
+
+	return *normalized, nil
+}
+
+func interfaceOf(reflectedValue reflect.Value) interface{} {
+	if !reflectedValue.IsValid() {
+		return nil
+	}
+	return reflectedValue.Interface()
+}
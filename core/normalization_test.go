@@ -3,6 +3,7 @@ package core_test
 import (
 	"fmt"
 	. "github.com/typerandom/validator/core"
+	"math"
 	"reflect"
 	"testing"
 )
@@ -188,3 +189,122 @@ func TestThatDeepPointerNilValuesCanBeNormalized(t *testing.T) {
 func TestThatInvalidValuesCanBeNormalized(t *testing.T) {
 	testThatValueIsNormalizedToType(t, nil, nil, reflect.Invalid, reflect.Invalid, true)
 }
+
+func TestThatUInt64AboveInt64RangeIsNormalizedToUInt64(t *testing.T) {
+	var value uint64 = math.MaxUint64
+	testThatValueIsNormalizedToType(t, value, value, reflect.Uint64, reflect.Uint64, false)
+	testThatValueIsNormalizedToType(t, &value, value, reflect.Uint64, reflect.Uint64, false)
+}
+
+func TestThatUIntPtrIsNormalizedToInt64(t *testing.T) {
+	var value uintptr = 123
+	testThatValueIsNormalizedToType(t, value, int64(123), reflect.Uintptr, reflect.Int64, false)
+}
+
+type normalizationMoney struct {
+	cents int64
+}
+
+func (this normalizationMoney) ValidatableValue() interface{} {
+	return this.cents
+}
+
+type normalizationId string
+
+func TestThatValueProviderIsNormalizedToProvidedValue(t *testing.T) {
+	value := normalizationMoney{cents: 123}
+	var nilValue *normalizationMoney
+	testThatValueIsNormalizedToType(t, value, int64(123), reflect.Int64, reflect.Int64, false)
+	testThatValueIsNormalizedToType(t, &value, int64(123), reflect.Int64, reflect.Int64, false)
+	testThatValueIsNormalizedToType(t, nilValue, int64(0), reflect.Int64, reflect.Int64, true)
+}
+
+type normalizationCycle struct {
+	next *normalizationCycle
+}
+
+func (this *normalizationCycle) ValidatableValue() interface{} {
+	return this.next
+}
+
+func TestThatCyclicValueProvidersFailToNormalize(t *testing.T) {
+	value := &normalizationCycle{}
+	value.next = value
+
+	if _, err := Normalize(value); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+
+	if _, err := NormalizeReflected(reflect.ValueOf(value)); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+}
+
+func TestThatNamedTypeIsNormalizedToBaseType(t *testing.T) {
+	var value normalizationId = "abc"
+	testThatValueIsNormalizedToType(t, value, "abc", reflect.String, reflect.String, false)
+}
+
+func TestThatLengthReturnsLengthOfCollections(t *testing.T) {
+	if length, ok := Length([]string{"a", "b"}); !ok || length != 2 {
+		t.Fatalf("Expected slice length 2, got %d.", length)
+	}
+
+	if length, ok := Length([3]int{}); !ok || length != 3 {
+		t.Fatalf("Expected array length 3, got %d.", length)
+	}
+
+	if length, ok := Length(map[string]int{"a": 1}); !ok || length != 1 {
+		t.Fatalf("Expected map length 1, got %d.", length)
+	}
+
+	if length, ok := Length([]string(nil)); !ok || length != 0 {
+		t.Fatalf("Expected nil slice length 0, got %d.", length)
+	}
+
+	if _, ok := Length("abc"); ok {
+		t.Fatal("Expected string to not have a collection length.")
+	}
+}
+
+func TestThatNormalizeReflectedMatchesNormalize(t *testing.T) {
+	text := "abc"
+	var nilText *string
+	var nilInterface interface{}
+
+	values := []interface{}{
+		"abc",
+		normalizationId("abc"),
+		int8(-5),
+		uint16(7),
+		uint64(math.MaxUint64),
+		float32(1.5),
+		true,
+		&text,
+		nilText,
+		[]string{"a"},
+		map[string]int{"a": 1},
+		struct{ Name string }{"abc"},
+		normalizationMoney{cents: 5},
+		&normalizationMoney{cents: 6},
+		&nilInterface,
+	}
+
+	for _, value := range values {
+		expected, err := Normalize(value)
+
+		if err != nil {
+			t.Fatalf("Didn't expect error, got %s.", err)
+		}
+
+		actual, err := NormalizeReflected(reflect.ValueOf(value))
+
+		if err != nil {
+			t.Fatalf("Didn't expect error, got %s.", err)
+		}
+
+		if !reflect.DeepEqual(*expected, actual) {
+			t.Fatalf("Expected %+v, got %+v.", *expected, actual)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"database/sql"
+	"reflect"
+)
+
+// OptionAdapter returns the value of an option type, i.e. `Option[string]` of a third party package, and whether it's
+// set. Unset options are validated as nil values, so that `required` and `not_empty` fail them, and should return
+// the zero value of the type of their values.
+type OptionAdapter func(value interface{}) (interface{}, bool)
+
+// RegisterOptionType registers the adapter of an option type. Values of the type, and pointers to it, are validated
+// by their value if they're set, or as nil values if they're not. The Null types of database/sql are supported
+// without being registered.
+func (r *ValidatorRegistry) RegisterOptionType(reflectedType reflect.Type, adapter OptionAdapter) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.options[reflectedType] = adapter
+}
+
+// GetOptionAdapter returns the adapter of an option type, if registered.
+func (r *ValidatorRegistry) GetOptionAdapter(reflectedType reflect.Type) (OptionAdapter, bool) {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		adapter, ok := registry.options[reflectedType]
+		registry.lock.RUnlock()
+
+		if ok {
+			return adapter, true
+		}
+	}
+
+	return nil, false
+}
+
+// sqlOptionOf returns the value of the Null types of database/sql, and whether it's valid. Returns false if value
+// isn't one of them.
+func sqlOptionOf(value interface{}) (optionValue interface{}, valid bool, ok bool) {
+	switch typedValue := value.(type) {
+	case sql.NullString:
+		return typedValue.String, typedValue.Valid, true
+	case sql.NullInt64:
+		return typedValue.Int64, typedValue.Valid, true
+	case sql.NullInt32:
+		return typedValue.Int32, typedValue.Valid, true
+	case sql.NullInt16:
+		return typedValue.Int16, typedValue.Valid, true
+	case sql.NullByte:
+		return typedValue.Byte, typedValue.Valid, true
+	case sql.NullFloat64:
+		return typedValue.Float64, typedValue.Valid, true
+	case sql.NullBool:
+		return typedValue.Bool, typedValue.Valid, true
+	case sql.NullTime:
+		return typedValue.Time, typedValue.Valid, true
+	}
+
+	return nil, false, false
+}
@@ -0,0 +1,52 @@
+package parser_test
+
+import (
+	"errors"
+	. "github.com/typerandom/validator/core/parser"
+	"testing"
+)
+
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		"not_empty,min(3)|empty",
+		"between(min=1,max=10)",
+		"match(^(a|b)[,\\)]$)",
+		"test(´te\\\\st´, -1.5, nil, true)",
+		"as(Email address),email",
+		"test(a(b)",
+		"test((),",
+		"a,(1)",
+		"é(",
+		"a,,b|c,|d",
+		"a(1)b",
+		"!a,!b(1)|!c",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		methodGroups, err := Parse(text)
+
+		if err != nil {
+			var syntaxErr *SyntaxError
+
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Tested '%s'. Expected syntax error, got %T (%s).", text, err, err)
+			}
+
+			if syntaxErr.Position < 0 || syntaxErr.Position > len(text) {
+				t.Fatalf("Tested '%s'. Expected position within text, got %d.", text, syntaxErr.Position)
+			}
+
+			return
+		}
+
+		for _, methods := range methodGroups {
+			for _, method := range methods {
+				if len(method.Name) == 0 {
+					t.Fatalf("Tested '%s'. Expected named methods, got %s.", text, methods)
+				}
+			}
+		}
+	})
+}
@@ -100,19 +100,63 @@ TEXT_SCAN:
 	return lexArgs
 }
 
+func isOpeningBracket(char rune) bool {
+	return char == '(' || char == '[' || char == '{'
+}
+
+func isClosingBracket(char rune) bool {
+	return char == ')' || char == ']' || char == '}'
+}
+
+// closingBracketOf returns the bracket that closes opening.
+func closingBracketOf(opening rune) rune {
+	switch opening {
+	case '(':
+		return ')'
+	case '[':
+		return ']'
+	default:
+		return '}'
+	}
+}
+
+// lexArgValueUnboundedText scans text that isn't enclosed by ´. Brackets may be nested within the text, but must be
+// closed by the same kind of bracket that opened them, and any character can be escaped with a backslash. Escapes are kept as-is so that the value can be used as a
+// regular expression, i.e. `match(^\d{2}\,\d{2}$)`. Words may be separated by white space, i.e.
+// `as(Email address)`, but white space around the text is skipped.
 func lexArgValueUnboundedText(scanner *scanner) lexer {
+	var brackets []rune
+
 TEXT_SCAN:
 	for {
 		switch char := scanner.next(); {
-		case isAlphaNumeric(char) || char == '_':
-			continue
-		case char == ',' || char == ')' || isWhiteSpace(char):
+		case char == '\\':
+			if scanner.next() == eof {
+				return scanner.UnexpectedEndError()
+			}
+		case isOpeningBracket(char):
+			brackets = append(brackets, closingBracketOf(char))
+		case isClosingBracket(char) && len(brackets) > 0:
+			if char != brackets[len(brackets)-1] {
+				return scanner.unexpectedCharError()
+			}
+			brackets = brackets[:len(brackets)-1]
+		case isWhiteSpace(char):
+			if next := scanner.peekPastWhiteSpace(); len(brackets) > 0 || (next != ',' && next != ')' && next != eof) {
+				continue
+			}
+			scanner.backup()
+			break TEXT_SCAN
+		case char == ',' || char == ')':
+			if len(brackets) > 0 {
+				continue
+			}
 			scanner.backup()
 			break TEXT_SCAN
+		case isClosingBracket(char):
+			return scanner.unexpectedCharError()
 		case char == eof:
 			return scanner.UnexpectedEndError()
-		default:
-			return scanner.unexpectedCharError()
 		}
 	}
 
@@ -135,16 +179,11 @@ func lexArgValueNumber(scanner *scanner) lexer {
 NUMBER_SCAN:
 	for {
 		switch char := scanner.next(); {
-		case char == '+' || char == '-':
-			if scanner.length() != 1 {
-				return scanner.unexpectedCharError()
-			}
+		case (char == '+' || char == '-') && scanner.length() == 1:
+			continue
 		case isNumeric(char):
 			continue
-		case char == '.':
-			if scanner.length() == 1 || isFloat {
-				return scanner.unexpectedCharError()
-			}
+		case char == '.' && scanner.length() > 1 && !isFloat:
 			isFloat = true
 		case char == ',' || char == ')' || isWhiteSpace(char):
 			returnTo = lexArgs
@@ -152,7 +191,9 @@ NUMBER_SCAN:
 		case char == eof:
 			return scanner.UnexpectedEndError()
 		default:
-			return scanner.unexpectedCharError()
+			// Not a number, i.e. `2015-01-01` or `1.2.3`, so scan it as text instead.
+			scanner.reset()
+			return lexArgValueUnboundedText
 		}
 	}
 
@@ -174,17 +215,44 @@ func lexArgValue(scanner *scanner) lexer {
 		return lexArgValueNumber
 	case isAlpha(char):
 		scanner.backup()
-		return lexArgValueUnboundedText
+		return lexArgName
 	case char == '´':
 		scanner.skip()
 		return lexArgValueBoundedText
 	case isWhiteSpace(char):
 		return lexWhiteSpace(scanner, lexArgValue)
-	default:
+	case char == eof:
+		return scanner.UnexpectedEndError()
+	case char == ',' || isClosingBracket(char):
 		return scanner.unexpectedCharError()
+	default:
+		scanner.backup()
+		return lexArgValueUnboundedText
 	}
 }
 
+// lexArgName scans the name of a named argument, i.e. `min` in `between(min=1)`. If the text turns out not to be
+// a name, then it's scanned as an unbounded text value instead.
+func lexArgName(scanner *scanner) lexer {
+	for {
+		if char := scanner.next(); !isAlphaNumeric(char) && char != '_' {
+			if char != '=' {
+				scanner.reset()
+				return lexArgValueUnboundedText
+			}
+			break
+		}
+	}
+
+	scanner.backup()
+	scanner.emit(TOKEN_ARG_NAME)
+
+	scanner.next()
+	scanner.skip()
+
+	return lexArgValue
+}
+
 func lexArgs(scanner *scanner) lexer {
 	switch char := scanner.next(); {
 	case char == ',':
@@ -196,14 +264,14 @@ func lexArgs(scanner *scanner) lexer {
 		if scanner.peek() == ')' {
 			scanner.next()
 			scanner.skip()
-			returnTo = lexMethod
+			returnTo = lexMethodEnd
 		}
 
 		scanner.skip()
 		return returnTo
 	case char == ')':
 		scanner.skip()
-		return lexMethod
+		return lexMethodEnd
 	case isWhiteSpace(char):
 		return lexWhiteSpace(scanner, lexArgs)
 	default:
@@ -223,7 +291,7 @@ NAME_SCAN:
 			returnTo = lexGroup
 			break NAME_SCAN
 		case char == ',':
-			returnTo = lexMethod
+			returnTo = lexMethodEnd
 			break NAME_SCAN
 		case char == '(':
 			returnTo = lexArgs
@@ -241,11 +309,39 @@ NAME_SCAN:
 	return returnTo
 }
 
+// lexMethod scans the start of a method. Methods are separated by `,`, so empty methods, i.e. `a,,b`, are errors.
 func lexMethod(scanner *scanner) lexer {
 	switch char := scanner.next(); {
 	case isAlphaNumeric(char) || char == '_':
 		scanner.backup()
 		return lexMethodName
+	case char == '!':
+		scanner.emit(TOKEN_NEGATION)
+		return lexNegatedMethod
+	case char == eof:
+		return scanner.UnexpectedEndError()
+	default:
+		return scanner.unexpectedCharError()
+	}
+}
+
+// lexNegatedMethod scans the name of a method after `!`, i.e. `!uppercase`. Methods can only be negated once.
+func lexNegatedMethod(scanner *scanner) lexer {
+	switch char := scanner.next(); {
+	case isAlphaNumeric(char) || char == '_':
+		scanner.backup()
+		return lexMethodName
+	case char == eof:
+		return scanner.UnexpectedEndError()
+	default:
+		return scanner.unexpectedCharError()
+	}
+}
+
+// lexMethodEnd scans the separator after a method and its arguments, i.e. `,` before the next method or `|` before
+// the next group. Methods without a separator, i.e. `a(1)b`, are errors.
+func lexMethodEnd(scanner *scanner) lexer {
+	switch char := scanner.next(); {
 	case char == '|':
 		scanner.backup()
 		return lexGroup
@@ -255,9 +351,6 @@ func lexMethod(scanner *scanner) lexer {
 		}
 		scanner.skip()
 		return lexMethod
-	case char == '(':
-		scanner.skip()
-		return lexArgs
 	case char == eof:
 		return nil
 	default:
@@ -267,7 +360,7 @@ func lexMethod(scanner *scanner) lexer {
 
 func lexGroup(scanner *scanner) lexer {
 	switch char := scanner.next(); {
-	case isAlpha(char):
+	case isAlpha(char) || char == '!':
 		scanner.backup()
 		return lexMethod
 	case char == '|':
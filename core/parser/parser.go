@@ -3,9 +3,29 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"math/big"
+	"sort"
 	"strconv"
 )
 
+// SyntaxError is returned by Parse if the text can't be parsed. Position is the position of the error in bytes, as
+// reported by the message, i.e. 5 for `Unexpected character U+002C ',' at position 5.`.
+type SyntaxError struct {
+	Position int
+	Message  string
+}
+
+func (this *SyntaxError) Error() string {
+	return this.Message
+}
+
+func newArgumentError(token *token, problem string) *SyntaxError {
+	return &SyntaxError{
+		Position: token.position,
+		Message:  fmt.Sprintf("Argument '%s' at position %d %s.", token.value, token.position, problem),
+	}
+}
+
 type Methods []*Method
 
 func (this Methods) String() string {
@@ -44,13 +64,121 @@ func (args Arguments) String() string {
 	return result
 }
 
+type NamedArguments map[string]interface{}
+
+func (args NamedArguments) String() string {
+	names := make([]string, 0, len(args))
+
+	for name := range args {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	result := ""
+
+	for _, name := range names {
+		if result != "" {
+			result += ", "
+		}
+		result += name + "=" + Arguments{args[name]}.String()
+	}
+
+	return result
+}
+
+// Method is a validator or directive of a group. Negated methods, i.e. `!uppercase`, pass if the validator fails.
 type Method struct {
-	Name      string
-	Arguments Arguments
+	Name           string
+	Arguments      Arguments
+	NamedArguments NamedArguments
+	Negated        bool
+
+	// namedText holds the text of the named arguments, so that they can be passed as text to validators without
+	// named arguments.
+	namedText []namedText
+}
+
+// namedText is the text of a named argument, i.e. `a=b`, and the position among the arguments where it's specified.
+type namedText struct {
+	text     string
+	position int
 }
 
 func (this *Method) String() string {
-	return "{ name: '" + this.Name + "', args: " + this.Arguments.String() + " }"
+	result := "{ name: '" + this.Name + "', args: " + this.Arguments.String()
+
+	if this.Negated {
+		result += ", negated: true"
+	}
+
+	if len(this.NamedArguments) > 0 {
+		result += ", named: " + this.NamedArguments.String()
+	}
+
+	return result + " }"
+}
+
+// WithoutNamedArguments returns a copy of the method with its named arguments passed as text at the positions where
+// they're specified, i.e. `a=b` of `match(a=b)`, for validators that don't declare named arguments.
+func (this *Method) WithoutNamedArguments() *Method {
+	copied := *this
+	copied.Arguments = make(Arguments, 0, len(this.Arguments)+len(this.NamedArguments))
+	copied.NamedArguments = nil
+	copied.namedText = nil
+
+	position := 0
+
+	for _, named := range this.namedText {
+		copied.Arguments = append(copied.Arguments, this.Arguments[position:named.position]...)
+		copied.Arguments = append(copied.Arguments, named.text)
+		position = named.position
+	}
+
+	copied.Arguments = append(copied.Arguments, this.Arguments[position:]...)
+
+	return &copied
+}
+
+func (this *Method) addArgument(name *string, value interface{}, text string) error {
+	if name == nil {
+		this.Arguments = append(this.Arguments, value)
+		return nil
+	}
+
+	if this.NamedArguments == nil {
+		this.NamedArguments = NamedArguments{}
+	}
+
+	if _, ok := this.NamedArguments[*name]; ok {
+		return errors.New(fmt.Sprintf("Argument '%s' of method '%s' is specified more than once.", *name, this.Name))
+	}
+
+	this.NamedArguments[*name] = value
+	this.namedText = append(this.namedText, namedText{text: *name + "=" + text, position: len(this.Arguments)})
+
+	return nil
+}
+
+// MaxExactInteger is the largest integer that float64 can represent exactly, along with all smaller integers.
+const MaxExactInteger = 1 << 53
+
+// parseInteger parses an integer as float64, like other numbers, if float64 can represent it exactly. Otherwise, the
+// integer is parsed as *big.Int, so that arguments such as `max(9999999999999999999)` keep their precision.
+func parseInteger(value string) (interface{}, error) {
+	integer, ok := new(big.Int).SetString(value, 10)
+
+	if !ok {
+		return nil, errors.New("Invalid integer.")
+	}
+
+	if integer.IsInt64() {
+		if int64Value := integer.Int64(); int64Value >= -MaxExactInteger && int64Value <= MaxExactInteger {
+			return float64(int64Value), nil
+		}
+	}
+
+	return integer, nil
 }
 
 func Parse(text string) ([]Methods, error) {
@@ -67,42 +195,71 @@ func Parse(text string) ([]Methods, error) {
 	var methodGroups []Methods
 	var methods Methods
 	var method *Method
+	var argName *string
+	negated := false
 
 	for _, token := range scanner.tokens {
+		var argValue interface{}
+
 		switch token.type_ {
 		case TOKEN_GROUP:
 			methodGroups = append(methodGroups, methods)
 			methods = Methods{}
+			continue
+		case TOKEN_NEGATION:
+			negated = true
+			continue
 		case TOKEN_METHOD:
 			method = &Method{
-				Name: token.value,
+				Name:    token.value,
+				Negated: negated,
 			}
 			methods = append(methods, method)
-		case TOKEN_ARG_INTEGER, TOKEN_ARG_FLOAT:
+			negated = false
+			continue
+		case TOKEN_ARG_NAME:
+			name := token.value
+			argName = &name
+			continue
+		case TOKEN_ARG_INTEGER:
+			parsedValue, err := parseInteger(token.value)
+
+			if err != nil {
+				return nil, newArgumentError(token, "is not a valid number")
+			}
+
+			argValue = parsedValue
+		case TOKEN_ARG_FLOAT:
 			parsedValue, err := strconv.ParseFloat(token.value, 64)
 
 			if err != nil {
-				return nil, errors.New(fmt.Sprintf("Argument '%s' at position %d is not a valid number.", token.value, token.position))
+				return nil, newArgumentError(token, "is not a valid number")
 			}
 
-			method.Arguments = append(method.Arguments, parsedValue)
+			argValue = parsedValue
 		case TOKEN_ARG_BOOLEAN:
 			parsedValue, err := strconv.ParseBool(token.value)
 
 			if err != nil {
-				return nil, errors.New(fmt.Sprintf("Argument '%s' at position %d is not a valid boolean.", token.value, token.position))
+				return nil, newArgumentError(token, "is not a valid boolean")
 			}
 
-			method.Arguments = append(method.Arguments, parsedValue)
+			argValue = parsedValue
 		case TOKEN_ARG_NIL:
-			method.Arguments = append(method.Arguments, nil)
+			argValue = nil
 		case TOKEN_ARG_STRING:
-			method.Arguments = append(method.Arguments, token.value)
+			argValue = token.value
 		case TOKEN_ERROR:
-			return nil, errors.New(token.value)
+			return nil, &SyntaxError{Position: token.position, Message: token.value}
 		default:
-			return nil, errors.New("Unable to parse. Unhandled token type.")
+			return nil, &SyntaxError{Position: token.position, Message: "Unable to parse. Unhandled token type."}
 		}
+
+		if err := method.addArgument(argName, argValue, token.value); err != nil {
+			return nil, &SyntaxError{Position: token.position, Message: err.Error()}
+		}
+
+		argName = nil
 	}
 
 	methodGroups = append(methodGroups, methods)
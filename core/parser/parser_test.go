@@ -3,6 +3,7 @@ package parser_test
 import (
 	"fmt"
 	. "github.com/typerandom/validator/core/parser"
+	"math/big"
 	"testing"
 )
 
@@ -46,6 +47,12 @@ func TestThatWhenParsingSingleMethodWithSingleBoundedStrArgumentItSucceeds(t *te
 	testThatValidSyntaxIsParsedAsExpected(t, "abc(´def´)", "[{ name: 'abc', args: 'def' }]")
 }
 
+func TestThatWhenParsingUnboundedStrArgumentWithSpacesItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "abc(def ghi)", "[{ name: 'abc', args: 'def ghi' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc( def  ghi , jkl )", "[{ name: 'abc', args: 'def  ghi', 'jkl' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "abc(Email address),def", "[{ name: 'abc', args: 'Email address' }, { name: 'def', args: (none) }]")
+}
+
 func TestThatWhenParsingSingleMethodWithSingleBoolArgumentItSucceeds(t *testing.T) {
 	testThatValidSyntaxIsParsedAsExpected(t, "abc(true)", "[{ name: 'abc', args: true }]")
 	testThatValidSyntaxIsParsedAsExpected(t, "abc(false)", "[{ name: 'abc', args: false }]")
@@ -127,6 +134,8 @@ func TestThatWhenParsingInvalidMethodNameItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, "´", "Unexpected character U+00B4 '´' at position 2.")
 	testThatInvalidSyntaxFailsWithError(t, "1", "Unexpected character U+0031 '1' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "_Test()", "Unexpected character U+005F '_' at position 1.")
+	testThatInvalidSyntaxFailsWithError(t, "é", "Unexpected character U+00E9 'é' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "aé", "Unexpected character U+00E9 'é' at position 3.")
 }
 
 func TestThatWhenParsingMethodNamesWithInvalidSeparatorsItFails(t *testing.T) {
@@ -136,6 +145,12 @@ func TestThatWhenParsingMethodNamesWithInvalidSeparatorsItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, "test(),", "Unexpected character U+002C ',' at position 7.")
 	testThatInvalidSyntaxFailsWithError(t, ",test()", "Unexpected character U+002C ',' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, ",test(),", "Unexpected character U+002C ',' at position 1.")
+	testThatInvalidSyntaxFailsWithError(t, "a,,b", "Unexpected character U+002C ',' at position 3.")
+	testThatInvalidSyntaxFailsWithError(t, "a(),,b", "Unexpected character U+002C ',' at position 5.")
+	testThatInvalidSyntaxFailsWithError(t, "a|b,|c", "Unexpected character U+007C '|' at position 5.")
+	testThatInvalidSyntaxFailsWithError(t, "a,(1)", "Unexpected character U+0028 '(' at position 3.")
+	testThatInvalidSyntaxFailsWithError(t, "a(1)b", "Unexpected character U+0062 'b' at position 5.")
+	testThatInvalidSyntaxFailsWithError(t, "a()(1)", "Unexpected character U+0028 '(' at position 4.")
 }
 
 func TestThatWhenParsingMethodArgsWithInvalidSeparatorsItFails(t *testing.T) {
@@ -152,3 +167,101 @@ func TestThatWhenParsingInvalidGroupSeparatorsItFails(t *testing.T) {
 	testThatInvalidSyntaxFailsWithError(t, "|a|", "Unexpected character U+007C '|' at position 1.")
 	testThatInvalidSyntaxFailsWithError(t, "||", "Unexpected character U+007C '|' at position 1.")
 }
+
+func TestThatWhenParsingNegatedMethodsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "!uppercase", "[{ name: 'uppercase', args: (none), negated: true }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "!one_of(admin,root)", "[{ name: 'one_of', args: 'admin', 'root', negated: true }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "not_empty,!contains(!)", "[{ name: 'not_empty', args: (none) }, { name: 'contains', args: '!', negated: true }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "empty|!empty,email", "[{ name: 'empty', args: (none) } { name: 'empty', args: (none), negated: true }, { name: 'email', args: (none) }]")
+}
+
+func TestThatWhenParsingInvalidNegationsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "!", "Unexpected end at position 1.")
+	testThatInvalidSyntaxFailsWithError(t, "!!a", "Unexpected character U+0021 '!' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "a!", "Unexpected character U+0021 '!' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "a,!", "Unexpected end at position 3.")
+	testThatInvalidSyntaxFailsWithError(t, "! a", "Unexpected character U+0020 ' ' at position 2.")
+	testThatInvalidSyntaxFailsWithError(t, "!(1)", "Unexpected character U+0028 '(' at position 2.")
+}
+
+func TestThatWhenParsingUnboundedTextArgWithBracketsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "match(^\\d{4}-\\d{2}$)", "[{ name: 'match', args: '^\\d{4}-\\d{2}$' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "match(^(a|b)[,\\)]$)", "[{ name: 'match', args: '^(a|b)[,\\)]$' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "match(^a{1,3}$, b)", "[{ name: 'match', args: '^a{1,3}$', 'b' }]")
+}
+
+func TestThatWhenParsingUnboundedTextArgWithEscapedValueItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "match(a\\,b)", "[{ name: 'match', args: 'a\\,b' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "match(a\\)b)", "[{ name: 'match', args: 'a\\)b' }]")
+}
+
+func TestThatWhenParsingUnboundedTextArgWithUnbalancedBracketsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "match(a(b)", "Unexpected end at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, "match(a]b)", "Unexpected character U+005D ']' at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "match(a\\", "Unexpected end at position 8.")
+	testThatInvalidSyntaxFailsWithError(t, "match(", "Unexpected end at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "match(´a", "Unexpected end at position 9.")
+}
+
+func TestThatWhenParsingMismatchedBracketsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "foo(1]", "Unexpected character U+005D ']' at position 6.")
+	testThatInvalidSyntaxFailsWithError(t, "foo[1)", "Unexpected character U+005B '[' at position 4.")
+	testThatInvalidSyntaxFailsWithError(t, "match(a(b]))", "Unexpected character U+005D ']' at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, "match([a)])", "Unexpected character U+0029 ')' at position 9.")
+	testThatInvalidSyntaxFailsWithError(t, "match(a{b]})", "Unexpected character U+005D ']' at position 10.")
+}
+
+func TestThatWhenParsingMethodWithNamedArgumentsItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "between(min=1,max=10)", "[{ name: 'between', args: (none), named: max=10, min=1 }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "test(abc, name=´def´, flag=true)", "[{ name: 'test', args: 'abc', named: flag=true, name='def' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "test(a_1=nil, abc)", "[{ name: 'test', args: 'abc', named: a_1=<nil> }]")
+}
+
+func TestThatWhenParsingMethodWithInvalidNamedArgumentsItFails(t *testing.T) {
+	testThatInvalidSyntaxFailsWithError(t, "test(min=)", "Unexpected character U+0029 ')' at position 10.")
+	testThatInvalidSyntaxFailsWithError(t, "test(min=1,min=2)", "Argument 'min' of method 'test' is specified more than once.")
+}
+
+func TestThatWhenParsingTextArgStartingWithNumberItSucceeds(t *testing.T) {
+	testThatValidSyntaxIsParsedAsExpected(t, "min(2015-01-01)", "[{ name: 'min', args: '2015-01-01' }]")
+	testThatValidSyntaxIsParsedAsExpected(t, "test(1.2.3, -abc, 5)", "[{ name: 'test', args: '1.2.3', '-abc', 5 }]")
+}
+
+func TestThatWhenParsingIntegerArgTooLargeForFloatItIsParsedAsBigInt(t *testing.T) {
+	methodGroups, err := Parse("max(9999999999999999999, 9007199254740992)")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	args := methodGroups[0][0].Arguments
+
+	if value, ok := args[0].(*big.Int); !ok || value.String() != "9999999999999999999" {
+		t.Fatalf("Expected big integer argument, got %T (%v).", args[0], args[0])
+	}
+
+	if value, ok := args[1].(float64); !ok || value != 9007199254740992 {
+		t.Fatalf("Expected float argument, got %T (%v).", args[1], args[1])
+	}
+}
+
+func TestThatWhenParsingInvalidSyntaxItFailsWithPosition(t *testing.T) {
+	for test, expected := range map[string]int{
+		"test,":             5,
+		"a,,b":              3,
+		"test(min=1,min=2)": 15,
+		"test(min=)":        10,
+	} {
+		_, err := Parse(test)
+
+		syntaxErr, ok := err.(*SyntaxError)
+
+		if !ok {
+			t.Fatalf("Tested '%s'. Expected syntax error, got %T (%v).", test, err, err)
+		}
+
+		if syntaxErr.Position != expected {
+			t.Fatalf("Tested '%s'. Expected position %d, got %d.", test, expected, syntaxErr.Position)
+		}
+	}
+}
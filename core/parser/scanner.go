@@ -34,10 +34,29 @@ func (this *scanner) peek() rune {
 	return char
 }
 
+// peekPastWhiteSpace returns the next character that isn't white space, without moving the scanner.
+func (this *scanner) peekPastWhiteSpace() rune {
+	position, width := this.position, this.width
+
+	char := this.next()
+
+	for isWhiteSpace(char) {
+		char = this.next()
+	}
+
+	this.position, this.width = position, width
+
+	return char
+}
+
 func (this *scanner) backup() {
 	this.position -= this.width
 }
 
+func (this *scanner) reset() {
+	this.position = this.start
+}
+
 func (this *scanner) skip() {
 	this.start = this.position
 }
@@ -63,17 +82,20 @@ func (this *scanner) emitValue(typ tokenType, value string) {
 	this.skip()
 }
 
+// errorf emits an error at the current position, and stops scanning.
 func (this *scanner) errorf(format string, args ...interface{}) lexer {
 	this.tokens = append(this.tokens, &token{
 		type_:    TOKEN_ERROR,
-		position: this.start,
+		position: this.position,
 		value:    fmt.Sprintf(format, args...),
 	})
 	return nil
 }
 
+// unexpectedCharError emits an error for the character before the current position, which may be multi-byte.
 func (this *scanner) unexpectedCharError() lexer {
-	return this.errorf("Unexpected character %#U at position %d.", this.value[this.position-1], this.position)
+	char, _ := utf8.DecodeLastRuneInString(this.value[:this.position])
+	return this.errorf("Unexpected character %#U at position %d.", char, this.position)
 }
 
 func (this *scanner) UnexpectedEndError() lexer {
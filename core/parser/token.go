@@ -21,6 +21,7 @@ const (
 	TOKEN_EOF
 
 	TOKEN_GROUP
+	TOKEN_NEGATION
 	TOKEN_METHOD
 
 	TOKEN_ARG_INTEGER
@@ -28,6 +29,7 @@ const (
 	TOKEN_ARG_STRING
 	TOKEN_ARG_BOOLEAN
 	TOKEN_ARG_NIL
+	TOKEN_ARG_NAME
 )
 
 func (this token) String() string {
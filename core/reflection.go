@@ -2,18 +2,48 @@ package core
 
 import (
 	"errors"
+	"fmt"
 	"github.com/typerandom/validator/core/parser"
 	"reflect"
 	"strings"
-	"unicode"
 )
 
+// ErrorMessageTag is the tag used to override the error messages of a field, i.e. `errmsg:"Password is too short."`.
+const ErrorMessageTag = "errmsg"
+
 type ReflectedField struct {
 	Index        int
 	Parent       *ReflectedField
 	Name         string
+	StructName   string
 	DisplayName  *string
+	ErrorMessage *string
 	MethodGroups []parser.Methods
+
+	// Embedded indicates whether the field is an embedded struct, or pointer to struct, whose fields are promoted.
+	Embedded bool
+
+	// Ignored indicates whether the field is excluded from validation and traversal by an `ignore` or `-` tag.
+	Ignored bool
+
+	// Unexported indicates whether the field is unexported. Unexported fields are only reflected by caches that
+	// include them.
+	Unexported bool
+
+	// Hidden contains the names of the fields of an embedded struct that are hidden by fields of the struct that
+	// embeds it. Hidden fields are not promoted, and therefore not validated.
+	Hidden map[string]bool
+}
+
+// IsHidden checks whether the field with name, of the struct of this field, is hidden by a field of a struct that
+// embeds it.
+func (this *ReflectedField) IsHidden(name string) bool {
+	for field := this; field != nil && field.Embedded; field = field.Parent {
+		if field.Hidden[name] {
+			return true
+		}
+	}
+	return false
 }
 
 func (this *ReflectedField) GetValue(sourceStruct reflect.Value) interface{} {
@@ -57,131 +87,506 @@ func (this *ReflectedField) FullDisplayName(postfix ...string) string {
 	}, postfix...)
 }
 
+// DisplayNameResolver resolves the display name of a struct field. An empty string means that the field name is used.
+type DisplayNameResolver func(field reflect.StructField) string
+
+// TagDisplayNameResolver resolves display names from the value of a tag, i.e. `label:"User name"` would resolve to
+// `User name`.
+func TagDisplayNameResolver(tagName string) DisplayNameResolver {
+	return func(field reflect.StructField) string {
+		return field.Tag.Get(tagName)
+	}
+}
+
+// JsonDisplayNameResolver resolves display names from the `json` tag, i.e. `json:"user_name,omitempty"` would resolve
+// to `user_name`. Options after a comma are ignored, as is the value `-`.
+func JsonDisplayNameResolver(field reflect.StructField) string {
+	name := field.Tag.Get("json")
+
+	if index := strings.Index(name, ","); index != -1 {
+		name = name[:index]
+	}
+
+	if name == "-" {
+		return ""
+	}
+
+	return name
+}
+
 func reflectValue(value interface{}) reflect.Type {
 	reflectedValueType := reflect.TypeOf(value)
 
-	if reflectedValueType.Kind() == reflect.Ptr {
+	if reflectedValueType != nil && reflectedValueType.Kind() == reflect.Ptr {
 		reflectedValueType = reflectedValueType.Elem()
 	}
 
 	return reflectedValueType
 }
 
-var structFieldCache map[reflect.Type][]*ReflectedField = map[reflect.Type][]*ReflectedField{}
-
+// GetStructFields reflects the fields of a struct (or pointer to struct) with the rules of a tag. If displayNameTag is
+// not nil, then display names are read from the tag with that name.
 func GetStructFields(value interface{}, tagName string, displayNameTag *string) ([]*ReflectedField, error) {
-	var fields []*ReflectedField
+	var displayNameResolver DisplayNameResolver
+
+	if displayNameTag != nil {
+		displayNameResolver = TagDisplayNameResolver(*displayNameTag)
+	}
+
+	return GetStructFieldsWithResolver(value, tagName, displayNameResolver)
+}
+
+// GetStructFieldsWithResolver reflects the fields of a struct like GetStructFields, with display names resolved by
+// displayNameResolver.
+func GetStructFieldsWithResolver(value interface{}, tagName string, displayNameResolver DisplayNameResolver) ([]*ReflectedField, error) {
+	reflectedType, err := structTypeOf(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return getTypeFields(reflectedType, []Tag{{Name: tagName}}, displayNameResolver, false)
+}
+
+// TagParser parses the rules of a tag into validator groups, i.e. to support the tag syntax of another library.
+type TagParser func(tag string) ([]parser.Methods, error)
+
+// Tag is a struct tag that rules are read from, and the parser of its syntax. Tags without a parser are parsed with
+// parser.Parse.
+type Tag struct {
+	Name   string
+	Parser TagParser
+}
+
+// parseTags parses the rules of the tags of a field. The rules of all tags are required to pass, so the groups of
+// each tag are combined with the groups of the other tags.
+func parseTags(field reflect.StructField, tags []Tag) ([]parser.Methods, error) {
+	var methodGroups []parser.Methods
+
+	for _, tag := range tags {
+		parse := tag.Parser
+
+		if parse == nil {
+			parse = parser.Parse
+		}
+
+		tagMethodGroups, err := parse(field.Tag.Get(tag.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		methodGroups = combineMethodGroups(methodGroups, tagMethodGroups)
+	}
+
+	return methodGroups, nil
+}
+
+// IgnoreDirective excludes a field from validation and traversal, i.e. `validate:"-"` or `validate:"ignore"`.
+const IgnoreDirective = "ignore"
+
+// LabelDirective sets the display name of a field in error messages, i.e. `validate:"as(Email address),email"`. The
+// label takes precedence over display names resolved by a tag or function.
+const LabelDirective = "as"
+
+// GetLabel returns the argument of the first `as` directive of the validator groups of a field, if any.
+func GetLabel(methodGroups []parser.Methods) (string, bool) {
+	for _, methods := range methodGroups {
+		for _, method := range methods {
+			if method.Name == LabelDirective && len(method.Arguments) == 1 {
+				return fmt.Sprint(method.Arguments[0]), true
+			}
+		}
+	}
+	return "", false
+}
+
+// InheritDirective makes a field inherit the rules of the field it hides, which is promoted from an embedded struct,
+// i.e. `validate:"inherit,max(50)"`. The rules of the field are added to the inherited rules.
+const InheritDirective = "inherit"
+
+// inheritMethodGroups replaces the `inherit` directive of the validator groups of a field with the rules of the
+// field it hides.
+func inheritMethodGroups(structType reflect.Type, field reflect.StructField, methodGroups []parser.Methods, tags []Tag) ([]parser.Methods, error) {
+	var ownGroups []parser.Methods
+	inherits := false
+
+	for _, methods := range methodGroups {
+		ownMethods := make(parser.Methods, 0, len(methods))
+
+		for _, method := range methods {
+			if method.Name == InheritDirective {
+				if method.Negated {
+					return nil, errors.New("Directive '" + InheritDirective + "' of field '" + field.Name + "' cannot be negated.")
+				}
+				inherits = true
+			} else {
+				ownMethods = append(ownMethods, method)
+			}
+		}
+
+		if len(ownMethods) > 0 || len(methodGroups) > 1 {
+			ownGroups = append(ownGroups, ownMethods)
+		}
+	}
+
+	if !inherits {
+		return methodGroups, nil
+	}
+
+	hiddenField, declaringType, ok := getHiddenField(structType, field.Name)
+
+	if !ok {
+		return nil, errors.New("Field '" + field.Name + "' of '" + structType.Name() + "' inherits rules, but hides no field of an embedded struct.")
+	}
+
+	if isIgnoredField(hiddenField, tags) {
+		return ownGroups, nil
+	}
+
+	inheritedGroups, err := parseTags(hiddenField, tags)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if inheritedGroups, err = inheritMethodGroups(declaringType, hiddenField, inheritedGroups, tags); err != nil {
+		return nil, err
+	}
+
+	return combineMethodGroups(inheritedGroups, ownGroups), nil
+}
+
+// getHiddenField returns the field with name that is promoted from the embedded structs of a struct type, and the
+// struct type that declares it.
+func getHiddenField(structType reflect.Type, name string) (reflect.StructField, reflect.Type, bool) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if !isEmbeddedStruct(field) {
+			continue
+		}
+
+		embeddedType := indirectType(field.Type)
+
+		if hiddenField, ok := embeddedType.FieldByName(name); ok {
+			declaringType := embeddedType
+
+			for _, index := range hiddenField.Index[:len(hiddenField.Index)-1] {
+				declaringType = indirectType(declaringType.Field(index).Type)
+			}
+
+			return hiddenField, declaringType, true
+		}
+	}
+
+	return reflect.StructField{}, nil, false
+}
+
+// getHiddenNames returns the names of the fields of an embedded struct type that are hidden by the fields of the
+// struct type that embeds it.
+func getHiddenNames(structType reflect.Type, embeddedType reflect.Type) map[string]bool {
+	var hidden map[string]bool
 
-	reflectedType := reflectValue(value)
+	for i := 0; i < structType.NumField(); i++ {
+		name := structType.Field(i).Name
 
-	if cachedFields, ok := structFieldCache[reflectedType]; ok {
-		return cachedFields, nil
+		if _, ok := embeddedType.FieldByName(name); ok {
+			if hidden == nil {
+				hidden = make(map[string]bool)
+			}
+			hidden[name] = true
+		}
+	}
+
+	return hidden
+}
+
+func indirectType(reflectedType reflect.Type) reflect.Type {
+	if reflectedType.Kind() == reflect.Ptr {
+		return reflectedType.Elem()
+	}
+	return reflectedType
+}
+
+// isIgnoredField checks whether any of the tags of a field excludes it from validation.
+func isIgnoredField(field reflect.StructField, tags []Tag) bool {
+	for _, tag := range tags {
+		if rules := field.Tag.Get(tag.Name); rules == "-" || rules == IgnoreDirective {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmbeddedStruct checks whether a field is an embedded struct or pointer to struct.
+func isEmbeddedStruct(field reflect.StructField) bool {
+	if !field.Anonymous {
+		return false
+	}
+
+	fieldType := field.Type
+
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
 	}
 
+	return fieldType.Kind() == reflect.Struct
+}
+
+func getTypeFields(reflectedType reflect.Type, tags []Tag, displayNameResolver DisplayNameResolver, includeUnexported bool) ([]*ReflectedField, error) {
+	fields := make([]*ReflectedField, 0, reflectedType.NumField())
+
 	for i := 0; i < reflectedType.NumField(); i++ {
 		field := reflectedType.Field(i)
-		if unicode.IsUpper(rune(field.Name[0])) { // only grab exported fields
-			tagValue := field.Tag.Get(tagName)
-			methodGroups, err := parser.Parse(tagValue)
+		embedded := isEmbeddedStruct(field)
+		unexported := !IsExportedField(field) && !embedded
+
+		// Only grab exported fields, and embedded structs as their exported fields are promoted.
+		if !unexported || includeUnexported {
+			var methodGroups []parser.Methods
+			ignored := isIgnoredField(field, tags)
+
+			if !ignored {
+				var err error
+
+				if methodGroups, err = parseTags(field, tags); err != nil {
+					return nil, err
+				}
 
-			if err != nil {
-				return nil, err
+				if methodGroups, err = inheritMethodGroups(reflectedType, field, methodGroups, tags); err != nil {
+					return nil, err
+				}
 			}
 
 			var displayName *string
 
-			if displayNameTag != nil {
-				if tmpDisplayName := field.Tag.Get(*displayNameTag); len(tmpDisplayName) > 0 {
+			if displayNameResolver != nil {
+				if tmpDisplayName := displayNameResolver(field); len(tmpDisplayName) > 0 {
 					displayName = &tmpDisplayName
 				}
 			}
 
+			var errorMessage *string
+
+			if tmpErrorMessage := field.Tag.Get(ErrorMessageTag); len(tmpErrorMessage) > 0 {
+				errorMessage = &tmpErrorMessage
+			}
+
 			reflectedField := &ReflectedField{
 				Index:        i,
 				Name:         field.Name,
+				StructName:   reflectedType.Name(),
 				DisplayName:  displayName,
+				ErrorMessage: errorMessage,
 				MethodGroups: methodGroups,
+				Embedded:     embedded,
+				Ignored:      ignored,
+				Unexported:   unexported,
+			}
+
+			if embedded {
+				reflectedField.Hidden = getHiddenNames(reflectedType, indirectType(field.Type))
 			}
 
 			fields = append(fields, reflectedField)
 		}
 	}
 
-	structFieldCache[reflectedType] = fields
-
 	return fields, nil
 }
 
+// GetSiblingValue retrieves the normalized value of an exported field, by name, of the source struct.
+func GetSiblingValue(source interface{}, name string) (*NormalizedValue, error) {
+	field, err := GetSiblingField(source, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return Normalize(field.Interface())
+}
+
+// GetSiblingField retrieves the reflected value of an exported field, by name, of the source struct. Fields of nil
+// embedded pointers are retrieved as nil pointers.
+func GetSiblingField(source interface{}, name string) (reflect.Value, error) {
+	sourceStruct := reflect.Indirect(reflect.ValueOf(source))
+
+	if sourceStruct.Kind() != reflect.Struct {
+		return reflect.Value{}, errors.New("Unable to get field '" + name + "' of non struct value.")
+	}
+
+	field, ok := sourceStruct.Type().FieldByName(name)
+
+	if !ok || len(field.PkgPath) > 0 {
+		return reflect.Value{}, errors.New("Field '" + name + "' does not exist.")
+	}
+
+	value, err := sourceStruct.FieldByIndexErr(field.Index)
+
+	if err != nil {
+		return reflect.Zero(reflect.PtrTo(field.Type)), nil
+	}
+
+	return value, nil
+}
+
 var (
 	InvalidMethodError          = errors.New("Method does not exist.")
 	InputParameterMismatchError = errors.New("Parameters does not match those of target function.")
 	UnhandledCallError          = errors.New("Unhandled function call error.")
 )
 
-// Source: http://stackoverflow.com/questions/27673747/reflection-error-on-golang-too-few-arguments
-// Note, this is really ugly/messy and should definitely be cleaned up.
-func CallDynamicMethod(i interface{}, methodName string, args ...interface{}) ([]interface{}, error) {
-	var ptr reflect.Value
-	var value reflect.Value
-	var finalMethod reflect.Value
+// ParameterError is returned by CallDynamicMethod if the arguments don't match the parameters of the method. It's
+// matched by InputParameterMismatchError.
+type ParameterError struct {
+	Method string
 
-	value = reflect.ValueOf(i)
+	// Index is the index of the mismatched argument, or -1 if the number of arguments doesn't match.
+	Index int
 
-	if value.Type().Kind() == reflect.Ptr {
-		ptr = value
-		value = ptr.Elem()
-	} else {
-		ptr = reflect.New(reflect.TypeOf(i))
-		temp := ptr.Elem()
-		temp.Set(value)
+	// Expected is the type of the mismatched parameter, and Actual the type of the argument, nil for nil arguments.
+	Expected reflect.Type
+	Actual   reflect.Type
+}
+
+func (this *ParameterError) Error() string {
+	if this.Index < 0 {
+		return "Number of arguments does not match the parameters of method '" + this.Method + "'."
 	}
 
-	method := value.MethodByName(methodName)
+	actual := "nil"
 
-	if method.IsValid() {
-		finalMethod = method
+	if this.Actual != nil {
+		actual = this.Actual.String()
 	}
 
-	method = ptr.MethodByName(methodName)
+	return fmt.Sprintf("Argument %d of method '%s' must be of type '%s', got '%s'.",
+		this.Index, this.Method, this.Expected, actual)
+}
 
-	if method.IsValid() {
-		finalMethod = method
-	} else {
+func (this *ParameterError) Is(target error) bool {
+	return target == InputParameterMismatchError
+}
+
+// CallDynamicMethod calls a method of i by name, with pointer or value receiver. Arguments are passed as they are if
+// they are assignable to the parameters, otherwise non-nil pointers are passed by the values they point to, values are
+// converted to types of the same kind, and numbers are converted if they fit the parameters exactly, i.e. 2.0 for an
+// int. The arguments of variadic
+// parameters are passed one by one, or as a slice as the last argument. It fails with InvalidMethodError if the
+// method doesn't exist, and with a ParameterError if the arguments don't match.
+func CallDynamicMethod(i interface{}, methodName string, args ...interface{}) ([]interface{}, error) {
+	method := methodByName(reflect.ValueOf(i), methodName)
+
+	if !method.IsValid() {
 		return nil, InvalidMethodError
 	}
 
-	if finalMethod.IsValid() {
-		funcType := reflect.TypeOf(finalMethod.Interface())
+	funcType := method.Type()
+	numParameters := funcType.NumIn()
+	variadic := funcType.IsVariadic()
 
-		numParameters := funcType.NumIn()
+	// A slice as the last argument of a variadic method is passed as its variadic arguments, i.e. `f(args...)`.
+	spread := variadic && len(args) == numParameters && args[len(args)-1] != nil &&
+		reflect.TypeOf(args[len(args)-1]).AssignableTo(funcType.In(numParameters-1))
 
-		if len(args) != numParameters {
-			return nil, InputParameterMismatchError
-		}
+	if len(args) != numParameters && (!variadic || len(args) < numParameters-1) {
+		return nil, &ParameterError{Method: methodName, Index: -1}
+	}
 
-		for i := 0; i < numParameters; i++ {
-			inputType := funcType.In(i)
-			if inputType.Kind() != reflect.Interface && reflect.TypeOf(args[i]) != inputType {
-				return nil, InputParameterMismatchError
-			}
+	methodArgs := make([]reflect.Value, len(args))
+
+	for i, arg := range args {
+		var parameterType reflect.Type
+
+		if variadic && i >= numParameters-1 && !spread {
+			parameterType = funcType.In(numParameters - 1).Elem()
+		} else {
+			parameterType = funcType.In(i)
 		}
 
-		methodArgs := make([]reflect.Value, len(args))
+		methodArg, ok := argumentValue(arg, parameterType)
 
-		for i, arg := range args {
-			methodArgs[i] = reflect.ValueOf(arg)
+		if !ok {
+			return nil, &ParameterError{Method: methodName, Index: i, Expected: parameterType, Actual: reflect.TypeOf(arg)}
 		}
 
-		callResult := finalMethod.Call(methodArgs)
+		methodArgs[i] = methodArg
+	}
+
+	var callResult []reflect.Value
+
+	if spread {
+		callResult = method.CallSlice(methodArgs)
+	} else {
+		callResult = method.Call(methodArgs)
+	}
+
+	returnValues := make([]interface{}, len(callResult))
+
+	for i, result := range callResult {
+		returnValues[i] = result.Interface()
+	}
+
+	return returnValues, nil
+}
 
-		returnValues := make([]interface{}, len(callResult))
+// methodByName returns the method of a value by name, including the methods with pointer receiver. Values that aren't
+// pointers are copied to be addressable.
+func methodByName(value reflect.Value, methodName string) reflect.Value {
+	if !value.IsValid() {
+		return reflect.Value{}
+	}
 
-		for i, result := range callResult {
-			returnValues[i] = result.Interface()
+	if value.Kind() != reflect.Ptr {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		value = ptr
+	} else if value.IsNil() {
+		return reflect.Value{}
+	}
+
+	return value.MethodByName(methodName)
+}
+
+// argumentValue returns the value of an argument for a parameter of a type, if the argument matches the parameter.
+func argumentValue(arg interface{}, parameterType reflect.Type) (reflect.Value, bool) {
+	if arg == nil {
+		switch parameterType.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Chan, reflect.Func:
+			return reflect.Zero(parameterType), true
+		default:
+			return reflect.Value{}, false
 		}
+	}
 
-		return returnValues, nil
+	value := reflect.ValueOf(arg)
+
+	if value.Type().AssignableTo(parameterType) {
+		return value, true
+	}
+
+	if value.Kind() == reflect.Ptr && !value.IsNil() && value.Elem().Type().AssignableTo(parameterType) {
+		return value.Elem(), true
 	}
 
-	return nil, UnhandledCallError
+	// Values of named types are converted to other types of the same kind, i.e. a string for a `type Name string`.
+	if value.Kind() == parameterType.Kind() && value.Type().ConvertibleTo(parameterType) {
+		return value.Convert(parameterType), true
+	}
+
+	if isNumericKind(value.Kind()) && isNumericKind(parameterType.Kind()) {
+		converted := value.Convert(parameterType)
+
+		// Numbers that don't fit the parameter, i.e. 1.5 for an int, would be changed by the conversion.
+		if converted.Convert(value.Type()).Interface() == arg {
+			return converted, true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Float64
 }
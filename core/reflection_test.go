@@ -1,7 +1,10 @@
 package core_test
 
 import (
+	"errors"
+	"fmt"
 	. "github.com/typerandom/validator/core"
+	"reflect"
 	"testing"
 )
 
@@ -85,3 +88,190 @@ func TestThatSpecificStructFieldsCanBeReflected(t *testing.T) {
 		}
 	}
 }
+
+func TestThatTagDisplayNameResolverUsesTagValue(t *testing.T) {
+	type Foo struct {
+		ValueA string `label:"Value A, optional"`
+		ValueB string
+	}
+
+	resolver := TagDisplayNameResolver("label")
+	fooType := reflect.TypeOf(Foo{})
+
+	expectedNames := []string{"Value A, optional", ""}
+
+	for i, expectedName := range expectedNames {
+		if name := resolver(fooType.Field(i)); name != expectedName {
+			t.Fatalf("Expected display name of field %d to be '%s', but got '%s'.", i, expectedName, name)
+		}
+	}
+}
+
+func TestThatJsonDisplayNameResolverIgnoresTagOptions(t *testing.T) {
+	type Foo struct {
+		ValueA string `json:"value_a,omitempty"`
+		ValueB string `json:"-"`
+		ValueC string `json:",omitempty"`
+		ValueD string
+	}
+
+	fooType := reflect.TypeOf(Foo{})
+
+	expectedNames := []string{"value_a", "", "", ""}
+
+	for i, expectedName := range expectedNames {
+		if name := JsonDisplayNameResolver(fooType.Field(i)); name != expectedName {
+			t.Fatalf("Expected display name of field %d to be '%s', but got '%s'.", i, expectedName, name)
+		}
+	}
+}
+
+type callDummy struct {
+	Prefix string
+}
+
+type callName string
+
+func (this callDummy) Join(values ...string) string {
+	return fmt.Sprint(this.Prefix, values)
+}
+
+func (this *callDummy) Format(format string, count int64, args ...interface{}) string {
+	return fmt.Sprintf(format, count, args)
+}
+
+func (this *callDummy) Greet(name callName, err error) string {
+	return this.Prefix + string(name) + fmt.Sprint(err)
+}
+
+func testThatMethodCallReturns(t *testing.T, source interface{}, methodName string, expected string, args ...interface{}) {
+	returnValues, err := CallDynamicMethod(source, methodName, args...)
+
+	if err != nil {
+		t.Fatalf("Tested %s%v. Didn't expect error, got %s.", methodName, args, err)
+	}
+
+	if len(returnValues) != 1 || returnValues[0] != expected {
+		t.Fatalf("Tested %s%v. Expected '%s', got %v.", methodName, args, expected, returnValues)
+	}
+}
+
+type inheritAudit struct {
+	CreatedBy string `test:"not_empty"`
+}
+
+type inheritBase struct {
+	*inheritAudit
+	CreatedBy string `test:"inherit,email"`
+}
+
+func TestThatInheritedRulesAreResolvedThroughEmbeddedStructs(t *testing.T) {
+	type Foo struct {
+		inheritBase
+		CreatedBy string `test:"inherit,max(50)|empty"`
+	}
+
+	fields, err := GetStructFields(&Foo{}, "test", nil)
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	if !fields[0].Hidden["CreatedBy"] {
+		t.Fatalf("Expected 'CreatedBy' of embedded struct to be hidden, but got %v.", fields[0].Hidden)
+	}
+
+	var groups []string
+
+	for _, methods := range fields[1].MethodGroups {
+		var names []string
+
+		for _, method := range methods {
+			names = append(names, method.Name)
+		}
+
+		groups = append(groups, fmt.Sprint(names))
+	}
+
+	if expected := "[[not_empty email max] [not_empty email empty]]"; fmt.Sprint(groups) != expected {
+		t.Fatalf("Expected groups %s, but got %s.", expected, groups)
+	}
+}
+
+func TestThatDynamicMethodsCanBeCalledWithValueAndPointerReceivers(t *testing.T) {
+	testThatMethodCallReturns(t, callDummy{Prefix: "a"}, "Join", "a[b]", "b")
+	testThatMethodCallReturns(t, &callDummy{Prefix: "a"}, "Join", "a[b]", "b")
+	testThatMethodCallReturns(t, callDummy{Prefix: "a"}, "Greet", "ab<nil>", callName("b"), nil)
+}
+
+func TestThatDynamicMethodsCanBeCalledWithAssignableAndConvertibleArguments(t *testing.T) {
+	name := callName("b")
+
+	testThatMethodCallReturns(t, &callDummy{Prefix: "a"}, "Greet", "abc", "b", errors.New("c"))
+	testThatMethodCallReturns(t, &callDummy{Prefix: "a"}, "Greet", "ab<nil>", &name, nil)
+	testThatMethodCallReturns(t, &callDummy{}, "Format", "2 []", "%d %v", float64(2))
+	testThatMethodCallReturns(t, &callDummy{}, "Format", "3 []", "%d %v", 3)
+}
+
+func TestThatVariadicDynamicMethodsCanBeCalled(t *testing.T) {
+	testThatMethodCallReturns(t, callDummy{}, "Join", "[]")
+	testThatMethodCallReturns(t, callDummy{}, "Join", "[a b]", "a", "b")
+	testThatMethodCallReturns(t, callDummy{}, "Join", "[a b]", []string{"a", "b"})
+	testThatMethodCallReturns(t, &callDummy{}, "Format", "1 [x 2]", "%d %v", 1, "x", 2)
+	testThatMethodCallReturns(t, &callDummy{}, "Format", "1 [x]", "%d %v", 1, []interface{}{"x"})
+}
+
+func TestThatDynamicMethodCallWithMismatchedArgumentsFails(t *testing.T) {
+	for _, test := range []struct {
+		methodName string
+		args       []interface{}
+		index      int
+		message    string
+	}{
+		{"Format", []interface{}{"%d %v", 1.5}, 1, "Argument 1 of method 'Format' must be of type 'int64', got 'float64'."},
+		{"Format", []interface{}{"%d %v"}, -1, "Number of arguments does not match the parameters of method 'Format'."},
+		{"Join", []interface{}{"a", 1}, 1, "Argument 1 of method 'Join' must be of type 'string', got 'int'."},
+		{"Greet", []interface{}{1, nil}, 0, "Argument 0 of method 'Greet' must be of type 'core_test.callName', got 'int'."},
+		{"Greet", []interface{}{nil, nil}, 0, "Argument 0 of method 'Greet' must be of type 'core_test.callName', got 'nil'."},
+		{"Greet", []interface{}{"a", "b", "c"}, -1, "Number of arguments does not match the parameters of method 'Greet'."},
+	} {
+		_, err := CallDynamicMethod(&callDummy{}, test.methodName, test.args...)
+
+		var parameterErr *ParameterError
+
+		if !errors.As(err, &parameterErr) || !errors.Is(err, InputParameterMismatchError) {
+			t.Fatalf("Tested %s%v. Expected parameter error, got %v.", test.methodName, test.args, err)
+		}
+
+		if parameterErr.Index != test.index || err.Error() != test.message {
+			t.Fatalf("Tested %s%v. Expected '%s' at %d, got '%s' at %d.", test.methodName, test.args, test.message,
+				test.index, err, parameterErr.Index)
+		}
+	}
+}
+
+func TestThatDynamicMethodCallOfMissingMethodFails(t *testing.T) {
+	var dummy *callDummy
+
+	for _, source := range []interface{}{&callDummy{}, dummy, nil} {
+		if _, err := CallDynamicMethod(source, "Missing"); err != InvalidMethodError {
+			t.Fatalf("Expected invalid method error for %T, got %v.", source, err)
+		}
+	}
+}
+
+func TestThatStructFieldsCanBeReflectedWithDisplayNameResolver(t *testing.T) {
+	type Foo struct {
+		ValueA string `json:"value_a,omitempty" test:"not_empty"`
+	}
+
+	fields, err := GetStructFieldsWithResolver(&Foo{}, "test", JsonDisplayNameResolver)
+
+	if err != nil {
+		t.Fatalf("Didn't expect an error, but got '%s'.", err)
+	}
+
+	if len(fields) != 1 || fields[0].DisplayName == nil || *fields[0].DisplayName != "value_a" {
+		t.Fatalf("Expected display name 'value_a', but got %v.", fields[0].DisplayName)
+	}
+}
@@ -2,26 +2,483 @@ package core
 
 import (
 	"errors"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sync"
 )
 
 type ValidatorFn func(context ValidatorContext, args []interface{}) error
 
-type ValidatorRegistry map[string]ValidatorFn
+// ValidatorRegistry holds validators by name. It's safe for concurrent use.
+type ValidatorRegistry struct {
+	base         *ValidatorRegistry
+	validators   map[string]ValidatorFn
+	aliases      map[string]parser.Methods
+	fieldRules   map[reflect.Type]map[string][]string
+	transforms   map[string]bool
+	converters   map[string]bool
+	schemas      map[string]*ArgumentSchema
+	adapters     map[reflect.Type]TypeAdapter
+	options      map[reflect.Type]OptionAdapter
+	comparers    map[reflect.Type]Comparer
+	batches      map[string]BatchValidatorFn
+	warnings     map[string]bool
+	nilHandlers  map[string]bool
+	pure         map[string]bool
+	descriptions map[string]Description
+	lock         sync.RWMutex
+}
+
+func NewValidatorRegistry() *ValidatorRegistry {
+	return &ValidatorRegistry{
+		validators:   make(map[string]ValidatorFn),
+		aliases:      make(map[string]parser.Methods),
+		fieldRules:   make(map[reflect.Type]map[string][]string),
+		transforms:   make(map[string]bool),
+		converters:   make(map[string]bool),
+		schemas:      make(map[string]*ArgumentSchema),
+		adapters:     make(map[reflect.Type]TypeAdapter),
+		options:      make(map[reflect.Type]OptionAdapter),
+		comparers:    make(map[reflect.Type]Comparer),
+		batches:      make(map[string]BatchValidatorFn),
+		warnings:     make(map[string]bool),
+		nilHandlers:  make(map[string]bool),
+		pure:         make(map[string]bool),
+		descriptions: make(map[string]Description),
+	}
+}
+
+// NewValidatorRegistryWithBase creates a registry on top of a shared base registry. Validators, aliases, field rules
+// and type adapters of the base are used unless the registry registers its own, and registering them with the
+// registry never changes the base.
+func NewValidatorRegistryWithBase(base *ValidatorRegistry) *ValidatorRegistry {
+	registry := NewValidatorRegistry()
+	registry.base = base
+	return registry
+}
+
+// Copy returns a copy of the registry on top of the same base, so that registering validators with the copy doesn't
+// affect the registry, and the other way around.
+func (r *ValidatorRegistry) Copy() *ValidatorRegistry {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	registry := NewValidatorRegistryWithBase(r.base)
+
+	for name, validator := range r.validators {
+		registry.validators[name] = validator
+	}
+
+	for name, methods := range r.aliases {
+		registry.aliases[name] = methods
+	}
+
+	for reflectedType, fields := range r.fieldRules {
+		registry.fieldRules[reflectedType] = make(map[string][]string, len(fields))
+
+		for fieldName, rules := range fields {
+			registry.fieldRules[reflectedType][fieldName] = append([]string(nil), rules...)
+		}
+	}
+
+	for name, schema := range r.schemas {
+		registry.schemas[name] = schema
+	}
+
+	for reflectedType, adapter := range r.adapters {
+		registry.adapters[reflectedType] = adapter
+	}
+
+	for reflectedType, adapter := range r.options {
+		registry.options[reflectedType] = adapter
+	}
+
+	for reflectedType, comparer := range r.comparers {
+		registry.comparers[reflectedType] = comparer
+	}
+
+	for name, batch := range r.batches {
+		registry.batches[name] = batch
+	}
+
+	copyFlags(registry.transforms, r.transforms)
+	copyFlags(registry.converters, r.converters)
+	copyFlags(registry.warnings, r.warnings)
+	copyFlags(registry.nilHandlers, r.nilHandlers)
+	copyFlags(registry.pure, r.pure)
+
+	for name, description := range r.descriptions {
+		registry.descriptions[name] = description
+	}
+
+	return registry
+}
+
+// Base returns the base registry of the registry, or nil if it has none.
+func (r *ValidatorRegistry) Base() *ValidatorRegistry {
+	return r.base
+}
+
+func copyFlags(dst map[string]bool, src map[string]bool) {
+	for name, flag := range src {
+		dst[name] = flag
+	}
+}
+
+// registryOf returns the layer that registered the validator with name, that is the registry itself or one of its
+// bases. The registry itself is returned if no layer registered it.
+func (r *ValidatorRegistry) registryOf(name string) *ValidatorRegistry {
+	if r.base == nil {
+		return r
+	}
+
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		_, ok := registry.validators[name]
+		registry.lock.RUnlock()
+
+		if ok {
+			return registry
+		}
+	}
+
+	return r
+}
+
+// RegisterFieldRules registers rules for a field of a struct type (or pointer to struct type), in addition to the
+// rules of the field tag. The rules are parsed when the fields of the type are reflected.
+func (r *ValidatorRegistry) RegisterFieldRules(value interface{}, fieldName string, rules string) {
+	reflectedType := reflectValue(value)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.fieldRules[reflectedType] == nil {
+		r.fieldRules[reflectedType] = make(map[string][]string)
+	}
+
+	r.fieldRules[reflectedType][fieldName] = append(r.fieldRules[reflectedType][fieldName], rules)
+}
+
+// GetFieldRules returns the registered rules of a struct type by field name. Rules of the base registry come before
+// the rules of the registry itself.
+func (r *ValidatorRegistry) GetFieldRules(reflectedType reflect.Type) map[string][]string {
+	var fieldRules map[string][]string
+
+	if r.base != nil {
+		fieldRules = r.base.GetFieldRules(reflectedType)
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if fieldRules == nil {
+		fieldRules = make(map[string][]string, len(r.fieldRules[reflectedType]))
+	}
+
+	for fieldName, rules := range r.fieldRules[reflectedType] {
+		fieldRules[fieldName] = append(fieldRules[fieldName], rules...)
+	}
+
+	return fieldRules
+}
+
+// RegisterAlias registers a set of validators by name, i.e. `RegisterAlias("username", "not_empty,min(3),max(30)")`.
+// The rules cannot contain groups (`|`), and are expanded into the validators of a tag when the tag is parsed. Aliases
+// of a single validator without arguments take the arguments of the tag, i.e. `contains(@)` of `RegisterAlias("contains", "contain")`.
+func (r *ValidatorRegistry) RegisterAlias(name string, rules string) error {
+	methodGroups, err := parser.Parse(rules)
+
+	if err != nil {
+		return err
+	}
+
+	if len(methodGroups) != 1 {
+		return errors.New("Alias '" + name + "' cannot contain validator groups.")
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-func NewValidatorRegistry() ValidatorRegistry {
-	return make(ValidatorRegistry)
+	r.aliases[name] = methodGroups[0]
+
+	return nil
+}
+
+// ExpandAliases replaces the aliases of method groups with the validators of the aliases.
+func (r *ValidatorRegistry) ExpandAliases(methodGroups []parser.Methods) ([]parser.Methods, error) {
+	if !r.hasAliases() {
+		return methodGroups, nil
+	}
+
+	expandedGroups := make([]parser.Methods, len(methodGroups))
+
+	for i, methods := range methodGroups {
+		expandedMethods, err := r.expandAliases(methods, nil)
+
+		if err != nil {
+			return nil, err
+		}
+
+		expandedGroups[i] = expandedMethods
+	}
+
+	return expandedGroups, nil
+}
+
+func (r *ValidatorRegistry) expandAliases(methods parser.Methods, expanding []string) (parser.Methods, error) {
+	var expandedMethods parser.Methods
+
+	for _, method := range methods {
+		aliasMethods, ok := r.getAlias(method.Name)
+
+		if !ok {
+			expandedMethods = append(expandedMethods, method)
+			continue
+		}
+
+		if len(method.Arguments) > 0 || len(method.NamedArguments) > 0 {
+			if len(aliasMethods) != 1 || len(aliasMethods[0].Arguments) > 0 || len(aliasMethods[0].NamedArguments) > 0 {
+				return nil, errors.New("Alias '" + method.Name + "' does not support any arguments.")
+			}
+
+			renamedMethod := *method
+			renamedMethod.Name = aliasMethods[0].Name
+			renamedMethod.Negated = aliasMethods[0].Negated
+			aliasMethods = parser.Methods{&renamedMethod}
+		}
+
+		for _, name := range expanding {
+			if name == method.Name {
+				return nil, errors.New("Alias '" + method.Name + "' references itself.")
+			}
+		}
+
+		aliasMethods, err := r.expandAliases(aliasMethods, append(expanding, method.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Only aliases of a single validator can be negated, as the validators of an alias must all pass.
+		if method.Negated {
+			if len(aliasMethods) != 1 {
+				return nil, errors.New("Alias '" + method.Name + "' cannot be negated, as it has more than one validator.")
+			}
+
+			negatedMethod := *aliasMethods[0]
+			negatedMethod.Negated = !negatedMethod.Negated
+			aliasMethods = parser.Methods{&negatedMethod}
+		}
+
+		expandedMethods = append(expandedMethods, aliasMethods...)
+	}
+
+	return expandedMethods, nil
+}
+
+func (r *ValidatorRegistry) hasAliases() bool {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		count := len(registry.aliases)
+		registry.lock.RUnlock()
+
+		if count > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *ValidatorRegistry) getAlias(name string) (parser.Methods, bool) {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		methods, ok := registry.aliases[name]
+		registry.lock.RUnlock()
+
+		if ok {
+			return methods, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *ValidatorRegistry) Register(name string, validator ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = validator
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.schemas, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
+}
+
+// RegisterWithSchema registers a validator whose arguments are checked and converted by schema when tags are
+// parsed. The validator receives the converted arguments, i.e. int64 for ArgumentInt.
+func (r *ValidatorRegistry) RegisterWithSchema(name string, validator ValidatorFn, schema *ArgumentSchema) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = validator
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
+	r.schemas[name] = schema
+}
+
+// SetSchema sets the argument schema of a registered validator by name, without changing how it was registered, i.e.
+// of a transformer. The schema is removed if the validator is registered again.
+func (r *ValidatorRegistry) SetSchema(name string, schema *ArgumentSchema) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.schemas[name] = schema
+}
+
+// RegisterTransformer registers a validator that transforms the value of a field with context.SetValue, i.e. `trim`.
+// The transformed value is written back to the field if the validated value is passed by pointer and the field is
+// valid.
+func (r *ValidatorRegistry) RegisterTransformer(name string, transformer ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = transformer
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	r.transforms[name] = true
+	delete(r.converters, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
+	delete(r.schemas, name)
+}
+
+// RegisterConverter registers a validator that converts the value of a field with context.SetValue, i.e. `to_int`
+// parses a string into an int64. Unlike transformed values, the converted value is only written back to the field if
+// the field is of the converted type, i.e. an int field for an int64, or to the field named by the `target`
+// argument of the converter, i.e. `to_int(target=Age)`.
+func (r *ValidatorRegistry) RegisterConverter(name string, converter ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = converter
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	r.converters[name] = true
+	delete(r.transforms, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
+	delete(r.schemas, name)
+}
+
+// RegisterWarning registers a validator whose errors are warnings, i.e. `deprecated_format`. Warnings are reported
+// with the errors of a validation, but don't fail it.
+func (r *ValidatorRegistry) RegisterWarning(name string, validator ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = validator
+	delete(r.descriptions, name)
+	delete(r.pure, name)
+	r.warnings[name] = true
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.schemas, name)
+	delete(r.batches, name)
+	delete(r.nilHandlers, name)
+}
+
+// RegisterPure registers a validator that is pure, i.e. `email`, that is its result only depends on the value and
+// arguments that it's run with. It must not use other values of its context, such as the source struct, nor change
+// them. The results of pure validators may be memoized by validators.
+func (r *ValidatorRegistry) RegisterPure(name string, validator ValidatorFn) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.validators[name] = validator
+	delete(r.descriptions, name)
+	r.pure[name] = true
+	delete(r.transforms, name)
+	delete(r.converters, name)
+	delete(r.schemas, name)
+	delete(r.batches, name)
+	delete(r.warnings, name)
+	delete(r.nilHandlers, name)
 }
 
-func (r ValidatorRegistry) Register(name string, validator ValidatorFn) {
-	r[name] = validator
+// IsPure checks whether the validator with name was registered as a pure validator.
+func (r *ValidatorRegistry) IsPure(name string) bool {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	return registry.pure[name]
 }
 
-func (r ValidatorRegistry) Get(name string) (ValidatorFn, error) {
-	validator, ok := r[name]
+// IsWarning checks whether the validator with name was registered as a warning.
+func (r *ValidatorRegistry) IsWarning(name string) bool {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	return registry.warnings[name]
+}
+
+// IsTransformer checks whether the validator with name was registered as a transformer.
+func (r *ValidatorRegistry) IsTransformer(name string) bool {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	return registry.transforms[name]
+}
+
+// IsConverter checks whether the validator with name was registered as a converter.
+func (r *ValidatorRegistry) IsConverter(name string) bool {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	return registry.converters[name]
+}
+
+// CheckNegation checks whether the validator with name can be negated, i.e. `!uppercase`. Transformers, converters and
+// batch validators can't be negated, as they don't only pass or fail.
+func (r *ValidatorRegistry) CheckNegation(name string) error {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+
+	if registry.transforms[name] || registry.converters[name] || registry.batches[name] != nil {
+		return errors.New("Validator '" + name + "' cannot be negated.")
+	}
+
+	return nil
+}
+
+func (r *ValidatorRegistry) Get(name string) (ValidatorFn, error) {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	validator, ok := registry.validators[name]
+	registry.lock.RUnlock()
 
 	if !ok {
-		return nil, errors.New("Validator '" + name + "' is not registered.")
+		return nil, notRegisteredError(name)
 	}
 
 	return validator, nil
 }
+
+// pluggableValidators hold how to register the validators that aren't registered by default, as they depend on
+// packages outside of the standard library.
+var pluggableValidators = map[string]string{
+	"yaml": "Register YamlValidator with the Unmarshal function of a YAML package, i.e. `Register(\"yaml\", validators.YamlValidator(yaml.Unmarshal))`.",
+}
+
+func notRegisteredError(name string) error {
+	if hint, ok := pluggableValidators[name]; ok {
+		return errors.New("Validator '" + name + "' is not registered. " + hint)
+	}
+	return errors.New("Validator '" + name + "' is not registered.")
+}
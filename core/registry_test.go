@@ -0,0 +1,49 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"reflect"
+	"testing"
+)
+
+type registryDummy struct {
+	Name string
+}
+
+func TestThatRegistryWithBaseAppendsFieldRulesToRulesOfBase(t *testing.T) {
+	base := NewValidatorRegistry()
+	base.RegisterFieldRules(registryDummy{}, "Name", "not_empty")
+
+	registry := NewValidatorRegistryWithBase(base)
+	registry.RegisterFieldRules(registryDummy{}, "Name", "min(3)")
+
+	rules := registry.GetFieldRules(reflect.TypeOf(registryDummy{}))
+
+	if !reflect.DeepEqual(rules["Name"], []string{"not_empty", "min(3)"}) {
+		t.Fatalf("Expected rules of base and registry, got %v.", rules["Name"])
+	}
+
+	if rules := base.GetFieldRules(reflect.TypeOf(registryDummy{})); len(rules["Name"]) != 1 {
+		t.Fatalf("Expected rules of base only, got %v.", rules["Name"])
+	}
+}
+
+func TestThatCopyOfRegistryDoesNotShareValidators(t *testing.T) {
+	registry := NewValidatorRegistry()
+	registry.RegisterTransformer("trim", func(context ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	registryCopy := registry.Copy()
+	registryCopy.Register("is_copy", func(context ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if !registryCopy.IsTransformer("trim") {
+		t.Fatalf("Expected copied transformer, got none.")
+	}
+
+	if _, err := registry.Get("is_copy"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+}
@@ -0,0 +1,274 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/typerandom/validator/core/parser"
+	"math"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// ArgumentType is the type of a validator argument declared by an ArgumentSchema.
+type ArgumentType int
+
+const (
+	// ArgumentAny accepts any argument without conversion.
+	ArgumentAny ArgumentType = iota
+	// ArgumentString converts the argument to a string. Numbers are converted to their shortest text representation.
+	ArgumentString
+	// ArgumentInt converts the argument to an int64. The argument must be a number without fraction.
+	ArgumentInt
+	// ArgumentFloat converts the argument to a float64.
+	ArgumentFloat
+	// ArgumentBool converts the argument to a bool.
+	ArgumentBool
+	// ArgumentRegexp compiles the argument to a *regexp.Regexp.
+	ArgumentRegexp
+	// ArgumentNumber checks that the argument is a number, without converting it, so that integers that float64 can't
+	// represent exactly keep their precision.
+	ArgumentNumber
+)
+
+func (this ArgumentType) String() string {
+	switch this {
+	case ArgumentString:
+		return "string"
+	case ArgumentInt:
+		return "integer"
+	case ArgumentFloat, ArgumentNumber:
+		return "number"
+	case ArgumentBool:
+		return "boolean"
+	case ArgumentRegexp:
+		return "regular expression"
+	}
+	return "any"
+}
+
+// ArgumentSchema declares the arguments of a validator, so that arguments are checked and converted once when tags
+// are parsed, rather than every time the validator runs. I.e. `&ArgumentSchema{Types: []ArgumentType{ArgumentInt}, Required: 1}`
+// declares a single required integer, which is passed to the validator as int64.
+type ArgumentSchema struct {
+	// Types are the types of the positional arguments.
+	Types []ArgumentType
+	// Required is the number of required arguments, the remaining arguments are optional.
+	Required int
+	// Defaults are the values of optional arguments that are omitted, by position. I.e. the default of the second
+	// argument is `Defaults[1]`. Values of required arguments are ignored.
+	Defaults []interface{}
+	// Variadic allows any number of arguments of the last type.
+	Variadic bool
+	// Named are the names of the named arguments, i.e. `min` and `max` of `between(min=1,max=10)`, which count towards
+	// the required arguments. Converters also support `target`.
+	Named []string
+	// NamedAsText passes named arguments as text at the positions where they're specified, i.e. `a=b` of `match(a=b)`,
+	// for validators whose arguments can contain `=`.
+	NamedAsText bool
+}
+
+// Convert checks the number of arguments, converts them to their declared types and appends defaults.
+func (this *ArgumentSchema) Convert(args []interface{}) ([]interface{}, error) {
+	return this.convert(args, 0)
+}
+
+// convert converts the positional arguments of a method with a number of named arguments.
+func (this *ArgumentSchema) convert(args []interface{}, named int) ([]interface{}, error) {
+	required := this.Required
+
+	if len(args)+named < required {
+		return nil, errors.New("Requires at least " + strconv.Itoa(required) + " arguments, got " + strconv.Itoa(len(args)+named) + ".")
+	}
+
+	if !this.Variadic && len(args) > len(this.Types) {
+		return nil, errors.New("Supports at most " + strconv.Itoa(len(this.Types)) + " arguments, got " + strconv.Itoa(len(args)) + ".")
+	}
+
+	converted := make([]interface{}, 0, len(this.Types))
+
+	for i, arg := range args {
+		argType := ArgumentAny
+
+		if i < len(this.Types) {
+			argType = this.Types[i]
+		} else if len(this.Types) > 0 {
+			argType = this.Types[len(this.Types)-1]
+		}
+
+		value, err := convertArgument(arg, argType)
+
+		if err != nil {
+			return nil, errors.New("Requires argument " + strconv.Itoa(i+1) + " to be of type " + argType.String() + ". " + err.Error())
+		}
+
+		converted = append(converted, value)
+	}
+
+	for i := len(args); i < len(this.Defaults); i++ {
+		converted = append(converted, this.Defaults[i])
+	}
+
+	return converted, nil
+}
+
+func convertArgument(arg interface{}, argType ArgumentType) (interface{}, error) {
+	switch argType {
+	case ArgumentString:
+		switch typedArg := arg.(type) {
+		case string:
+			return typedArg, nil
+		case float64:
+			return strconv.FormatFloat(typedArg, 'f', -1, 64), nil
+		case int64:
+			return strconv.FormatInt(typedArg, 10), nil
+		case bool:
+			return strconv.FormatBool(typedArg), nil
+		case *big.Int:
+			return typedArg.String(), nil
+		}
+	case ArgumentInt:
+		switch typedArg := arg.(type) {
+		case int64:
+			return typedArg, nil
+		case float64:
+			if typedArg == math.Trunc(typedArg) && math.Abs(typedArg) <= parser.MaxExactInteger {
+				return int64(typedArg), nil
+			}
+		}
+	case ArgumentFloat:
+		switch typedArg := arg.(type) {
+		case float64:
+			return typedArg, nil
+		case int64:
+			return float64(typedArg), nil
+		}
+	case ArgumentBool:
+		if typedArg, ok := arg.(bool); ok {
+			return typedArg, nil
+		}
+	case ArgumentRegexp:
+		switch typedArg := arg.(type) {
+		case *regexp.Regexp:
+			return typedArg, nil
+		case string:
+			return regexp.Compile(typedArg)
+		}
+	case ArgumentNumber:
+		switch arg.(type) {
+		case float64, int64, uint64, *big.Int:
+			return arg, nil
+		}
+	default:
+		return arg, nil
+	}
+
+	return nil, errors.New("Got '" + fmt.Sprint(arg) + "'.")
+}
+
+// ConvertArguments returns copies of the methods of the groups with arguments converted by the schemas of their
+// validators, and named arguments checked against the names declared by the schemas. Methods of validators without
+// schemas are left as they are.
+func (r *ValidatorRegistry) ConvertArguments(methodGroups []parser.Methods) ([]parser.Methods, error) {
+	if !r.hasSchemas() {
+		return methodGroups, nil
+	}
+
+	convertedGroups := make([]parser.Methods, len(methodGroups))
+
+	for i, methods := range methodGroups {
+		convertedMethods := make(parser.Methods, len(methods))
+
+		for j, method := range methods {
+			convertedMethods[j] = method
+
+			schema, ok := r.getSchema(method.Name)
+
+			if !ok {
+				continue
+			}
+
+			if schema.NamedAsText && len(method.NamedArguments) > 0 {
+				method = method.WithoutNamedArguments()
+			}
+
+			if err := checkNamedArguments(r.namedArguments(method.Name, schema), method.NamedArguments); err != nil {
+				return nil, errors.New("Validator '" + method.Name + "' has invalid arguments. " + err.Error())
+			}
+
+			args, err := schema.convert(method.Arguments, len(method.NamedArguments))
+
+			if err != nil {
+				return nil, errors.New("Validator '" + method.Name + "' has invalid arguments. " + err.Error())
+			}
+
+			convertedMethod := *method
+			convertedMethod.Arguments = args
+			convertedMethods[j] = &convertedMethod
+		}
+
+		convertedGroups[i] = convertedMethods
+	}
+
+	return convertedGroups, nil
+}
+
+// namedArguments returns the names of the named arguments of a validator, which are declared by its schema. Converters
+// also support `target`.
+func (r *ValidatorRegistry) namedArguments(name string, schema *ArgumentSchema) []string {
+	named := schema.Named
+
+	if r.IsConverter(name) {
+		named = append(named[:len(named):len(named)], "target")
+	}
+
+	return named
+}
+
+// checkNamedArguments checks that the named arguments of a method are declared.
+func checkNamedArguments(declared []string, named parser.NamedArguments) error {
+	names := make([]string, 0, len(named))
+
+	for name := range named {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		found := false
+
+		for _, declaredName := range declared {
+			found = found || declaredName == name
+		}
+
+		if !found {
+			return errors.New("Doesn't support argument '" + name + "'.")
+		}
+	}
+
+	return nil
+}
+
+func (r *ValidatorRegistry) hasSchemas() bool {
+	for registry := r; registry != nil; registry = registry.base {
+		registry.lock.RLock()
+		count := len(registry.schemas)
+		registry.lock.RUnlock()
+
+		if count > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *ValidatorRegistry) getSchema(name string) (*ArgumentSchema, bool) {
+	registry := r.registryOf(name)
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	schema, ok := registry.schemas[name]
+	return schema, ok
+}
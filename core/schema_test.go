@@ -0,0 +1,145 @@
+package core_test
+
+import (
+	. "github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"regexp"
+	"testing"
+)
+
+func TestThatArgumentSchemaConvertsArguments(t *testing.T) {
+	schema := &ArgumentSchema{
+		Types:    []ArgumentType{ArgumentInt, ArgumentString, ArgumentRegexp, ArgumentFloat},
+		Required: 3,
+	}
+
+	args, err := schema.Convert([]interface{}{float64(5), float64(1.5), "^a+$"})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if args[0] != int64(5) || args[1] != "1.5" {
+		t.Fatalf("Expected converted arguments, got %#v.", args)
+	}
+
+	if expr, ok := args[2].(*regexp.Regexp); !ok || !expr.MatchString("aaa") {
+		t.Fatalf("Expected compiled regexp, got %#v.", args[2])
+	}
+}
+
+func TestThatArgumentSchemaChecksNumberOfArguments(t *testing.T) {
+	schema := &ArgumentSchema{Types: []ArgumentType{ArgumentInt, ArgumentInt}, Required: 1}
+
+	if _, err := schema.Convert([]interface{}{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if _, err := schema.Convert([]interface{}{float64(1), float64(2), float64(3)}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if args, err := schema.Convert([]interface{}{float64(1)}); err != nil || len(args) != 1 {
+		t.Fatalf("Expected 1 argument, got %#v (%v).", args, err)
+	}
+}
+
+func TestThatArgumentSchemaChecksTypesOfArguments(t *testing.T) {
+	schema := &ArgumentSchema{Types: []ArgumentType{ArgumentInt}, Required: 1}
+
+	for _, arg := range []interface{}{float64(1.5), "abc", true} {
+		if _, err := schema.Convert([]interface{}{arg}); err == nil {
+			t.Fatalf("Expected error for %#v, didn't get any.", arg)
+		}
+	}
+
+	if _, err := (&ArgumentSchema{Types: []ArgumentType{ArgumentRegexp}}).Convert([]interface{}{"[a-"}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatArgumentSchemaAppendsDefaults(t *testing.T) {
+	schema := &ArgumentSchema{Types: []ArgumentType{ArgumentString, ArgumentInt}, Required: 1, Defaults: []interface{}{nil, int64(10)}}
+
+	args, err := schema.Convert([]interface{}{"abc"})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(args) != 2 || args[1] != int64(10) {
+		t.Fatalf("Expected default argument, got %#v.", args)
+	}
+}
+
+func TestThatArgumentSchemaSupportsVariadicArguments(t *testing.T) {
+	schema := &ArgumentSchema{Types: []ArgumentType{ArgumentString}, Variadic: true}
+
+	args, err := schema.Convert([]interface{}{"a", float64(2), "c"})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(args) != 3 || args[1] != "2" {
+		t.Fatalf("Expected string arguments, got %#v.", args)
+	}
+}
+
+func TestThatRegistryConvertsArgumentsOfValidatorsWithSchemas(t *testing.T) {
+	registry := NewValidatorRegistry()
+	registry.RegisterWithSchema("limit", nil, &ArgumentSchema{Types: []ArgumentType{ArgumentInt}, Required: 1})
+
+	methodGroups, _ := parser.Parse("limit(5),other(5)")
+	convertedGroups, err := registry.ConvertArguments(methodGroups)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if convertedGroups[0][0].Arguments[0] != int64(5) || convertedGroups[0][1].Arguments[0] != float64(5) {
+		t.Fatalf("Expected only arguments of 'limit' to be converted, got %#v.", convertedGroups[0])
+	}
+
+	if methodGroups[0][0].Arguments[0] != float64(5) {
+		t.Fatal("Expected parsed methods to not be modified.")
+	}
+
+	methodGroups, _ = parser.Parse("limit(abc)")
+
+	if _, err := registry.ConvertArguments(methodGroups); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatRegistryChecksNamedArgumentsOfValidatorsWithSchemas(t *testing.T) {
+	registry := NewValidatorRegistry()
+	registry.RegisterWithSchema("match", nil, &ArgumentSchema{Types: []ArgumentType{ArgumentString}, Variadic: true, NamedAsText: true})
+	registry.RegisterWithSchema("policy", nil, &ArgumentSchema{Named: []string{"entropy"}})
+	registry.Register("custom", nil)
+
+	methodGroups, _ := parser.Parse("match(^a,a=b,c),policy(entropy=40),custom(flag=true)")
+	convertedGroups, err := registry.ConvertArguments(methodGroups)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if match := convertedGroups[0][0]; match.Arguments.String() != "'^a', 'a=b', 'c'" || match.NamedArguments != nil {
+		t.Fatalf("Expected named argument to be passed as text, got %s.", match)
+	}
+
+	if policy := convertedGroups[0][1]; policy.NamedArguments["entropy"] != float64(40) {
+		t.Fatalf("Expected named argument 'entropy', got %s.", policy)
+	}
+
+	if custom := convertedGroups[0][2]; custom.NamedArguments["flag"] != true {
+		t.Fatalf("Expected named argument of validator without schema, got %s.", custom)
+	}
+
+	methodGroups, _ = parser.Parse("policy(classes=3)")
+
+	if _, err := registry.ConvertArguments(methodGroups); err == nil || err.Error() != "Validator 'policy' has invalid arguments. Doesn't support argument 'classes'." {
+		t.Fatalf("Expected error of undeclared argument, got %v.", err)
+	}
+}
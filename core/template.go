@@ -0,0 +1,100 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// PlaceholderFn resolves the value of a message placeholder for an error.
+type PlaceholderFn func(err *Error) string
+
+var (
+	placeholders     = map[string]PlaceholderFn{}
+	placeholdersLock sync.RWMutex
+)
+
+// RegisterPlaceholder registers a custom placeholder that can be used in error messages, i.e. `{name}`.
+// Built-in placeholders are {field}, {struct}, {value}, {validator} and {arg0}, {arg1}, ... for the arguments of the validator.
+func RegisterPlaceholder(name string, resolve PlaceholderFn) {
+	placeholdersLock.Lock()
+	defer placeholdersLock.Unlock()
+	placeholders[name] = resolve
+}
+
+func resolvePlaceholder(err *Error, name string) (string, bool) {
+	switch name {
+	case "field":
+		return err.GetFieldDisplayName(), true
+	case "struct":
+		if err.field != nil {
+			return err.field.StructName, true
+		}
+		return "", true
+	case "value":
+		return fmt.Sprintf("%v", err.value), true
+	case "validator":
+		return err.GetValidatorName(), true
+	}
+
+	if strings.HasPrefix(name, "arg") && err.validator != nil {
+		var index int
+		if _, scanErr := fmt.Sscanf(name, "arg%d", &index); scanErr == nil && fmt.Sprintf("arg%d", index) == name {
+			if index < len(err.validator.Arguments) {
+				return fmt.Sprintf("%v", err.validator.Arguments[index]), true
+			}
+			return "", false
+		}
+	}
+
+	placeholdersLock.RLock()
+	resolve, ok := placeholders[name]
+	placeholdersLock.RUnlock()
+
+	if ok {
+		return resolve(err), true
+	}
+
+	return "", false
+}
+
+// formatMessage replaces the placeholders of a message with their values. Unknown placeholders are left as-is.
+// Replacement is done in a single pass, so placeholders within resolved values are never replaced.
+func formatMessage(message string, err *Error) string {
+	if !strings.Contains(message, "{") {
+		return message
+	}
+
+	var buffer bytes.Buffer
+
+	for {
+		start := strings.Index(message, "{")
+
+		if start == -1 {
+			break
+		}
+
+		end := strings.Index(message[start:], "}")
+
+		if end == -1 {
+			break
+		}
+
+		end += start
+
+		buffer.WriteString(message[:start])
+
+		if value, ok := resolvePlaceholder(err, message[start+1:end]); ok {
+			buffer.WriteString(value)
+		} else {
+			buffer.WriteString(message[start : end+1])
+		}
+
+		message = message[end+1:]
+	}
+
+	buffer.WriteString(message)
+
+	return buffer.String()
+}
@@ -1,22 +1,30 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"reflect"
 )
 
 type testContext struct {
+	ctx    context.Context
 	source interface{}
 
 	value        interface{}
 	originalKind reflect.Kind
 	isNil        bool
 
-	field *ReflectedField
+	field          *ReflectedField
+	namedArguments map[string]interface{}
+
+	fieldSkipped  bool
+	structAborted bool
 }
 
 func NewTestContext(value interface{}) *testContext {
-	ctx := &testContext{}
+	ctx := &testContext{
+		ctx: context.Background(),
+	}
 
 	if err := ctx.SetValue(value); err != nil {
 		return nil
@@ -25,6 +33,14 @@ func NewTestContext(value interface{}) *testContext {
 	return ctx
 }
 
+func (this *testContext) SetContext(ctx context.Context) {
+	this.ctx = ctx
+}
+
+func (this *testContext) Context() context.Context {
+	return this.ctx
+}
+
 func (this *testContext) SetSource(source interface{}) {
 	this.source = source
 }
@@ -67,6 +83,37 @@ func (this *testContext) OriginalKind() reflect.Kind {
 	return this.originalKind
 }
 
+func (this *testContext) SetNamedArguments(args map[string]interface{}) {
+	this.namedArguments = args
+}
+
+func (this *testContext) NamedArguments() map[string]interface{} {
+	return this.namedArguments
+}
+
+func (this *testContext) SiblingValue(name string) (*NormalizedValue, error) {
+	return GetSiblingValue(this.source, name)
+}
+
+func (this *testContext) SkipField() {
+	this.fieldSkipped = true
+}
+
+// IsFieldSkipped checks whether SkipField or AbortStruct has been called.
+func (this *testContext) IsFieldSkipped() bool {
+	return this.fieldSkipped
+}
+
+func (this *testContext) AbortStruct() {
+	this.fieldSkipped = true
+	this.structAborted = true
+}
+
+// IsStructAborted checks whether AbortStruct has been called.
+func (this *testContext) IsStructAborted() bool {
+	return this.structAborted
+}
+
 func (this *testContext) NewError(localeKey string, args ...interface{}) error {
 	return errors.New(localeKey)
 }
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+)
+
+// TextOf returns the textual form of a value that implements encoding.TextMarshaler or fmt.Stringer, by value or by
+// pointer, i.e. a uuid.UUID or net.IP. Text marshalers are preferred, as they're meant to be parsed back.
+func TextOf(value interface{}) (string, bool) {
+	reflectedValue := reflect.ValueOf(value)
+
+	if !reflectedValue.IsValid() || (reflectedValue.Kind() == reflect.Ptr && reflectedValue.IsNil()) {
+		return "", false
+	}
+
+	if text, ok := textOf(value); ok {
+		return text, true
+	}
+
+	// Methods with pointer receivers are only available through a pointer to a copy of the value.
+	if reflectedValue.Kind() != reflect.Ptr {
+		pointer := reflect.New(reflectedValue.Type())
+		pointer.Elem().Set(reflectedValue)
+		return textOf(pointer.Interface())
+	}
+
+	return "", false
+}
+
+func textOf(value interface{}) (string, bool) {
+	switch typedValue := value.(type) {
+	case encoding.TextMarshaler:
+		text, err := typedValue.MarshalText()
+
+		if err != nil {
+			return "", false
+		}
+
+		return string(text), true
+	case fmt.Stringer:
+		return typedValue.String(), true
+	}
+
+	return "", false
+}
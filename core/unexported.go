@@ -0,0 +1,27 @@
+package core
+
+import (
+	"reflect"
+)
+
+// UnexportedPolicy decides how unexported fields of structs are treated. Embedded structs are traversed regardless of
+// the policy, as their exported fields are promoted.
+type UnexportedPolicy int
+
+const (
+	// SkipUnexported skips unexported fields, which are neither validated nor traversed. It's the default.
+	SkipUnexported UnexportedPolicy = iota
+
+	// FailUnexported fails unexported fields that have validators, instead of running them.
+	FailUnexported
+
+	// ValidateUnexported validates unexported fields like exported fields, but read-only. Transformed and default
+	// values of unexported fields are not written back.
+	ValidateUnexported
+)
+
+// IsExportedField checks whether a struct field is exported. Unlike checking the case of the first byte of its name,
+// it handles names that start with multi-byte characters.
+func IsExportedField(field reflect.StructField) bool {
+	return len(field.PkgPath) == 0
+}
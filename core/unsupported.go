@@ -0,0 +1,27 @@
+package core
+
+import (
+	"reflect"
+)
+
+// UnsupportedPolicy decides how fields of kinds that can't be validated are treated, that is channels, functions and
+// unsafe pointers. Fields of any kind can be excluded from validation with an `ignore` or `-` tag.
+type UnsupportedPolicy int
+
+const (
+	// SkipUnsupported skips the validators of fields of unsupported kinds without running them. It's the default.
+	SkipUnsupported UnsupportedPolicy = iota
+
+	// FailUnsupported fails fields of unsupported kinds that have validators, instead of running them.
+	FailUnsupported
+)
+
+// IsUnsupportedKind checks whether values of kind can't be validated, that is channels, functions and unsafe pointers.
+func IsUnsupportedKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return true
+	default:
+		return false
+	}
+}
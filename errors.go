@@ -0,0 +1,31 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+// Errors of the default validators, to find them in the errors of a validation with errors.Is, i.e.
+// `errors.Is(err, validator.ErrNotEmpty)`. Errors of other validators can be found with core.ValidatorError.
+var (
+	ErrRequired = core.ValidatorError("required")
+	ErrNotEmpty = core.ValidatorError("not_empty")
+	ErrEmpty    = core.ValidatorError("empty")
+	ErrMin      = core.ValidatorError("min")
+	ErrMax      = core.ValidatorError("max")
+	ErrLength   = core.ValidatorError("length")
+	ErrBetween  = core.ValidatorError("between")
+	ErrOneOf    = core.ValidatorError("one_of")
+	ErrMatch    = core.ValidatorError("match")
+	ErrRegexp   = core.ValidatorError("regexp")
+	ErrNumeric  = core.ValidatorError("numeric")
+	ErrEmail    = core.ValidatorError("email")
+	ErrUrl      = core.ValidatorError("url")
+	ErrUuid     = core.ValidatorError("uuid")
+)
+
+// Errors of values that can't be validated, i.e. `Validate(nil)` fails with ErrNilValue and `Validate(1)` fails with
+// an error that is matched by ErrNotAStruct.
+var (
+	ErrNilValue   = core.ErrNilValue
+	ErrNotAStruct = core.ErrNotAStruct
+)
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"github.com/typerandom/validator"
+)
+
+func main() {
+	user := &User{
+		Name:      "J",
+		Age:       16,
+		Role:      "guest",
+		Password:  "secret",
+		Confirmed: "public",
+	}
+
+	fmt.Println("Validating user with generated code...")
+
+	for _, err := range ValidateUser(user) {
+		fmt.Printf("* Got error: %s\n", err)
+	}
+
+	fmt.Println()
+	fmt.Println("Validating user with Validate, which uses the generated code as well...")
+
+	for _, err := range validator.Validate(user) {
+		fmt.Printf("* Got error: %s\n", err)
+	}
+}
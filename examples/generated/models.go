@@ -0,0 +1,37 @@
+package main
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core"
+	"time"
+)
+
+//go:generate cocoon-gen -type User
+
+type Role string
+
+type Address struct {
+	Street string `validate:"not_empty,max(100)"`
+	Zip    string `validate:"regexp(´^[0-9]{5}$´)"`
+}
+
+type User struct {
+	Name      string    `validate:"not_empty,min(2)"`
+	Email     *string   `validate:"empty|email"`
+	Age       uint8     `validate:"min(18)"`
+	Role      Role      `validate:"one_of(admin,user)"`
+	Tags      []string  `validate:"max(3)"`
+	Born      time.Time `validate:"not_empty"`
+	Address   Address
+	Billing   *Address
+	Password  string
+	Confirmed string
+	internal  string
+}
+
+func (this *User) ValidateStruct(context core.ValidatorContext) error {
+	if this.Password != this.Confirmed {
+		return errors.New("Passwords don't match.")
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/typerandom/validator/core/parser"
+	"sort"
+	"strings"
+)
+
+// RuleSet is a stable, serializable description of the rules that are enforced for struct types, i.e. to diff the
+// rules of a release against the previous one. Types are sorted by name, and fields are in the order in which they
+// are declared.
+type RuleSet struct {
+	Types []*TypeRules `json:"types"`
+}
+
+// TypeRules holds the rules of the fields of a struct type. Fields without rules are omitted.
+type TypeRules struct {
+	Name   string        `json:"name"`
+	Fields []*FieldRules `json:"fields"`
+}
+
+// FieldRules holds the validator groups of a field, with aliases expanded. A field passes if any group passes.
+type FieldRules struct {
+	Name   string          `json:"name"`
+	Groups [][]*MethodRule `json:"groups"`
+}
+
+// MethodRule is a validator or directive of a group, with its arguments formatted as strings. Negated validators pass
+// if the validator fails, i.e. `!uppercase`.
+type MethodRule struct {
+	Name           string            `json:"name"`
+	Negated        bool              `json:"negated,omitempty"`
+	Arguments      []string          `json:"arguments,omitempty"`
+	NamedArguments map[string]string `json:"namedArguments,omitempty"`
+}
+
+// String returns the rules of the set, a line per field, i.e. `User.Name: not_empty,min(3)|empty`.
+func (this *RuleSet) String() string {
+	var lines []string
+
+	for _, typeRules := range this.Types {
+		for _, fieldRules := range typeRules.Fields {
+			lines = append(lines, typeRules.Name+"."+fieldRules.Name+": "+fieldRules.String())
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// String returns the rules of the field in tag syntax, i.e. `not_empty,min(3)|empty`.
+func (this *FieldRules) String() string {
+	groups := make([]string, len(this.Groups))
+
+	for i, methods := range this.Groups {
+		rules := make([]string, len(methods))
+
+		for j, method := range methods {
+			rules[j] = method.String()
+		}
+
+		groups[i] = strings.Join(rules, ",")
+	}
+
+	return strings.Join(groups, "|")
+}
+
+// String returns the method in tag syntax, i.e. `length(min=1,max=5)`. Named arguments are sorted by name.
+func (this *MethodRule) String() string {
+	methodName := this.Name
+
+	if this.Negated {
+		methodName = "!" + methodName
+	}
+
+	arguments := append([]string{}, this.Arguments...)
+
+	names := make([]string, 0, len(this.NamedArguments))
+
+	for name := range this.NamedArguments {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		arguments = append(arguments, name+"="+this.NamedArguments[name])
+	}
+
+	if len(arguments) == 0 {
+		return methodName
+	}
+
+	return methodName + "(" + strings.Join(arguments, ",") + ")"
+}
+
+func (this *validator) ExportRules(values ...interface{}) (*RuleSet, error) {
+	ruleSet := &RuleSet{}
+	exported := make(map[string]bool)
+
+	for _, value := range values {
+		plan, err := this.Compile(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, compiledType := range plan.Types {
+			name := compiledType.Type.String()
+
+			if exported[name] {
+				continue
+			}
+
+			exported[name] = true
+			ruleSet.Types = append(ruleSet.Types, exportType(name, compiledType))
+		}
+	}
+
+	sort.Slice(ruleSet.Types, func(i, j int) bool {
+		return ruleSet.Types[i].Name < ruleSet.Types[j].Name
+	})
+
+	return ruleSet, nil
+}
+
+func exportType(name string, compiledType *CompiledType) *TypeRules {
+	typeRules := &TypeRules{Name: name, Fields: []*FieldRules{}}
+
+	for _, compiledField := range compiledType.Fields {
+		fieldRules := &FieldRules{Name: compiledField.Field.Name}
+
+		for _, methods := range compiledField.Field.MethodGroups {
+			// Fields without tags have a single group without methods.
+			if len(methods) == 0 && len(compiledField.Field.MethodGroups) == 1 {
+				continue
+			}
+
+			group := make([]*MethodRule, len(methods))
+
+			for i, method := range methods {
+				group[i] = exportMethod(method)
+			}
+
+			fieldRules.Groups = append(fieldRules.Groups, group)
+		}
+
+		if len(fieldRules.Groups) > 0 {
+			typeRules.Fields = append(typeRules.Fields, fieldRules)
+		}
+	}
+
+	return typeRules
+}
+
+func exportMethod(method *parser.Method) *MethodRule {
+	methodRule := &MethodRule{Name: method.Name, Negated: method.Negated}
+
+	for _, argument := range method.Arguments {
+		methodRule.Arguments = append(methodRule.Arguments, fmt.Sprint(argument))
+	}
+
+	if len(method.NamedArguments) > 0 {
+		methodRule.NamedArguments = make(map[string]string, len(method.NamedArguments))
+
+		for name, argument := range method.NamedArguments {
+			methodRule.NamedArguments[name] = fmt.Sprint(argument)
+		}
+	}
+
+	return methodRule
+}
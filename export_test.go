@@ -0,0 +1,67 @@
+package validator_test
+
+import (
+	"encoding/json"
+	. "github.com/typerandom/validator"
+	"testing"
+)
+
+type exportAddress struct {
+	City string `validate:"not_empty,min(2)"`
+}
+
+type exportUser struct {
+	Name      string `validate:"not_empty,password(upper=1,classes=2)|empty"`
+	Nickname  string
+	Addresses []*exportAddress
+}
+
+func TestThatExportRulesDescribesRulesOfNestedTypes(t *testing.T) {
+	ruleSet, err := ExportRules(&exportUser{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := "validator_test.exportAddress.City: not_empty,min(2)\n" +
+		"validator_test.exportUser.Name: not_empty,password(classes=2,upper=1)|empty"
+
+	if ruleSet.String() != expected {
+		t.Fatalf("Expected rules '%s', got '%s'.", expected, ruleSet)
+	}
+}
+
+func TestThatExportedRulesAreStable(t *testing.T) {
+	v := New()
+
+	if err := v.RegisterAlias("city", "not_empty,min(2)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	v.Rules(exportUser{}).Field("Nickname", "max(10)")
+
+	ruleSetA, err := v.ExportRules(exportUser{}, &exportAddress{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	ruleSetB, _ := v.ExportRules(&exportAddress{}, exportUser{})
+
+	dataA, _ := json.Marshal(ruleSetA)
+	dataB, _ := json.Marshal(ruleSetB)
+
+	if string(dataA) != string(dataB) {
+		t.Fatalf("Expected stable rules, got %s and %s.", dataA, dataB)
+	}
+
+	if len(ruleSetA.Types) != 2 || len(ruleSetA.Types[1].Fields) != 2 || ruleSetA.Types[1].Fields[1].String() != "max(10)" {
+		t.Fatalf("Expected registered rules of 'Nickname', got %s.", dataA)
+	}
+}
+
+func TestThatExportRulesFailsForUnknownValidators(t *testing.T) {
+	if _, err := ExportRules(&precompileUser{}); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+}
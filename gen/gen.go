@@ -0,0 +1,587 @@
+// Package gen generates code that validates structs without reflection, from the validate tags of the structs of a
+// package. Generated code registers itself with the validator, which falls back to reflection for other types.
+package gen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Header is the first line of generated files. Files that start with it are ignored when loading packages.
+const Header = "// Code generated by cocoon-gen. DO NOT EDIT."
+
+// tagName is the tag of the rules, which must match the tag used by the validator.
+const tagName = "validate"
+
+// writeBackMethods are the methods that modify fields, which requires reflection.
+var writeBackMethods = map[string]bool{
+	"default":  true,
+	"trim":     true,
+	"lower":    true,
+	"upper":    true,
+	"truncate": true,
+}
+
+// Package holds the type declarations of a parsed package.
+type Package struct {
+	Name    string
+	types   map[string]*ast.TypeSpec
+	order   []string
+	methods map[string]map[string]bool
+}
+
+// Load parses the non-test Go files of a directory, except files generated by cocoon-gen.
+func Load(dir string) (*Package, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	sources := make(map[string][]byte)
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		source, err := ioutil.ReadFile(path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.HasPrefix(source, []byte(Header)) {
+			sources[path] = source
+		}
+	}
+
+	return Parse(sources)
+}
+
+// Parse parses the sources of a package by filename.
+func Parse(sources map[string][]byte) (*Package, error) {
+	pkg := &Package{
+		types:   make(map[string]*ast.TypeSpec),
+		methods: make(map[string]map[string]bool),
+	}
+
+	var filenames []string
+
+	for filename := range sources {
+		filenames = append(filenames, filename)
+	}
+
+	sort.Strings(filenames)
+
+	fileSet := token.NewFileSet()
+
+	for _, filename := range filenames {
+		file, err := goparser.ParseFile(fileSet, filename, sources[filename], 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(pkg.Name) == 0 {
+			pkg.Name = file.Name.Name
+		} else if pkg.Name != file.Name.Name {
+			return nil, errors.New("Found packages '" + pkg.Name + "' and '" + file.Name.Name + "' in the same directory.")
+		}
+
+		for _, decl := range file.Decls {
+			switch typedDecl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range typedDecl.Specs {
+					if typeSpec, ok := spec.(*ast.TypeSpec); ok {
+						pkg.types[typeSpec.Name.Name] = typeSpec
+						pkg.order = append(pkg.order, typeSpec.Name.Name)
+					}
+				}
+			case *ast.FuncDecl:
+				if typedDecl.Recv != nil && len(typedDecl.Recv.List) == 1 {
+					pkg.addMethod(receiverName(typedDecl.Recv.List[0].Type), typedDecl.Name.Name)
+				}
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+func receiverName(expr ast.Expr) string {
+	switch typed := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(typed.X)
+	case *ast.Ident:
+		return typed.Name
+	}
+	return ""
+}
+
+func (this *Package) addMethod(typeName string, methodName string) {
+	if this.methods[typeName] == nil {
+		this.methods[typeName] = make(map[string]bool)
+	}
+	this.methods[typeName][methodName] = true
+}
+
+func (this *Package) hasMethod(typeName string, methodName string) bool {
+	return this.methods[typeName][methodName]
+}
+
+// Generate generates the validation code of the named struct types, and of the struct types of the package they
+// contain. If no types are named, then code is generated for all struct types with validate tags that are
+// supported, and the reasons for skipping the others are returned.
+func (this *Package) Generate(typeNames ...string) ([]byte, []string, error) {
+	generator := &generator{
+		pkg:       this,
+		supported: make(map[string]error),
+	}
+
+	var skipped []string
+
+	if len(typeNames) == 0 {
+		for _, typeName := range this.order {
+			if structType, ok := this.types[typeName].Type.(*ast.StructType); ok && hasTags(structType) {
+				if err := generator.check(typeName); err != nil {
+					skipped = append(skipped, err.Error())
+				} else {
+					typeNames = append(typeNames, typeName)
+				}
+			}
+		}
+	}
+
+	for _, typeName := range typeNames {
+		if err := generator.check(typeName); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, typeName := range typeNames {
+		generator.include(typeName)
+	}
+
+	source, err := generator.generate()
+
+	return source, skipped, err
+}
+
+func hasTags(structType *ast.StructType) bool {
+	for _, field := range structType.Fields.List {
+		if _, ok := fieldRules(field); ok && !isIgnored(field) {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldRules(field *ast.Field) (string, bool) {
+	if field.Tag == nil {
+		return "", false
+	}
+
+	tag, err := strconv.Unquote(field.Tag.Value)
+
+	if err != nil {
+		return "", false
+	}
+
+	return reflect.StructTag(tag).Lookup(tagName)
+}
+
+// isIgnored checks whether a field is excluded from validation and traversal, i.e. `validate:"-"`.
+func isIgnored(field *ast.Field) bool {
+	rules, _ := fieldRules(field)
+	return rules == "-" || rules == core.IgnoreDirective
+}
+
+// value describes how the normalized value of a field type is generated.
+type value struct {
+	kind       string
+	convert    string
+	zero       string
+	structName string
+	pointer    bool
+}
+
+type generator struct {
+	pkg       *Package
+	supported map[string]error
+	included  []string
+	imports   map[string]bool
+	buffer    bytes.Buffer
+}
+
+// check checks whether the validation code of a struct type can be generated.
+func (this *generator) check(typeName string) error {
+	if err, ok := this.supported[typeName]; ok {
+		return err
+	}
+
+	// Assume recursive types are supported while checking them.
+	this.supported[typeName] = nil
+
+	err := this.checkStruct(typeName)
+	this.supported[typeName] = err
+
+	return err
+}
+
+func (this *generator) checkStruct(typeName string) error {
+	typeSpec, ok := this.pkg.types[typeName]
+
+	if !ok {
+		return errors.New("Type '" + typeName + "' does not exist.")
+	}
+
+	structType, ok := typeSpec.Type.(*ast.StructType)
+
+	if !ok {
+		return errors.New("Type '" + typeName + "' is not a struct.")
+	}
+
+	if this.pkg.hasMethod(typeName, "ValidatableValue") {
+		return errors.New("Type '" + typeName + "' provides its value with ValidatableValue.")
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			return errors.New("Type '" + typeName + "' has embedded fields.")
+		}
+
+		if !field.Names[0].IsExported() || isIgnored(field) {
+			continue
+		}
+
+		rules, hasRules := fieldRules(field)
+
+		if hasRules {
+			methodGroups, err := parser.Parse(rules)
+
+			if err != nil {
+				return errors.New("Unable to parse tag of field '" + field.Names[0].Name + "' of type '" + typeName + "'. " + err.Error())
+			}
+
+			for _, methods := range methodGroups {
+				for _, method := range methods {
+					if writeBackMethods[method.Name] {
+						return errors.New("Field '" + field.Names[0].Name + "' of type '" + typeName + "' uses '" + method.Name + "', which modifies the field.")
+					}
+				}
+			}
+		}
+
+		if _, err := this.fieldValue(field.Type, hasRules); err != nil {
+			return errors.New("Field '" + field.Names[0].Name + "' of type '" + typeName + "' is not supported. " + err.Error())
+		}
+	}
+
+	return nil
+}
+
+var basicKinds = map[string]string{
+	"string":  "String",
+	"bool":    "Bool",
+	"int":     "Int",
+	"int8":    "Int8",
+	"int16":   "Int16",
+	"int32":   "Int32",
+	"rune":    "Int32",
+	"int64":   "Int64",
+	"uint":    "Uint",
+	"uint8":   "Uint8",
+	"byte":    "Uint8",
+	"uint16":  "Uint16",
+	"uint32":  "Uint32",
+	"uint64":  "Uint64",
+	"uintptr": "Uintptr",
+	"float32": "Float32",
+	"float64": "Float64",
+}
+
+// fieldValue describes the value of a field type. Collections are only supported if their elements can't contain
+// structs. Returns nil if the field can be skipped, as it neither has rules nor contains structs.
+func (this *generator) fieldValue(fieldType ast.Expr, hasRules bool) (*value, error) {
+	switch typed := fieldType.(type) {
+	case *ast.StarExpr:
+		elemValue, err := this.fieldValue(typed.X, hasRules)
+
+		if elemValue != nil {
+			elemValue.pointer = true
+		}
+
+		return elemValue, err
+	case *ast.Ident:
+		return this.identValue(typed.Name, hasRules)
+	case *ast.SelectorExpr:
+		if pkg, ok := typed.X.(*ast.Ident); ok && pkg.Name == "time" && typed.Sel.Name == "Time" {
+			if !hasRules {
+				return nil, nil
+			}
+			return &value{kind: "Struct", zero: "time.Time{}"}, nil
+		}
+		return nil, errors.New("Types of other packages are not supported.")
+	case *ast.ArrayType, *ast.MapType:
+		var elemType ast.Expr
+		kind := "Map"
+
+		if arrayType, ok := typed.(*ast.ArrayType); ok {
+			elemType = arrayType.Elt
+			kind = "Slice"
+
+			if arrayType.Len != nil {
+				kind = "Array"
+			}
+		} else {
+			elemType = typed.(*ast.MapType).Value
+		}
+
+		if elemValue, err := this.fieldValue(elemType, true); err != nil || elemValue.structName != "" || elemValue.zero == "time.Time{}" {
+			return nil, errors.New("Only collections of basic types are supported.")
+		}
+
+		if !hasRules {
+			return nil, nil
+		}
+
+		return &value{kind: kind}, nil
+	}
+
+	return nil, errors.New("Unsupported type.")
+}
+
+func (this *generator) identValue(name string, hasRules bool) (*value, error) {
+	if kind, ok := basicKinds[name]; ok {
+		if !hasRules {
+			return nil, nil
+		}
+		return basicValue(kind), nil
+	}
+
+	typeSpec, ok := this.pkg.types[name]
+
+	if !ok {
+		return nil, errors.New("Type '" + name + "' is not supported.")
+	}
+
+	if _, ok := typeSpec.Type.(*ast.StructType); ok {
+		if err := this.check(name); err != nil {
+			return nil, err
+		}
+		return &value{kind: "Struct", zero: name + "{}", structName: name}, nil
+	}
+
+	if this.pkg.hasMethod(name, "ValidatableValue") {
+		return nil, errors.New("Type '" + name + "' provides its value with ValidatableValue.")
+	}
+
+	if underlying, ok := typeSpec.Type.(*ast.Ident); ok {
+		if kind, ok := basicKinds[underlying.Name]; ok {
+			if !hasRules {
+				return nil, nil
+			}
+			return basicValue(kind), nil
+		}
+	}
+
+	return nil, errors.New("Type '" + name + "' is not supported.")
+}
+
+func basicValue(kind string) *value {
+	switch {
+	case kind == "String":
+		return &value{kind: kind, convert: "string(%s)", zero: `""`}
+	case kind == "Bool":
+		return &value{kind: kind, convert: "bool(%s)", zero: "false"}
+	case strings.HasPrefix(kind, "Int"):
+		return &value{kind: kind, convert: "int64(%s)", zero: "int64(0)"}
+	case strings.HasPrefix(kind, "Uint"):
+		return &value{kind: kind, convert: "validator.GeneratedUint(uint64(%s))", zero: "int64(0)"}
+	}
+	return &value{kind: kind, convert: "float64(%s)", zero: "float64(0)"}
+}
+
+// include adds a struct type, and the struct types of the package it contains, to the generated code.
+func (this *generator) include(typeName string) {
+	for _, included := range this.included {
+		if included == typeName {
+			return
+		}
+	}
+
+	this.included = append(this.included, typeName)
+
+	for _, field := range this.pkg.types[typeName].Type.(*ast.StructType).Fields.List {
+		if !field.Names[0].IsExported() || isIgnored(field) {
+			continue
+		}
+
+		_, hasRules := fieldRules(field)
+
+		if fieldValue, _ := this.fieldValue(field.Type, hasRules); fieldValue != nil && fieldValue.structName != "" {
+			this.include(fieldValue.structName)
+		}
+	}
+}
+
+func (this *generator) generate() ([]byte, error) {
+	this.imports = map[string]bool{
+		"github.com/typerandom/validator":      true,
+		"github.com/typerandom/validator/core": true,
+	}
+
+	var body bytes.Buffer
+
+	this.printf(&body, "func init() {\n")
+	for _, typeName := range this.included {
+		this.printf(&body, "validator.RegisterGenerated((*%s)(nil), cocoonValidate%s)\n", typeName, typeName)
+	}
+	this.printf(&body, "}\n")
+
+	for _, typeName := range this.included {
+		this.generateStruct(&body, typeName)
+	}
+
+	var source bytes.Buffer
+
+	this.printf(&source, "%s\n\npackage %s\n\nimport (\n", Header, this.pkg.Name)
+
+	var imports []string
+
+	for path := range this.imports {
+		imports = append(imports, path)
+	}
+
+	sort.Strings(imports)
+
+	for _, path := range imports {
+		this.printf(&source, "%q\n", path)
+	}
+
+	this.printf(&source, ")\n\n")
+	source.Write(body.Bytes())
+
+	return format.Source(source.Bytes())
+}
+
+func (this *generator) printf(buffer *bytes.Buffer, format string, args ...interface{}) {
+	fmt.Fprintf(buffer, format, args...)
+}
+
+func (this *generator) generateStruct(buffer *bytes.Buffer, typeName string) {
+	structType := this.pkg.types[typeName].Type.(*ast.StructType)
+
+	this.printf(buffer, "\n// Validate%s validates value with the default validator, using generated code instead of reflection.\n", typeName)
+	this.printf(buffer, "func Validate%s(value *%s, opts ...validator.Option) core.ErrorList {\n", typeName, typeName)
+	this.printf(buffer, "return validator.ValidateGenerated(validator.Default(), value, cocoonValidate%s, opts...)\n}\n\n", typeName)
+
+	var statements bytes.Buffer
+	var generatedFields bytes.Buffer
+	index := 0
+	usesSource := false
+
+	for _, field := range structType.Fields.List {
+		_, hasRules := fieldRules(field)
+
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			validated := false
+
+			// Ignored fields are part of the cached fields, so they're counted but not validated.
+			if !isIgnored(field) {
+				if fieldValue, _ := this.fieldValue(field.Type, hasRules); fieldValue != nil {
+					this.generateField(&statements, index, "value."+name.Name, fieldValue)
+					usesSource = true
+					validated = true
+				}
+			}
+
+			this.printf(&generatedFields, "{Name: %q, Validated: %t},\n", name.Name, validated)
+			index++
+		}
+	}
+
+	// The fields as they were generated, so that the struct is validated by reflection if the code is stale.
+	this.printf(buffer, "var cocoonFields%s = []validator.GeneratedField{\n", typeName)
+	buffer.Write(generatedFields.Bytes())
+	this.printf(buffer, "}\n\n")
+
+	this.printf(buffer, "func cocoonValidate%s(validation *validator.GeneratedValidation, v interface{}, parentField *core.ReflectedField) {\n", typeName)
+	this.printf(buffer, "value := v.(*%s)\n\nif validation.Reflect(value, parentField, cocoonFields%s) {\nreturn\n}\n\n", typeName, typeName)
+	this.printf(buffer, "if !validation.Enter(value, parentField) {\nreturn\n}\n\ndefer validation.Leave(value)\n\nfields := validation.Fields(value)\n\n")
+
+	// Fields that can't be reflected are reported by Fields.
+	this.printf(buffer, "if len(fields) != %d {\nreturn\n}\n\n", index)
+
+	if usesSource || this.hasStructHook(typeName) {
+		this.printf(buffer, "source := *value\n\n")
+	}
+
+	buffer.Write(statements.Bytes())
+
+	if this.hasStructHook(typeName) {
+		this.printf(buffer, "\nvalidation.Struct(value, source, parentField)\n")
+	}
+
+	this.printf(buffer, "}\n")
+}
+
+func (this *generator) hasStructHook(typeName string) bool {
+	return this.pkg.hasMethod(typeName, "ValidateStruct")
+}
+
+func (this *generator) generateField(buffer *bytes.Buffer, index int, expr string, fieldValue *value) {
+	this.imports["reflect"] = true
+
+	if fieldValue.pointer && fieldValue.zero == "time.Time{}" {
+		this.imports["time"] = true
+	}
+
+	if buffer.Len() > 0 {
+		this.printf(buffer, "\n")
+	}
+
+	call := func(value string, isNil bool) string {
+		return fmt.Sprintf("validation.Field(fields[%d], parentField, source, %s, reflect.%s, %t)", index, value, fieldValue.kind, isNil)
+	}
+
+	convert := func(expr string) string {
+		if len(fieldValue.convert) > 0 {
+			return fmt.Sprintf(fieldValue.convert, expr)
+		}
+		return expr
+	}
+
+	this.printf(buffer, "if !validation.Done() {\n")
+
+	switch {
+	case fieldValue.structName != "" && fieldValue.pointer:
+		this.printf(buffer, "if %s != nil {\nfield := %s\ncocoonValidate%s(validation, %s, field)\n} else {\n%s\n}\n", expr, call("*"+expr, false), fieldValue.structName, expr, call(fieldValue.zero, true))
+	case fieldValue.structName != "":
+		this.printf(buffer, "field := %s\ncocoonValidate%s(validation, &%s, field)\n", call(expr, false), fieldValue.structName, expr)
+	case fieldValue.pointer:
+		this.printf(buffer, "if %s != nil {\n%s\n} else {\n%s\n}\n", expr, call(convert("*"+expr), false), call(fieldValue.zero, true))
+	default:
+		this.printf(buffer, "%s\n", call(convert(expr), false))
+	}
+
+	this.printf(buffer, "}\n")
+}
@@ -0,0 +1,138 @@
+package gen_test
+
+import (
+	"github.com/typerandom/validator/gen"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestThatGenerateMatchesGeneratedExample(t *testing.T) {
+	pkg, err := gen.Load("../examples/generated")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	source, _, err := pkg.Generate("User")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected, err := ioutil.ReadFile("../examples/generated/cocoon_validation.go")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if string(source) != string(expected) {
+		t.Fatalf("Expected generated code to match example, got:\n%s", source)
+	}
+}
+
+const source = `package models
+
+type Base struct {
+	Id int64
+}
+
+type Tagged struct {
+	Name string ` + "`validate:\"min(1)\"`" + `
+}
+
+type Embedded struct {
+	Base
+	Name string ` + "`validate:\"min(1)\"`" + `
+}
+
+type Defaulted struct {
+	Limit int ` + "`validate:\"default(10),min(1)\"`" + `
+}
+
+type Nested struct {
+	Items []Tagged ` + "`validate:\"max(3)\"`" + `
+}
+
+type Provided struct {
+	Name string ` + "`validate:\"min(1)\"`" + `
+}
+
+func (this Provided) ValidatableValue() interface{} {
+	return this.Name
+}
+`
+
+func TestThatGenerateSkipsUnsupportedTypes(t *testing.T) {
+	pkg, err := gen.Parse(map[string][]byte{"models.go": []byte(source)})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	code, skipped, err := pkg.Generate()
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if !strings.Contains(string(code), "func ValidateTagged(") || strings.Contains(string(code), "func ValidateBase(") {
+		t.Fatalf("Expected only code of Tagged, got:\n%s", code)
+	}
+
+	expected := []string{
+		"Type 'Embedded' has embedded fields.",
+		"Field 'Limit' of type 'Defaulted' uses 'default', which modifies the field.",
+		"Field 'Items' of type 'Nested' is not supported. Only collections of basic types are supported.",
+		"Type 'Provided' provides its value with ValidatableValue.",
+	}
+
+	if strings.Join(skipped, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("Expected skipped types %v, got %v.", expected, skipped)
+	}
+}
+
+func TestThatGenerateFailsOnUnsupportedNamedTypes(t *testing.T) {
+	pkg, err := gen.Parse(map[string][]byte{"models.go": []byte(source)})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if _, _, err := pkg.Generate("Embedded"); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+
+	if _, _, err := pkg.Generate("Unknown"); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+}
+
+func TestThatGenerateCountsButSkipsIgnoredFields(t *testing.T) {
+	pkg, err := gen.Parse(map[string][]byte{"models.go": []byte(`package models
+
+type Worker struct {
+	Name string ` + "`validate:\"not_empty\"`" + `
+	Jobs chan string ` + "`validate:\"-\"`" + `
+	Age  int ` + "`validate:\"min(18)\"`" + `
+}
+`)})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	code, skipped, err := pkg.Generate()
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(skipped) > 0 {
+		t.Fatalf("Didn't expect skipped types, got %v.", skipped)
+	}
+
+	if !strings.Contains(string(code), "len(fields) != 3") || !strings.Contains(string(code), "fields[2]") || strings.Contains(string(code), "fields[1]") {
+		t.Fatalf("Expected code that skips field 1 of 3, got:\n%s", code)
+	}
+}
@@ -0,0 +1,222 @@
+package validator
+
+import (
+	gocontext "context"
+	"github.com/typerandom/validator/core"
+	"math"
+	"reflect"
+	"sync"
+)
+
+// GeneratedFunc validates the fields of a struct, passed by pointer, with code generated by cocoon-gen.
+type GeneratedFunc func(validation *GeneratedValidation, value interface{}, parentField *core.ReflectedField)
+
+var generatedFuncs sync.Map
+
+// RegisterGenerated registers the generated function of the struct type of value, which is a pointer to struct.
+// Validate uses the generated functions of structs passed by pointer, unless fields are selected, and falls back to
+// reflection for other types. Generated code registers its functions when initialized.
+func RegisterGenerated(value interface{}, fn GeneratedFunc) {
+	generatedFuncs.Store(reflect.TypeOf(value).Elem(), fn)
+}
+
+// getGeneratedFunc returns the generated function of value, if value is a non-nil pointer to a struct with one.
+func getGeneratedFunc(value interface{}) (GeneratedFunc, bool) {
+	reflectedType := reflect.TypeOf(value)
+
+	if reflectedType == nil || reflectedType.Kind() != reflect.Ptr || reflect.ValueOf(value).IsNil() {
+		return nil, false
+	}
+
+	if fn, ok := generatedFuncs.Load(reflectedType.Elem()); ok {
+		return fn.(GeneratedFunc), true
+	}
+
+	return nil, false
+}
+
+// ValidateGenerated validates value, a pointer to struct, with the generated function fn using the configuration
+// of v. It's called by the Validate functions of generated code.
+func ValidateGenerated(v Validator, value interface{}, fn GeneratedFunc, opts ...Option) core.ErrorList {
+	context := v.(*validator).newContext(gocontext.Background(), opts)
+
+	if err := core.CheckInput(value); err != nil {
+		context.errors.AddPlain(err)
+		return context.result()
+	}
+
+	// Generated code only validates exported fields, so unexported fields are validated by reflection.
+	if context.unexportedPolicy != core.SkipUnexported {
+		walkValidate(context, value, reflect.ValueOf(value), nil)
+		return context.result()
+	}
+
+	fn(&GeneratedValidation{context: context}, value, nil)
+	return context.result()
+}
+
+// GeneratedValidation runs the validators of fields on behalf of generated code, with the same semantics as
+// validation by reflection.
+type GeneratedValidation struct {
+	context *context
+}
+
+// GeneratedField describes an exported field of a struct as it was generated. Fields that aren't Validated were
+// skipped by the generator, as they had no rules.
+type GeneratedField struct {
+	Name      string
+	Validated bool
+}
+
+// Reflect validates a struct, passed by pointer, by reflection instead of by its generated code if the code is stale.
+// That is, if the fields of the struct changed since the code was generated, if the validator has rules for fields
+// that the code skips (i.e. by Rules, WithTag or a tag parser), or if rules of the fields write back to them. Returns
+// true if the struct was validated by reflection.
+func (this *GeneratedValidation) Reflect(value interface{}, parentField *core.ReflectedField, generated []GeneratedField) bool {
+	// Structs that shouldn't be validated are skipped by Enter.
+	if this.context.aborting || (parentField != nil && parentField.Ignored) {
+		return false
+	}
+
+	fields, err := this.context.fieldCache.GetStructFields(value)
+
+	// Errors of the fields are reported by Fields.
+	if err != nil || this.isCurrent(fields, generated) {
+		return false
+	}
+
+	walkValidate(this.context, value, reflect.ValueOf(value), parentField)
+
+	return true
+}
+
+// isCurrent checks whether the cached fields of a struct are the fields that its code was generated for.
+func (this *GeneratedValidation) isCurrent(fields []*core.ReflectedField, generated []GeneratedField) bool {
+	if len(fields) != len(generated) {
+		return false
+	}
+
+	for i, field := range fields {
+		if field.Name != generated[i].Name {
+			return false
+		}
+
+		if field.Ignored {
+			continue
+		}
+
+		for _, methods := range field.MethodGroups {
+			if len(methods) > 0 && !generated[i].Validated {
+				return false
+			}
+
+			for _, method := range methods {
+				if this.writesBack(method.Name) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// writesBack checks whether a method writes back to its field, which generated code can't do.
+func (this *GeneratedValidation) writesBack(name string) bool {
+	registry := this.context.validator.registry
+	return name == defaultDirective || registry.IsTransformer(name) || registry.IsConverter(name)
+}
+
+// Fields returns the cached fields of a struct, in the order of declaration of its exported fields.
+func (this *GeneratedValidation) Fields(value interface{}) []*core.ReflectedField {
+	fields, err := this.context.fieldCache.GetStructFields(value)
+
+	if err != nil {
+		this.context.errors.AddPlain(err)
+		return nil
+	}
+
+	return fields
+}
+
+// Enter marks a struct, passed by pointer, as being validated until Leave is called. Returns false if the struct
+// shouldn't be validated, as it's already being validated on the current path (i.e. a cycle), or it exceeds the
+// maximum depth.
+func (this *GeneratedValidation) Enter(value interface{}, parentField *core.ReflectedField) bool {
+	// Nested structs of a field that aborted its struct, or of an ignored field, are not validated.
+	if this.context.aborting || (parentField != nil && parentField.Ignored) {
+		return false
+	}
+
+	structValue := reflect.ValueOf(value).Elem()
+
+	if !this.context.enter(structValue, parentField) {
+		return false
+	}
+
+	var structPath string
+
+	if parentField != nil {
+		structPath = parentField.FullName()
+	}
+
+	this.context.structStart(structPath, structValue.Type())
+
+	return true
+}
+
+// Leave marks a struct that was entered as validated.
+func (this *GeneratedValidation) Leave(value interface{}) {
+	this.context.aborting = false
+	this.context.structDone()
+	this.context.leave(reflect.ValueOf(value).Elem())
+}
+
+// Done checks whether validation of the struct should stop, i.e. because a field has aborted it.
+func (this *GeneratedValidation) Done() bool {
+	return this.context.isDone() || this.context.aborting
+}
+
+// Field runs the validators of a cached field of source with its normalized value. Returns the field with its parent
+// set, to be used as the parent field of nested structs.
+func (this *GeneratedValidation) Field(cachedField *core.ReflectedField, parentField *core.ReflectedField, source interface{}, value interface{}, originalKind reflect.Kind, isNil bool) *core.ReflectedField {
+	field := this.context.fieldOf(cachedField, parentField)
+
+	if field.Ignored || !this.context.visit(field) {
+		return field
+	}
+
+	normalized := &core.NormalizedValue{
+		Value:        value,
+		OriginalKind: originalKind,
+		IsNil:        isNil,
+	}
+
+	walkValidateField(this.context, field, source, normalized, reflect.Value{}, reflect.Value{})
+
+	return field
+}
+
+// Struct calls ValidateStruct of a struct that implements core.Validatable.
+func (this *GeneratedValidation) Struct(value core.Validatable, source interface{}, parentField *core.ReflectedField) {
+	if this.context.isDone() || this.context.aborting {
+		return
+	}
+
+	this.context.setField(parentField)
+	this.context.setSource(source)
+	this.context.setValue(&core.NormalizedValue{Value: source, OriginalKind: reflect.Struct})
+	this.context.setNamedArguments(nil)
+
+	if err := value.ValidateStruct(this.context); err != nil {
+		this.context.errors.Add(core.NewError(parentField, structHookMethod, err))
+	}
+}
+
+// GeneratedUint normalizes an unsigned integer, which is an int64 unless it doesn't fit.
+func GeneratedUint(value uint64) interface{} {
+	if value > math.MaxInt64 {
+		return value
+	}
+	return int64(value)
+}
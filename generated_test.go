@@ -0,0 +1,192 @@
+package validator_test
+
+import (
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"testing"
+)
+
+type generatedUser struct {
+	Name     string  `validate:"not_empty,min(2)"`
+	Email    *string `validate:"empty|email"`
+	Age      uint8   `validate:"min(18)"`
+	Nickname string  `binding:"min(3)"`
+}
+
+var generatedCalls int
+
+var generatedUserFields = []validator.GeneratedField{
+	{Name: "Name", Validated: true},
+	{Name: "Email", Validated: true},
+	{Name: "Age", Validated: true},
+	{Name: "Nickname", Validated: false},
+}
+
+// validateGeneratedUser is written the way cocoon-gen generates code.
+func validateGeneratedUser(validation *validator.GeneratedValidation, v interface{}, parentField *core.ReflectedField) {
+	generatedCalls++
+
+	value := v.(*generatedUser)
+
+	if validation.Reflect(value, parentField, generatedUserFields) {
+		return
+	}
+
+	if !validation.Enter(value, parentField) {
+		return
+	}
+
+	defer validation.Leave(value)
+
+	fields := validation.Fields(value)
+
+	if len(fields) != 4 {
+		return
+	}
+
+	source := *value
+
+	if !validation.Done() {
+		validation.Field(fields[0], parentField, source, string(value.Name), reflect.String, false)
+	}
+
+	if !validation.Done() {
+		if value.Email != nil {
+			validation.Field(fields[1], parentField, source, string(*value.Email), reflect.String, false)
+		} else {
+			validation.Field(fields[1], parentField, source, "", reflect.String, true)
+		}
+	}
+
+	if !validation.Done() {
+		validation.Field(fields[2], parentField, source, validator.GeneratedUint(uint64(value.Age)), reflect.Uint8, false)
+	}
+}
+
+func init() {
+	validator.RegisterGenerated((*generatedUser)(nil), validateGeneratedUser)
+}
+
+func TestThatGeneratedCodeMatchesReflection(t *testing.T) {
+	email := "invalid"
+	user := generatedUser{Name: "J", Email: &email, Age: 16}
+
+	generatedErrs := validator.ValidateGenerated(validator.Default(), &user, validateGeneratedUser)
+	reflectedErrs := validator.Validate(user) // Structs passed by value are validated by reflection.
+
+	if generatedErrs.Error() != reflectedErrs.Error() {
+		t.Fatalf("Expected errors '%s', got '%s'.", reflectedErrs, generatedErrs)
+	}
+
+	if generatedErrs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", generatedErrs.Length())
+	}
+}
+
+func TestThatValidateUsesGeneratedCode(t *testing.T) {
+	generatedCalls = 0
+
+	if errs := validator.Validate(&generatedUser{Name: "Jane", Age: 18}); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+
+	if generatedCalls != 1 {
+		t.Fatalf("Expected generated code to be called once, got %d.", generatedCalls)
+	}
+
+	validator.ValidateFields(&generatedUser{}, "Name")
+
+	if generatedCalls != 1 {
+		t.Fatalf("Expected selected fields to be validated by reflection, got %d calls.", generatedCalls)
+	}
+}
+
+func TestThatGeneratedCodeHonorsOptions(t *testing.T) {
+	errs := validator.ValidateGenerated(validator.Default(), &generatedUser{}, validateGeneratedUser, validator.FailFast())
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatGeneratedCodeHonorsAbortStruct(t *testing.T) {
+	v := validator.New()
+	v.Register("email", func(context core.ValidatorContext, args []interface{}) error {
+		context.AbortStruct()
+		return nil
+	})
+
+	email := "invalid"
+	errs := validator.ValidateGenerated(v, &generatedUser{Name: "J", Email: &email}, validateGeneratedUser)
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Name" {
+		t.Fatalf("Expected error of field 'Name', got %s.", errs)
+	}
+}
+
+func TestThatGeneratedCodeHonorsOverrides(t *testing.T) {
+	user := &generatedUser{Name: "Jane", Age: 20}
+
+	if errs := validator.ValidateGenerated(validator.Default(), user, validateGeneratedUser, validator.Override("generatedUser.Age", "min(21)")); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+// validateStaleGeneratedUser was generated before the Nickname field was added.
+func validateStaleGeneratedUser(validation *validator.GeneratedValidation, v interface{}, parentField *core.ReflectedField) {
+	value := v.(*generatedUser)
+
+	if validation.Reflect(value, parentField, generatedUserFields[:3]) {
+		return
+	}
+
+	fields := validation.Fields(value)
+
+	if len(fields) != 3 {
+		return
+	}
+}
+
+func TestThatStaleGeneratedCodeFallsBackToReflection(t *testing.T) {
+	user := generatedUser{Name: "J", Age: 16}
+
+	generatedErrs := validator.ValidateGenerated(validator.Default(), &user, validateStaleGeneratedUser)
+	reflectedErrs := validator.Validate(user)
+
+	if generatedErrs.Length() != 2 || generatedErrs.Error() != reflectedErrs.Error() {
+		t.Fatalf("Expected errors '%s', got '%s'.", reflectedErrs, generatedErrs)
+	}
+}
+
+func TestThatGeneratedCodeFallsBackToReflectionForRulesOfSkippedFields(t *testing.T) {
+	v := validator.New()
+	v.Rules(&generatedUser{}).Field("Nickname", "min(3)")
+
+	errs := validator.ValidateGenerated(v, &generatedUser{Name: "Jane", Age: 18, Nickname: "J"}, validateGeneratedUser)
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Nickname" {
+		t.Fatalf("Expected error of field 'Nickname', got %s.", errs)
+	}
+
+	errs = validator.ValidateGenerated(validator.New(validator.WithTag("binding", "validate")), &generatedUser{Name: "Jane", Age: 18, Nickname: "J"}, validateGeneratedUser)
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Nickname" {
+		t.Fatalf("Expected error of field 'Nickname' of the binding tag, got %s.", errs)
+	}
+}
+
+func TestThatGeneratedCodeFallsBackToReflectionForRulesThatWriteBack(t *testing.T) {
+	v := validator.New()
+	v.Rules(&generatedUser{}).Field("Name", "trim")
+
+	user := &generatedUser{Name: " Jane ", Age: 18}
+
+	if errs := validator.ValidateGenerated(v, user, validateGeneratedUser); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+
+	if user.Name != "Jane" {
+		t.Fatalf("Expected trimmed name, got '%s'.", user.Name)
+	}
+}
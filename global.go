@@ -4,18 +4,12 @@ import (
 	"sync"
 )
 
-var globalLock sync.Mutex
-var globalInitialized bool
+var globalInitOnce sync.Once
 var globalDefaultValidator *validator
 
 func getGlobalValidator() *validator {
-	if !globalInitialized {
-		globalLock.Lock()
-		defer globalLock.Unlock()
-		if !globalInitialized {
-			globalInitialized = true
-			globalDefaultValidator = newValidator()
-		}
-	}
+	globalInitOnce.Do(func() {
+		globalDefaultValidator = newValidator()
+	})
 	return globalDefaultValidator
 }
@@ -0,0 +1,164 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"time"
+)
+
+// FieldEvent describes the validation of a field, or of a single validator of a field, to hooks.
+type FieldEvent struct {
+	// Path is the full name of the field, i.e. `Address.City`.
+	Path string
+
+	Field *core.ReflectedField
+
+	// Validator is the name of the validator that failed, for OnFieldError.
+	Validator string
+
+	// Duration is how long the validator took for OnFieldError, and how long all validators of the field took for
+	// OnFieldDone.
+	Duration time.Duration
+
+	// Err is the error of the validator that failed, for OnFieldError.
+	Err *core.Error
+
+	// Errors are the errors of the field, for OnFieldDone. The field passed if there are none.
+	Errors core.ErrorList
+}
+
+// StructEvent describes the validation of a struct to hooks.
+type StructEvent struct {
+	// Path is the full name of the field of the struct, i.e. `Address`, or empty for the value passed to Validate.
+	Path string
+
+	Type reflect.Type
+
+	// Duration is how long validating the fields of the struct, including nested structs, took, for OnStructDone.
+	Duration time.Duration
+
+	// Errors are the errors of the struct and its fields, for OnStructDone. The struct passed if there are none.
+	Errors core.ErrorList
+}
+
+// Hooks are called during validation, i.e. to add logging, metrics or tracing. Hooks that are nil are not called.
+// Hooks are called from the goroutine that validates, so they must be safe for concurrent use if the validator is.
+type Hooks struct {
+	// OnFieldStart is called before the validators of a field run.
+	OnFieldStart func(event FieldEvent)
+
+	// OnFieldError is called for each validator of a field that fails, including warnings.
+	OnFieldError func(event FieldEvent)
+
+	// OnFieldDone is called after the validators of a field have run.
+	OnFieldDone func(event FieldEvent)
+
+	// OnStructStart is called before the fields of a struct are validated.
+	OnStructStart func(event StructEvent)
+
+	// OnStructDone is called after a struct, including ValidateStruct, has been validated.
+	OnStructDone func(event StructEvent)
+}
+
+// hookList is the hooks of a validator, in the order they were added.
+type hookList []Hooks
+
+func (this hookList) fieldStart(event FieldEvent) {
+	for _, hooks := range this {
+		if hooks.OnFieldStart != nil {
+			hooks.OnFieldStart(event)
+		}
+	}
+}
+
+func (this hookList) fieldError(event FieldEvent) {
+	for _, hooks := range this {
+		if hooks.OnFieldError != nil {
+			hooks.OnFieldError(event)
+		}
+	}
+}
+
+func (this hookList) fieldDone(event FieldEvent) {
+	for _, hooks := range this {
+		if hooks.OnFieldDone != nil {
+			hooks.OnFieldDone(event)
+		}
+	}
+}
+
+func (this hookList) structStart(event StructEvent) {
+	for _, hooks := range this {
+		if hooks.OnStructStart != nil {
+			hooks.OnStructStart(event)
+		}
+	}
+}
+
+func (this hookList) structDone(event StructEvent) {
+	for _, hooks := range this {
+		if hooks.OnStructDone != nil {
+			hooks.OnStructDone(event)
+		}
+	}
+}
+
+// fieldError calls the OnFieldError hooks for the error of a validator of field that started at started.
+func (this *context) fieldError(field *core.ReflectedField, err *core.Error, started time.Time) {
+	if this.recorder != nil {
+		this.recorder.RecordValidatorFailure(err.GetValidatorName())
+	}
+
+	if this.hooks != nil {
+		this.hooks.fieldError(FieldEvent{
+			Path:      field.FullName(),
+			Field:     field,
+			Validator: err.GetValidatorName(),
+			Duration:  time.Since(started),
+			Err:       err,
+		})
+	}
+}
+
+// startedStruct is a struct being validated, for the OnStructDone hooks and the recorder.
+type startedStruct struct {
+	path       string
+	structType reflect.Type
+	started    time.Time
+	errorCount int
+}
+
+// structStart calls the OnStructStart hooks for the struct of structType at path, which is validated until
+// structDone is called.
+func (this *context) structStart(path string, structType reflect.Type) {
+	if this.hooks == nil && this.recorder == nil {
+		return
+	}
+
+	this.structs = append(this.structs, startedStruct{path: path, structType: structType, started: time.Now(), errorCount: len(this.errors)})
+	this.hooks.structStart(StructEvent{Path: path, Type: structType})
+}
+
+// structDone calls the OnStructDone hooks, and records the duration, of the most recently started struct.
+func (this *context) structDone() {
+	if this.hooks == nil && this.recorder == nil {
+		return
+	}
+
+	started := this.structs[len(this.structs)-1]
+	this.structs = this.structs[:len(this.structs)-1]
+	duration := time.Since(started.started)
+
+	if this.recorder != nil {
+		this.recorder.RecordStruct(started.structType, duration)
+	}
+
+	if this.hooks != nil {
+		this.hooks.structDone(StructEvent{
+			Path:     started.path,
+			Type:     started.structType,
+			Duration: duration,
+			Errors:   append(core.ErrorList(nil), this.errors[started.errorCount:]...),
+		})
+	}
+}
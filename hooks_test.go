@@ -0,0 +1,94 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+type hookAddress struct {
+	City string `validate:"not_empty"`
+}
+
+type hookDummy struct {
+	Name    string `validate:"not_empty,min(3)"`
+	Email   string `validate:"empty|email"`
+	Address hookAddress
+}
+
+func TestThatHooksAreCalledForFieldsAndStructs(t *testing.T) {
+	var events []string
+
+	validator := New()
+	validator.AddHooks(Hooks{
+		OnFieldStart: func(event FieldEvent) {
+			events = append(events, "start "+event.Path)
+		},
+		OnFieldError: func(event FieldEvent) {
+			events = append(events, "error "+event.Path+" "+event.Validator)
+		},
+		OnFieldDone: func(event FieldEvent) {
+			if event.Duration < 0 {
+				t.Fatalf("Expected duration, got %s.", event.Duration)
+			}
+			events = append(events, "done "+event.Path+" "+strconv.Itoa(event.Errors.Length()))
+		},
+		OnStructStart: func(event StructEvent) {
+			events = append(events, "struct "+event.Path)
+		},
+		OnStructDone: func(event StructEvent) {
+			events = append(events, "struct done "+event.Path+" "+strconv.Itoa(event.Errors.Length()))
+		},
+	})
+
+	errs := validator.Validate(&hookDummy{Name: "ab"})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	expected := []string{
+		"struct ",
+		"start Name",
+		"error Name min",
+		"done Name 1",
+		"start Email",
+		"done Email 0",
+		"start Address",
+		"done Address 0",
+		"struct Address",
+		"start Address.City",
+		"error Address.City not_empty",
+		"done Address.City 1",
+		"struct done Address 1",
+		"struct done  2",
+	}
+
+	if !reflect.DeepEqual(events, expected) {
+		t.Fatalf("Expected events %v, got %v.", expected, events)
+	}
+}
+
+func TestThatHooksAreCalledInOrderAndCopied(t *testing.T) {
+	var calls []string
+
+	validator := New()
+	validator.AddHooks(Hooks{OnFieldError: func(event FieldEvent) {
+		calls = append(calls, "first")
+	}})
+	validator.AddHooks(Hooks{OnFieldError: func(event FieldEvent) {
+		if event.Err == nil || event.Err.GetValidatorName() != event.Validator {
+			t.Fatalf("Expected error of validator '%s', got %v.", event.Validator, event.Err)
+		}
+		calls = append(calls, "second")
+	}})
+
+	if err := validator.Copy().ValidateValue("", "not_empty"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expected := []string{"first", "second"}; !reflect.DeepEqual(calls, expected) {
+		t.Fatalf("Expected calls %v, got %v.", expected, calls)
+	}
+}
@@ -0,0 +1,552 @@
+// Package jsonschema generates JSON Schemas from the validate tags of structures, so that the same rules can be used
+// for validation on the server and documentation or validation on the client.
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/typerandom/validator"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Draft is the JSON Schema version of generated root schemas.
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+	Const                interface{}        `json:"const,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+}
+
+// String returns the schema as indented JSON.
+func (this *Schema) String() string {
+	data, _ := json.MarshalIndent(this, "", "  ")
+	return string(data)
+}
+
+// Generate generates the JSON Schema of value, which is a struct or a pointer to struct, using the default validator.
+func Generate(value interface{}) (*Schema, error) {
+	return GenerateWith(validator.Default(), value)
+}
+
+// GenerateWith generates the JSON Schema of value using the aliases, rules and validators of v.
+func GenerateWith(v validator.Validator, value interface{}) (*Schema, error) {
+	plan, err := v.Compile(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema := NewGenerator(plan).Generate(reflect.TypeOf(value))
+	schema.Schema = Draft
+
+	return schema, nil
+}
+
+// Generator generates schemas of types from compiled validation plans.
+type Generator struct {
+	// RefPrefix, when set, makes named struct types generated into Definitions and referenced by the prefix followed
+	// by their name, i.e. `#/$defs/`. Otherwise struct types are generated inline.
+	RefPrefix string
+
+	// Definitions holds the schemas of referenced struct types by name.
+	Definitions map[string]*Schema
+
+	types     map[reflect.Type]*validator.CompiledType
+	names     map[reflect.Type]string
+	resolving map[reflect.Type]bool
+}
+
+// NewGenerator creates a generator for the types of plan.
+func NewGenerator(plan *validator.Plan) *Generator {
+	generator := &Generator{
+		Definitions: make(map[string]*Schema),
+		types:       make(map[reflect.Type]*validator.CompiledType),
+		names:       make(map[reflect.Type]string),
+		resolving:   make(map[reflect.Type]bool),
+	}
+	generator.Include(plan)
+	return generator
+}
+
+// Include adds the types of plan to the generator.
+func (this *Generator) Include(plan *validator.Plan) {
+	for _, compiledType := range plan.Types {
+		if _, ok := this.types[compiledType.Type]; !ok {
+			this.types[compiledType.Type] = compiledType
+		}
+	}
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Generate generates the schema of a type. Recursive types are generated as plain objects where they recur.
+func (this *Generator) Generate(reflectedType reflect.Type) *Schema {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+
+	if reflectedType == timeType {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch reflectedType.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Array, reflect.Slice:
+		return &Schema{Type: "array", Items: this.Generate(reflectedType.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: this.Generate(reflectedType.Elem())}
+	case reflect.Struct:
+		if len(this.RefPrefix) > 0 && len(reflectedType.Name()) > 0 {
+			return this.generateRef(reflectedType)
+		}
+		return this.generateStruct(reflectedType)
+	}
+
+	return &Schema{}
+}
+
+// generateRef generates the schema of a named struct type into the definitions, unless already generated, and
+// returns a reference to it.
+func (this *Generator) generateRef(reflectedType reflect.Type) *Schema {
+	name, ok := this.names[reflectedType]
+
+	if !ok {
+		name = reflectedType.Name()
+
+		if _, taken := this.Definitions[name]; taken {
+			name = strings.Replace(reflectedType.PkgPath(), "/", ".", -1) + "." + name
+		}
+
+		this.names[reflectedType] = name
+		this.Definitions[name] = &Schema{}
+		*this.Definitions[name] = *this.generateStruct(reflectedType)
+	}
+
+	return &Schema{Ref: this.RefPrefix + name}
+}
+
+func (this *Generator) generateStruct(reflectedType reflect.Type) *Schema {
+	schema := &Schema{Type: "object"}
+	compiledType := this.types[reflectedType]
+
+	if compiledType == nil || this.resolving[reflectedType] {
+		return schema
+	}
+
+	this.resolving[reflectedType] = true
+	defer delete(this.resolving, reflectedType)
+
+	schema.Properties = make(map[string]*Schema)
+	var embeddedSchemas []*Schema
+
+	for _, compiledField := range compiledType.Fields {
+		structField := reflectedType.Field(compiledField.Field.Index)
+
+		// Like encoding/json, the fields of embedded structs without a name are promoted.
+		if compiledField.Field.Embedded && len(structField.Tag.Get("json")) == 0 {
+			embeddedType := structField.Type
+
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+			}
+
+			embeddedSchema := this.generateStruct(embeddedType)
+
+			// The fields of nil embedded pointers are omitted, so they're never required.
+			if structField.Type.Kind() == reflect.Ptr {
+				embeddedSchema.Required = nil
+			}
+
+			embeddedSchemas = append(embeddedSchemas, embeddedSchema)
+			continue
+		} else if len(structField.PkgPath) > 0 {
+			continue
+		}
+
+		name := PropertyName(structField)
+
+		if len(name) == 0 {
+			continue
+		}
+
+		fieldSchema := this.Generate(structField.Type)
+
+		if applyGroups(fieldSchema, compiledField.Groups) {
+			schema.Required = append(schema.Required, name)
+		}
+
+		schema.Properties[name] = fieldSchema
+	}
+
+	// Fields of the struct take precedence over promoted fields.
+	for _, embeddedSchema := range embeddedSchemas {
+		names := make([]string, 0, len(embeddedSchema.Properties))
+
+		for name := range embeddedSchema.Properties {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		for _, name := range names {
+			if _, ok := schema.Properties[name]; !ok {
+				schema.Properties[name] = embeddedSchema.Properties[name]
+
+				if contains(embeddedSchema.Required, name) {
+					schema.Required = append(schema.Required, name)
+				}
+			}
+		}
+	}
+
+	return schema
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PropertyName returns the JSON name of a field, or an empty string if the field is not encoded.
+func PropertyName(field reflect.StructField) string {
+	name := field.Tag.Get("json")
+
+	if index := strings.Index(name, ","); index != -1 {
+		name = name[:index]
+	}
+
+	if name == "-" {
+		return ""
+	} else if len(name) == 0 {
+		return field.Name
+	}
+
+	return name
+}
+
+// applyGroups applies the constraints of validator groups to a schema. Groups that are limited to scenarios are not
+// applied, as they are not always validated. Alternative groups are applied as `anyOf`. Returns true if the field is
+// required.
+func applyGroups(schema *Schema, groups [][]*validator.CompiledValidator) bool {
+	var activeGroups [][]*validator.CompiledValidator
+
+	for _, group := range groups {
+		if !hasScenario(group) {
+			activeGroups = append(activeGroups, group)
+		}
+	}
+
+	switch len(activeGroups) {
+	case 0:
+		return false
+	case 1:
+		return applyGroup(schema, schema.Type, activeGroups[0])
+	}
+
+	nilable := false
+
+	for _, group := range activeGroups {
+		nilable = nilable || acceptsNil(group)
+	}
+
+	for _, group := range activeGroups {
+		groupSchema := &Schema{}
+
+		// Groups of nil-able fields, i.e. `nil|email`, accept null, so the type is applied by the other groups.
+		if nilable {
+			if acceptsNil(group) {
+				schema.AnyOf = append(schema.AnyOf, &Schema{Type: "null"})
+				continue
+			}
+
+			groupSchema.Type, groupSchema.Format = schema.Type, schema.Format
+		}
+
+		applyGroup(groupSchema, schema.Type, group)
+		schema.AnyOf = append(schema.AnyOf, groupSchema)
+	}
+
+	if nilable {
+		schema.Type, schema.Format = "", ""
+	}
+
+	return false
+}
+
+// acceptsNil checks whether a group only accepts nil, i.e. the first group of `nil|email`.
+func acceptsNil(group []*validator.CompiledValidator) bool {
+	for _, compiledValidator := range group {
+		if compiledValidator.Method.Name == "nil" && !compiledValidator.Method.Negated {
+			return true
+		}
+	}
+	return false
+}
+
+func hasScenario(group []*validator.CompiledValidator) bool {
+	for _, compiledValidator := range group {
+		if compiledValidator.Method.Name == "scenario" {
+			return true
+		}
+	}
+	return false
+}
+
+// applyGroup applies the constraints of validators of a group to a schema of type schemaType.
+func applyGroup(schema *Schema, schemaType string, group []*validator.CompiledValidator) bool {
+	required := false
+
+	for i, compiledValidator := range group {
+		// Negated validators, i.e. `!uppercase`, have no constraint in the schema.
+		if compiledValidator.Method.Negated {
+			continue
+		}
+
+		args := compiledValidator.Method.Arguments
+
+		switch compiledValidator.Method.Name {
+		case "not_empty":
+			required = true
+			applyEmpty(schema, schemaType, false)
+		case "omitempty":
+			// The validators that follow only apply to values that aren't empty, so the field is optional.
+			emptySchema := &Schema{}
+			applyEmpty(emptySchema, schemaType, true)
+
+			constrainedSchema := &Schema{}
+			applyGroup(constrainedSchema, schemaType, group[i+1:])
+
+			if !reflect.DeepEqual(*constrainedSchema, Schema{}) {
+				schema.AnyOf = append(schema.AnyOf, emptySchema, constrainedSchema)
+			}
+
+			return required
+		case "required":
+			required = true
+		case "min":
+			if bound, ok := toFloat(args, 0); ok {
+				applyBound(schema, schemaType, bound, false)
+			}
+		case "max":
+			if bound, ok := toFloat(args, 0); ok {
+				applyBound(schema, schemaType, bound, true)
+			}
+		case "between":
+			if minBound, ok := toFloat(args, 0); ok {
+				applyBound(schema, schemaType, minBound, false)
+			}
+			if maxBound, ok := toFloat(args, 1); ok {
+				applyBound(schema, schemaType, maxBound, true)
+			}
+		case "gt", "gte", "lt", "lte":
+			if bound, ok := toFloat(args, 0); ok {
+				applyNumberBound(schema, schemaType, compiledValidator.Method.Name, bound)
+			}
+		case "positive":
+			applyNumberBound(schema, schemaType, "gt", 0)
+		case "negative":
+			applyNumberBound(schema, schemaType, "lt", 0)
+		case "non_negative":
+			applyNumberBound(schema, schemaType, "gte", 0)
+		case "length":
+			if minBound, ok := toFloat(args, 0); ok {
+				maxBound := minBound
+
+				if len(args) > 1 {
+					maxBound, _ = toFloat(args, 1)
+				}
+
+				applyBound(schema, schemaType, minBound, false)
+				applyBound(schema, schemaType, maxBound, true)
+			}
+		case "one_of":
+			schema.Enum = append([]interface{}(nil), args...)
+		case "equal":
+			schema.Enum = append([]interface{}(nil), args...)
+		case "regexp", "match":
+			if len(args) == 1 {
+				if expr, ok := args[0].(*regexp.Regexp); ok {
+					schema.Pattern = expr.String()
+				} else {
+					schema.Pattern = fmt.Sprint(args[0])
+				}
+			}
+		case "default":
+			if len(args) == 1 {
+				schema.Default = args[0]
+			}
+		case "empty":
+			applyEmpty(schema, schemaType, true)
+		default:
+			if format, ok := formats[compiledValidator.Method.Name]; ok {
+				schema.Format = format
+			}
+		}
+	}
+
+	return required
+}
+
+// formats are the JSON Schema formats of validators.
+var formats = map[string]string{
+	"email":   "email",
+	"url":     "uri",
+	"uuid":    "uuid",
+	"ipv4":    "ipv4",
+	"ipv6":    "ipv6",
+	"iso8601": "date-time",
+}
+
+func toFloat(args []interface{}, index int) (float64, bool) {
+	if index >= len(args) {
+		return 0, false
+	}
+
+	switch typedArg := args[index].(type) {
+	case float64:
+		return typedArg, true
+	case int64:
+		return float64(typedArg), true
+	}
+
+	return 0, false
+}
+
+// applyNumberBound applies the bound of a `gt`, `gte`, `lt` or `lte` comparison to a schema of a number.
+func applyNumberBound(schema *Schema, schemaType string, comparison string, bound float64) {
+	if schemaType != "integer" && schemaType != "number" {
+		return
+	}
+
+	switch comparison {
+	case "gt":
+		schema.ExclusiveMinimum = tighterFloat(schema.ExclusiveMinimum, bound, false)
+	case "gte":
+		schema.Minimum = tighterFloat(schema.Minimum, bound, false)
+	case "lt":
+		schema.ExclusiveMaximum = tighterFloat(schema.ExclusiveMaximum, bound, true)
+	case "lte":
+		schema.Maximum = tighterFloat(schema.Maximum, bound, true)
+	}
+}
+
+// applyEmpty applies the constraint of `empty`, or `not_empty`, to a schema. Strings, arrays and objects are empty
+// if they have no length, numbers if they're 0 and booleans if they're false.
+func applyEmpty(schema *Schema, schemaType string, empty bool) {
+	var zero interface{}
+
+	switch schemaType {
+	case "string", "array", "object":
+		if empty {
+			applyBound(schema, schemaType, 0, true)
+		} else {
+			applyBound(schema, schemaType, 1, false)
+		}
+		return
+	case "integer", "number":
+		zero = 0
+	case "boolean":
+		if empty {
+			schema.Const = false
+		} else {
+			schema.Const = true
+		}
+		return
+	default:
+		return
+	}
+
+	if empty {
+		schema.Const = zero
+	} else {
+		schema.Not = &Schema{Const: zero}
+	}
+}
+
+// applyBound applies a lower or upper bound to a schema, as a length, number of items or properties, or value.
+func applyBound(schema *Schema, schemaType string, bound float64, upper bool) {
+	intBound := int(bound)
+
+	switch schemaType {
+	case "string":
+		if upper {
+			schema.MaxLength = tighterInt(schema.MaxLength, intBound, true)
+		} else {
+			schema.MinLength = tighterInt(schema.MinLength, intBound, false)
+		}
+	case "array":
+		if upper {
+			schema.MaxItems = tighterInt(schema.MaxItems, intBound, true)
+		} else {
+			schema.MinItems = tighterInt(schema.MinItems, intBound, false)
+		}
+	case "object":
+		if upper {
+			schema.MaxProperties = tighterInt(schema.MaxProperties, intBound, true)
+		} else {
+			schema.MinProperties = tighterInt(schema.MinProperties, intBound, false)
+		}
+	case "integer", "number":
+		if upper {
+			schema.Maximum = tighterFloat(schema.Maximum, bound, true)
+		} else {
+			schema.Minimum = tighterFloat(schema.Minimum, bound, false)
+		}
+	}
+}
+
+// tighterInt returns the tighter of a bound and the current bound, if any. The validators of a group must all pass,
+// so the lowest upper bound and the highest lower bound apply.
+func tighterInt(current *int, bound int, upper bool) *int {
+	if current != nil && (upper && *current < bound || !upper && *current > bound) {
+		return current
+	}
+	return &bound
+}
+
+// tighterFloat returns the tighter of a bound and the current bound, like tighterInt.
+func tighterFloat(current *float64, bound float64, upper bool) *float64 {
+	if current != nil && (upper && *current < bound || !upper && *current > bound) {
+		return current
+	}
+	return &bound
+}
@@ -0,0 +1,316 @@
+package jsonschema_test
+
+import (
+	"encoding/json"
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/jsonschema"
+	"reflect"
+	"testing"
+)
+
+type address struct {
+	Street string `json:"street" validate:"not_empty,max(100)"`
+	Zip    string `json:"zip" validate:"regexp(´^[0-9]{5}$´)"`
+}
+
+type user struct {
+	Name      string            `json:"name" validate:"not_empty,min(2),max(50)"`
+	Email     string            `json:"email,omitempty" validate:"empty|email"`
+	Age       int               `json:"age" validate:"between(18,130)"`
+	Role      string            `json:"role" validate:"one_of(admin,user)"`
+	Tags      []string          `json:"tags" validate:"max(5)"`
+	Addresses []address         `json:"addresses"`
+	Labels    map[string]string `json:"labels"`
+	Secret    string            `json:"-" validate:"not_empty"`
+	Nickname  string
+}
+
+func TestThatGenerateProducesSchemaFromTags(t *testing.T) {
+	schema, err := jsonschema.Generate(&user{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	data, err := json.Marshal(schema)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	var actual map[string]interface{}
+	json.Unmarshal(data, &actual)
+
+	var expected map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {
+			"name": {"type": "string", "minLength": 2, "maxLength": 50},
+			"email": {"type": "string", "anyOf": [{"maxLength": 0}, {"format": "email"}]},
+			"age": {"type": "integer", "minimum": 18, "maximum": 130},
+			"role": {"type": "string", "enum": ["admin", "user"]},
+			"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 5},
+			"addresses": {
+				"type": "array",
+				"items": {
+					"type": "object",
+					"properties": {
+						"street": {"type": "string", "minLength": 1, "maxLength": 100},
+						"zip": {"type": "string", "pattern": "^[0-9]{5}$"}
+					},
+					"required": ["street"]
+				}
+			},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"Nickname": {"type": "string"}
+		},
+		"required": ["name"]
+	}`), &expected)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected schema %v, got %s.", expected, schema)
+	}
+}
+
+func TestThatGenerateIgnoresScenarioGroups(t *testing.T) {
+	type input struct {
+		Id string `json:"id" validate:"scenario(update),not_empty"`
+	}
+
+	schema, err := jsonschema.Generate(&input{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(schema.Required) != 0 {
+		t.Fatalf("Expected no required properties, got %v.", schema.Required)
+	}
+}
+
+type node struct {
+	Value    string  `json:"value" validate:"not_empty"`
+	Children []*node `json:"children"`
+}
+
+func TestThatGenerateHandlesRecursiveTypes(t *testing.T) {
+	schema, err := jsonschema.Generate(&node{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	items := schema.Properties["children"].Items
+
+	if items == nil || items.Type != "object" || items.Properties != nil {
+		t.Fatalf("Expected recursive type to be a plain object, got %s.", schema)
+	}
+}
+
+func TestThatGenerateUsesAliasesOfValidator(t *testing.T) {
+	type input struct {
+		Code string `json:"code" validate:"code"`
+	}
+
+	v := validator.New()
+
+	if err := v.RegisterAlias("code", "min(3),max(3)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	schema, err := jsonschema.GenerateWith(v, &input{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	code := schema.Properties["code"]
+
+	if code.MinLength == nil || *code.MinLength != 3 || code.MaxLength == nil || *code.MaxLength != 3 {
+		t.Fatalf("Expected length of 3, got %s.", code)
+	}
+}
+
+func TestThatGenerateFailsOnUnknownValidators(t *testing.T) {
+	type input struct {
+		Name string `validate:"unknown"`
+	}
+
+	if _, err := jsonschema.Generate(&input{}); err == nil {
+		t.Fatalf("Expected error, got nil.")
+	}
+}
+
+type timestamps struct {
+	CreatedAt string `json:"created_at" validate:"not_empty"`
+}
+
+type Owner struct {
+	Owner string `json:"owner" validate:"not_empty"`
+}
+
+type document struct {
+	timestamps
+	*Owner
+	Title string `json:"title" validate:"not_empty"`
+}
+
+func TestThatGeneratePromotesFieldsOfEmbeddedStructs(t *testing.T) {
+	schema, err := jsonschema.Generate(&document{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	for _, name := range []string{"created_at", "owner", "title"} {
+		if schema.Properties[name] == nil {
+			t.Fatalf("Expected property '%s', got %s.", name, schema)
+		}
+	}
+
+	if !reflect.DeepEqual(schema.Required, []string{"title", "created_at"}) {
+		t.Fatalf("Expected required properties [title created_at], got %v.", schema.Required)
+	}
+}
+
+func TestThatGenerateRequiresFieldsWithoutBounds(t *testing.T) {
+	type patch struct {
+		Bio *string `json:"bio" validate:"required"`
+	}
+
+	schema, err := jsonschema.Generate(&patch{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if !reflect.DeepEqual(schema.Required, []string{"bio"}) {
+		t.Fatalf("Expected required properties [bio], got %v.", schema.Required)
+	}
+
+	if bio := schema.Properties["bio"]; bio.MinLength != nil {
+		t.Fatalf("Expected no minimum length, got %v.", *bio.MinLength)
+	}
+}
+
+func TestThatGenerateUsesExclusiveBoundsOfComparisons(t *testing.T) {
+	type order struct {
+		Quantity int     `json:"quantity" validate:"gt(0),lte(100)"`
+		Discount float64 `json:"discount" validate:"non_negative,lt(1)"`
+	}
+
+	schema, err := jsonschema.Generate(&order{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	quantity := schema.Properties["quantity"]
+
+	if quantity.ExclusiveMinimum == nil || *quantity.ExclusiveMinimum != 0 || quantity.Maximum == nil || *quantity.Maximum != 100 {
+		t.Fatalf("Expected exclusive minimum 0 and maximum 100, got %s.", quantity)
+	}
+
+	discount := schema.Properties["discount"]
+
+	if discount.Minimum == nil || *discount.Minimum != 0 || discount.ExclusiveMaximum == nil || *discount.ExclusiveMaximum != 1 {
+		t.Fatalf("Expected minimum 0 and exclusive maximum 1, got %s.", discount)
+	}
+}
+
+func TestThatGenerateKeepsTighterBounds(t *testing.T) {
+	type person struct {
+		Age  int    `json:"age" validate:"min(18),between(1,99)"`
+		Name string `json:"name" validate:"max(10),not_empty,length(2,20)"`
+	}
+
+	schema, err := jsonschema.Generate(&person{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if age := schema.Properties["age"]; *age.Minimum != 18 || *age.Maximum != 99 {
+		t.Fatalf("Expected minimum 18 and maximum 99, got %s.", age)
+	}
+
+	if name := schema.Properties["name"]; *name.MinLength != 2 || *name.MaxLength != 10 {
+		t.Fatalf("Expected min length 2 and max length 10, got %s.", name)
+	}
+}
+
+func TestThatGenerateAcceptsNullForNilGroups(t *testing.T) {
+	type contact struct {
+		Email *string `json:"email" validate:"nil|email"`
+	}
+
+	schema, err := jsonschema.Generate(&contact{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	data, _ := json.Marshal(schema.Properties["email"])
+	expected := `{"anyOf":[{"type":"null"},{"type":"string","format":"email"}]}`
+
+	if string(data) != expected {
+		t.Fatalf("Expected schema '%s', got '%s'.", expected, data)
+	}
+}
+
+func TestThatGenerateAppliesEmptinessByType(t *testing.T) {
+	type input struct {
+		Count   int      `json:"count" validate:"not_empty"`
+		Offset  float64  `json:"offset" validate:"empty"`
+		Enabled bool     `json:"enabled" validate:"not_empty"`
+		Tags    []string `json:"tags" validate:"not_empty"`
+	}
+
+	schema, err := jsonschema.Generate(&input{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if count := schema.Properties["count"]; count.Minimum != nil || count.Not == nil || count.Not.Const != 0 {
+		t.Fatalf("Expected count other than 0, got %s.", count)
+	}
+
+	if offset := schema.Properties["offset"]; offset.Maximum != nil || offset.Const != 0 {
+		t.Fatalf("Expected offset of 0, got %s.", offset)
+	}
+
+	if enabled := schema.Properties["enabled"]; enabled.Const != true {
+		t.Fatalf("Expected enabled to be true, got %s.", enabled)
+	}
+
+	if tags := schema.Properties["tags"]; tags.MinItems == nil || *tags.MinItems != 1 {
+		t.Fatalf("Expected at least 1 tag, got %s.", tags)
+	}
+}
+
+func TestThatGenerateOnlyConstrainsValuesThatAreNotOmitted(t *testing.T) {
+	type input struct {
+		Website string `json:"website" validate:"omitempty,url,max(100)"`
+		Limit   int    `json:"limit" validate:"omitempty,min(10)"`
+	}
+
+	schema, err := jsonschema.Generate(&input{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(schema.Required) != 0 {
+		t.Fatalf("Expected no required properties, got %v.", schema.Required)
+	}
+
+	data, _ := json.Marshal(schema.Properties)
+
+	expected := `{"limit":{"type":"integer","anyOf":[{"const":0},{"minimum":10}]},"website":{"type":"string","anyOf":[{"maxLength":0},{"format":"uri","maxLength":100}]}}`
+
+	if string(data) != expected {
+		t.Fatalf("Expected properties %s, got %s.", expected, data)
+	}
+}
@@ -0,0 +1,81 @@
+package lint
+
+import (
+	gocontext "context"
+	"errors"
+	"github.com/typerandom/validator/core"
+	"reflect"
+)
+
+// lintError is the error of a locale key returned by validators run by the linter.
+type lintError struct {
+	key  string
+	args []interface{}
+}
+
+func (this *lintError) Error() string {
+	return this.key
+}
+
+// lintContext is the context of validators run by the linter. It has no source, so sibling values are unavailable.
+type lintContext struct {
+	field          *core.ReflectedField
+	value          interface{}
+	originalKind   reflect.Kind
+	isNil          bool
+	namedArguments map[string]interface{}
+}
+
+func (this *lintContext) Context() gocontext.Context {
+	return gocontext.Background()
+}
+
+func (this *lintContext) Source() interface{} {
+	return nil
+}
+
+func (this *lintContext) Field() *core.ReflectedField {
+	return this.field
+}
+
+func (this *lintContext) Value() interface{} {
+	return this.value
+}
+
+func (this *lintContext) SetValue(value interface{}) error {
+	normalized, err := core.Normalize(value)
+
+	if err != nil {
+		return err
+	}
+
+	this.value = normalized.Value
+	this.originalKind = normalized.OriginalKind
+	this.isNil = normalized.IsNil
+
+	return nil
+}
+
+func (this *lintContext) IsNil() bool {
+	return this.isNil
+}
+
+func (this *lintContext) OriginalKind() reflect.Kind {
+	return this.originalKind
+}
+
+func (this *lintContext) NamedArguments() map[string]interface{} {
+	return this.namedArguments
+}
+
+func (this *lintContext) SiblingValue(name string) (*core.NormalizedValue, error) {
+	return nil, errors.New("Sibling values are not available when linting.")
+}
+
+func (this *lintContext) SkipField() {}
+
+func (this *lintContext) AbortStruct() {}
+
+func (this *lintContext) NewError(localeKey string, args ...interface{}) error {
+	return &lintError{key: localeKey, args: args}
+}
@@ -0,0 +1,415 @@
+// Package lint statically checks the validation tags of structs in Go source files, so that unknown validators,
+// malformed arguments and unsupported validator and type combinations are found before the code runs.
+package lint
+
+import (
+	"github.com/typerandom/validator/core"
+	tagparser "github.com/typerandom/validator/core/parser"
+	"github.com/typerandom/validator/validators"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Issue is a problem with the validation tag of a field.
+type Issue struct {
+	Position token.Position
+	Struct   string
+	Field    string
+	Message  string
+}
+
+func (this Issue) String() string {
+	return this.Position.String() + ": " + this.Struct + "." + this.Field + ": " + this.Message
+}
+
+// Linter checks validation tags against the validators of a registry.
+type Linter struct {
+	// TagName is the name of the tag holding the rules, `validate` by default.
+	TagName string
+
+	registry *core.ValidatorRegistry
+	locale   *core.Locale
+}
+
+// New creates a linter for the default validators.
+func New() *Linter {
+	registry := core.NewValidatorRegistry()
+	locale := core.NewLocale()
+
+	validators.RegisterDefaultValidators(registry)
+	validators.RegisterDefaultLocale(locale)
+
+	return NewWith(registry, locale)
+}
+
+// NewWith creates a linter for the validators and aliases of registry, with messages of locale.
+func NewWith(registry *core.ValidatorRegistry, locale *core.Locale) *Linter {
+	return &Linter{
+		TagName:  "validate",
+		registry: registry,
+		locale:   locale,
+	}
+}
+
+// Registry returns the registry of the linter, i.e. to register custom validators and aliases.
+func (this *Linter) Registry() *core.ValidatorRegistry {
+	return this.registry
+}
+
+// LintDir lints the non-test Go files of a directory. If recursive, then subdirectories are linted as well, except
+// for `vendor`, `testdata` and hidden directories. The files of each directory are type checked as a package, so that
+// the types of fields are known.
+func (this *Linter) LintDir(dir string, recursive bool) ([]Issue, error) {
+	var issues []Issue
+
+	fileSet := token.NewFileSet()
+	imports := importer.ForCompiler(fileSet, "source", nil)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() {
+			return nil
+		}
+
+		if path != dir && (!recursive || isSkippedDir(info.Name())) {
+			return filepath.SkipDir
+		}
+
+		packageIssues, err := this.lintPackageDir(fileSet, imports, path)
+
+		if err != nil {
+			return err
+		}
+
+		issues = append(issues, packageIssues...)
+
+		return nil
+	})
+
+	return issues, err
+}
+
+func isSkippedDir(name string) bool {
+	return name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_")
+}
+
+// lintPackageDir lints the non-test Go files of a directory, with the files of each package type checked together.
+func (this *Linter) lintPackageDir(fileSet *token.FileSet, imports types.Importer, dir string) ([]Issue, error) {
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var packageNames []string
+	packages := map[string][]*ast.File{}
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, 0)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := packages[file.Name.Name]; !ok {
+			packageNames = append(packageNames, file.Name.Name)
+		}
+
+		packages[file.Name.Name] = append(packages[file.Name.Name], file)
+	}
+
+	var issues []Issue
+
+	for _, name := range packageNames {
+		issues = append(issues, this.lintFiles(fileSet, imports, packages[name])...)
+	}
+
+	return issues, nil
+}
+
+// LintFile lints a Go file, type checked as a package of its own. If src is not nil, then it's used as the source of
+// the file instead of reading it.
+func (this *Linter) LintFile(filename string, src interface{}) ([]Issue, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filename, src, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return this.lintFiles(fileSet, importer.ForCompiler(fileSet, "source", nil), []*ast.File{file}), nil
+}
+
+// lintFiles type checks the files of a package and lints their structs. Errors of type checking are ignored, as they
+// only leave the types of some fields unknown, which are then not checked against their validators.
+func (this *Linter) lintFiles(fileSet *token.FileSet, imports types.Importer, files []*ast.File) []Issue {
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}
+	config := &types.Config{Importer: imports, Error: func(error) {}}
+
+	config.Check(files[0].Name.Name, fileSet, files, info)
+
+	var issues []Issue
+
+	for _, file := range files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			if typeSpec, ok := node.(*ast.TypeSpec); ok {
+				if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+					issues = append(issues, this.lintStruct(fileSet, info, typeSpec.Name.Name, structType)...)
+				}
+			}
+			return true
+		})
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Position.Filename != issues[j].Position.Filename {
+			return issues[i].Position.Filename < issues[j].Position.Filename
+		}
+		return issues[i].Position.Offset < issues[j].Position.Offset
+	})
+
+	return issues
+}
+
+func (this *Linter) lintStruct(fileSet *token.FileSet, info *types.Info, structName string, structType *ast.StructType) []Issue {
+	var issues []Issue
+
+	for _, astField := range structType.Fields.List {
+		if astField.Tag == nil {
+			continue
+		}
+
+		tagValue, err := strconv.Unquote(astField.Tag.Value)
+
+		if err != nil {
+			continue
+		}
+
+		rules, ok := reflect.StructTag(tagValue).Lookup(this.TagName)
+
+		if !ok || rules == "-" || rules == core.IgnoreDirective {
+			continue
+		}
+
+		for _, name := range astField.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			field := &core.ReflectedField{Name: name.Name, StructName: structName}
+
+			var fieldType types.Type
+
+			if object := info.Defs[name]; object != nil {
+				fieldType = object.Type()
+			}
+
+			for _, message := range this.lintField(field, rules, fieldType) {
+				issues = append(issues, Issue{
+					Position: fileSet.Position(astField.Tag.Pos()),
+					Struct:   structName,
+					Field:    name.Name,
+					Message:  message,
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// lintField checks the rules of a field and returns the messages of the issues found.
+func (this *Linter) lintField(field *core.ReflectedField, rules string, fieldType types.Type) []string {
+	methodGroups, err := tagparser.Parse(rules)
+
+	if err != nil {
+		return []string{"Unable to parse tag. " + err.Error()}
+	}
+
+	if methodGroups, err = this.registry.ExpandAliases(methodGroups); err != nil {
+		return []string{"Unable to expand aliases. " + err.Error()}
+	}
+
+	if methodGroups, err = this.registry.ConvertArguments(methodGroups); err != nil {
+		return []string{"Unable to parse validators. " + err.Error()}
+	}
+
+	value, typeKnown := zeroValue(fieldType)
+
+	var messages []string
+
+	for _, methods := range methodGroups {
+		for _, method := range methods {
+			if message, ok := lintDirective(method); ok {
+				if len(message) > 0 {
+					messages = append(messages, message)
+				}
+				continue
+			}
+
+			validate, err := this.registry.Get(method.Name)
+
+			if err != nil {
+				messages = append(messages, err.Error())
+				continue
+			}
+
+			if method.Negated {
+				if err := this.registry.CheckNegation(method.Name); err != nil {
+					messages = append(messages, err.Error())
+					continue
+				}
+			}
+
+			if typeKnown {
+				if message := this.lintValidator(field, method, validate, value); len(message) > 0 {
+					messages = append(messages, message)
+				}
+			}
+		}
+	}
+
+	return messages
+}
+
+// lintDirective checks the arguments of the directives handled by the validator, which can't be negated. Returns false
+// if the method is not a directive.
+func lintDirective(method *tagparser.Method) (string, bool) {
+	switch method.Name {
+	case "scenario", "default", core.LabelDirective, core.InheritDirective:
+		if method.Negated {
+			return "Directive '" + method.Name + "' cannot be negated.", true
+		}
+	}
+
+	switch method.Name {
+	case "scenario":
+		if len(method.Arguments) == 0 {
+			return "Directive 'scenario' requires at least one argument.", true
+		}
+		return "", true
+	case "default", core.LabelDirective:
+		if len(method.Arguments) != 1 {
+			return "Directive '" + method.Name + "' requires a single argument, got " + strconv.Itoa(len(method.Arguments)) + ".", true
+		}
+		return "", true
+	case core.InheritDirective:
+		if len(method.Arguments) != 0 {
+			return "Directive '" + method.Name + "' doesn't take arguments.", true
+		}
+		return "", true
+	}
+	return "", false
+}
+
+// lintValidator runs a validator against the zero value of the field type, and returns the message of the error if
+// the validator doesn't support the type or rejects its arguments. Other errors are expected for zero values.
+func (this *Linter) lintValidator(field *core.ReflectedField, method *tagparser.Method, validate core.ValidatorFn, value interface{}) (message string) {
+	context := &lintContext{field: field, namedArguments: method.NamedArguments}
+
+	if err := context.SetValue(value); err != nil {
+		return ""
+	}
+
+	defer func() {
+		if recover() != nil {
+			message = ""
+		}
+	}()
+
+	err, ok := validate(context, method.Arguments).(*lintError)
+
+	if !ok || !(err.key == "type.unsupported" || strings.HasPrefix(err.key, "arguments.")) {
+		return ""
+	}
+
+	messageErr := core.NewMessageError(this.locale, err.key, err.args...)
+
+	return core.NewError(field, method, messageErr).Error()
+}
+
+var basicZeroValues = map[string]interface{}{
+	"string":     "",
+	"bool":       false,
+	"int":        int(0),
+	"int8":       int8(0),
+	"int16":      int16(0),
+	"int32":      int32(0),
+	"rune":       rune(0),
+	"int64":      int64(0),
+	"uint":       uint(0),
+	"uint8":      uint8(0),
+	"byte":       byte(0),
+	"uint16":     uint16(0),
+	"uint32":     uint32(0),
+	"uint64":     uint64(0),
+	"uintptr":    uintptr(0),
+	"float32":    float32(0),
+	"float64":    float64(0),
+	"complex64":  complex64(0),
+	"complex128": complex128(0),
+}
+
+// zeroValue returns a zero value of the kind of a field type. Returns false if the type is unknown, or if validators
+// may validate its values by other means than their kind, i.e. structs, and types that provide their value or text.
+func zeroValue(fieldType types.Type) (interface{}, bool) {
+	if fieldType == nil {
+		return nil, false
+	}
+
+	fieldType = types.Unalias(fieldType)
+
+	if named, ok := fieldType.(*types.Named); ok {
+		if object := named.Obj(); object.Pkg() != nil && object.Pkg().Path() == "time" && object.Name() == "Time" {
+			return time.Time{}, true
+		}
+
+		if providesValue(named) {
+			return nil, false
+		}
+	}
+
+	switch typed := fieldType.Underlying().(type) {
+	case *types.Basic:
+		value, ok := basicZeroValues[typed.Name()]
+		return value, ok
+	case *types.Pointer:
+		return zeroValue(typed.Elem())
+	case *types.Array, *types.Slice:
+		return []interface{}{}, true
+	case *types.Map:
+		return map[string]interface{}{}, true
+	}
+	return nil, false
+}
+
+// providesValue returns true if the values of a named type provide the value to validate, or their text.
+func providesValue(named *types.Named) bool {
+	for _, method := range []string{"ValidatableValue", "MarshalText", "String"} {
+		if object, _, _ := types.LookupFieldOrMethod(named, true, nil, method); object != nil {
+			if _, ok := object.(*types.Func); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,150 @@
+package lint_test
+
+import (
+	"github.com/typerandom/validator/lint"
+	"strings"
+	"testing"
+)
+
+const source = `package models
+
+import "time"
+
+type User struct {
+	Name     string    ` + "`validate:\"not_empty,min(2)\"`" + `
+	Email    string    ` + "`validate:\"empty|emial\"`" + `
+	Age      int       ` + "`validate:\"min(abc)\"`" + `
+	Active   bool      ` + "`validate:\"email\"`" + `
+	Born     time.Time ` + "`validate:\"between(1)\"`" + `
+	Tags     []string  ` + "`validate:\"max(3)\"`" + `
+	Role     Role      ` + "`validate:\"email\"`" + `
+	Id       int64     ` + "`validate:\"scenario()\"`" + `
+	Broken   string    ` + "`validate:\"min(\"`" + `
+	internal string    ` + "`validate:\"unknown\"`" + `
+}
+
+type Role string
+`
+
+func TestThatLintFileReportsIssues(t *testing.T) {
+	issues, err := lint.New().LintFile("models.go", source)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := []string{
+		"models.go:7:21: User.Email: Validator 'emial' is not registered.",
+		"models.go:8:21: User.Age: Validator 'min' on field 'Age' requires parameter 1 to be of type number.",
+		"models.go:9:21: User.Active: Validator 'email' does not support the type of field 'Active'.",
+		"models.go:10:21: User.Born: Unable to parse validators. Validator 'between' has invalid arguments. Requires at least 2 arguments, got 1.",
+		"models.go:13:21: User.Id: Directive 'scenario' requires at least one argument.",
+		"models.go:14:21: User.Broken: Unable to parse tag.",
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %v.", len(expected), issues)
+	}
+
+	for i, issue := range issues {
+		if !strings.HasPrefix(issue.String(), expected[i]) {
+			t.Fatalf("Expected issue '%s', got '%s'.", expected[i], issue)
+		}
+	}
+}
+
+func TestThatLintFileChecksValidatorsAgainstTypeCheckedFieldTypes(t *testing.T) {
+	issues, err := lint.New().LintFile("models.go", `package models
+
+import (
+	"net/url"
+	"time"
+)
+
+type Level int
+
+type Count = int
+
+type Address string
+
+type Status int
+
+func (this Status) String() string {
+	return "active"
+}
+
+type User struct {
+	Level   Level         `+"`validate:\"email\"`"+`
+	Count   *Count        `+"`validate:\"email\"`"+`
+	Query   url.Values    `+"`validate:\"email\"`"+`
+	Email   Address       `+"`validate:\"email\"`"+`
+	Status  Status        `+"`validate:\"email\"`"+`
+	Timeout time.Duration `+"`validate:\"email\"`"+`
+}
+`)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := []string{
+		"models.go:21:24: User.Level: Validator 'email' does not support the type of field 'Level'.",
+		"models.go:22:24: User.Count: Validator 'email' does not support the type of field 'Count'.",
+		"models.go:23:24: User.Query: Validator 'email' does not support the type of field 'Query'.",
+	}
+
+	if len(issues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %v.", len(expected), issues)
+	}
+
+	for i, issue := range issues {
+		if issue.String() != expected[i] {
+			t.Fatalf("Expected issue '%s', got '%s'.", expected[i], issue)
+		}
+	}
+}
+
+func TestThatLintFileUsesRegisteredAliases(t *testing.T) {
+	linter := lint.New()
+
+	if err := linter.Registry().RegisterAlias("emial", "email"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	issues, err := linter.LintFile("models.go", "package models\ntype User struct {\n\tEmail string `validate:\"emial\"`\n}\n")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(issues) != 0 {
+		t.Fatalf("Expected no issues, got %v.", issues)
+	}
+}
+
+func TestThatLintFileReportsMethodsThatCannotBeNegated(t *testing.T) {
+	issues, err := lint.New().LintFile("models.go", "package models\ntype User struct {\n\tName string `validate:\"!trim,!as(Name)\"`\n\tRole string `validate:\"!one_of(admin)\"`\n}\n")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(issues) != 2 || !strings.Contains(issues[0].String(), "Validator 'trim' cannot be negated.") || !strings.Contains(issues[1].String(), "Directive 'as' cannot be negated.") {
+		t.Fatalf("Expected issues of negated methods, got %v.", issues)
+	}
+}
+
+func TestThatLintFileUsesTagName(t *testing.T) {
+	linter := lint.New()
+	linter.TagName = "rules"
+
+	issues, err := linter.LintFile("models.go", "package models\ntype User struct {\n\tEmail string `validate:\"emial\" rules:\"unknown\"`\n}\n")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(issues) != 1 || issues[0].Field != "Email" {
+		t.Fatalf("Expected a single issue of the rules tag, got %v.", issues)
+	}
+}
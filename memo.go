@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"sync"
+)
+
+// memoKey identifies the result of a pure validator by the field, value and arguments that it was run with. The
+// arguments are identified by their parsed method, which is cached with the fields of its struct type. The length unit
+// is part of the key, as it changes the results of length based validators.
+type memoKey struct {
+	structName string
+	fieldName  string
+	method     *parser.Method
+	value      interface{}
+	isNil      bool
+	translator core.Translator
+	lengthUnit core.LengthUnit
+}
+
+// memoCache caches the results of pure validators, up to a limit of results. It's safe for concurrent use.
+type memoCache struct {
+	lock    sync.Mutex
+	limit   int
+	results map[memoKey]error
+}
+
+func newMemoCache(limit int) *memoCache {
+	return &memoCache{limit: limit, results: make(map[memoKey]error)}
+}
+
+// validate returns the memoized result of the validator of method for the current field and value of context, or
+// runs it with run. Values that aren't comparable are never memoized.
+func (this *memoCache) validate(context *context, method *parser.Method, run func() error) error {
+	if !isComparable(context.value) || !isComparable(context.translator) {
+		return run()
+	}
+
+	key := memoKey{
+		structName: context.field.StructName,
+		fieldName:  context.field.Name,
+		method:     method,
+		value:      context.value,
+		isNil:      context.isNil,
+		translator: context.translator,
+		lengthUnit: core.GetLengthUnit(context.ctx),
+	}
+
+	this.lock.Lock()
+	result, ok := this.results[key]
+	this.lock.Unlock()
+
+	if ok {
+		return result
+	}
+
+	result = run()
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	// Evict an arbitrary result when the cache is full, as map iteration order is random.
+	if len(this.results) >= this.limit {
+		for evictedKey := range this.results {
+			delete(this.results, evictedKey)
+			break
+		}
+	}
+
+	this.results[key] = result
+
+	return result
+}
+
+// isComparable checks whether value can be a key of a map. The dynamic values of interfaces are checked as well, as a
+// struct with an interface field holding a slice can't be hashed, although its type is comparable.
+func isComparable(value interface{}) bool {
+	return value == nil || reflect.ValueOf(value).Comparable()
+}
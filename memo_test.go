@@ -0,0 +1,143 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"sync/atomic"
+	"testing"
+)
+
+type memoDummy struct {
+	Code string `validate:"expensive_code"`
+	Name string `validate:"expensive_code"`
+}
+
+func newMemoTestValidator(calls *int64, pure bool) Validator {
+	validator := New()
+
+	validate := func(context core.ValidatorContext, args []interface{}) error {
+		atomic.AddInt64(calls, 1)
+
+		if context.Value() != "ok" {
+			return context.NewError("code.invalid")
+		}
+
+		return nil
+	}
+
+	if pure {
+		validator.RegisterPure("expensive_code", validate)
+	} else {
+		validator.Register("expensive_code", validate)
+	}
+
+	validator.SetMemoization(100)
+
+	return validator
+}
+
+func TestThatResultsOfPureValidatorsAreMemoized(t *testing.T) {
+	var calls int64
+	validator := newMemoTestValidator(&calls, true)
+
+	for i := 0; i < 3; i++ {
+		if errs := validator.Validate(&memoDummy{Code: "ok", Name: "bad"}); errs.Length() != 1 || errs.First().GetFieldName() != "Name" {
+			t.Fatalf("Expected error of 'Name', got %s.", errs)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls, got %d.", calls)
+	}
+
+	validator.Validate(&memoDummy{Code: "bad", Name: "ok"})
+
+	if calls != 4 {
+		t.Fatalf("Expected 4 calls, got %d.", calls)
+	}
+}
+
+func TestThatResultsOfValidatorsThatAreNotPureAreNotMemoized(t *testing.T) {
+	var calls int64
+	validator := newMemoTestValidator(&calls, false)
+
+	for i := 0; i < 3; i++ {
+		validator.Validate(&memoDummy{Code: "ok", Name: "bad"})
+	}
+
+	if calls != 6 {
+		t.Fatalf("Expected 6 calls, got %d.", calls)
+	}
+}
+
+func TestThatMemoizedResultsAreResetWhenValidatorsAreRegistered(t *testing.T) {
+	var calls int64
+	validator := newMemoTestValidator(&calls, true)
+
+	validator.Validate(&memoDummy{Code: "ok", Name: "ok"})
+
+	validator.RegisterPure("expensive_code", func(context core.ValidatorContext, args []interface{}) error {
+		return context.NewError("code.invalid")
+	})
+
+	if errs := validator.Validate(&memoDummy{Code: "ok", Name: "ok"}); errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+}
+
+func TestThatMemoizedResultsDependOnLengthUnit(t *testing.T) {
+	validator := New()
+
+	validator.RegisterPure("short", func(context core.ValidatorContext, args []interface{}) error {
+		if core.StringLength(context.Context(), context.Value().(string)) > 5 {
+			return context.NewError("code.invalid")
+		}
+		return nil
+	})
+
+	validator.SetMemoization(100)
+
+	value := &struct {
+		Name string `validate:"short"`
+	}{Name: "héllo"}
+
+	if errs := validator.Validate(value); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	validator.SetLengthUnit(core.RuneLength)
+
+	if errs := validator.Validate(value); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+}
+
+type memoPayload struct {
+	X interface{}
+}
+
+func TestThatValuesWithUnhashableDynamicValuesAreNotMemoized(t *testing.T) {
+	var calls int64
+	validator := New()
+
+	validator.RegisterPure("payload", func(context core.ValidatorContext, args []interface{}) error {
+		atomic.AddInt64(&calls, 1)
+		return nil
+	})
+
+	validator.SetMemoization(100)
+
+	value := &struct {
+		Payload memoPayload `validate:"payload"`
+	}{Payload: memoPayload{X: []int{1}}}
+
+	for i := 0; i < 2; i++ {
+		if errs := validator.Validate(value); errs.Any() {
+			t.Fatalf("Didn't expect errors, got %s.", errs)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected 2 calls, got %d.", calls)
+	}
+}
@@ -0,0 +1,58 @@
+package validator
+
+import (
+	gocontext "context"
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"time"
+)
+
+// Recorder records metrics of validation, i.e. as counters and histograms of Prometheus. Set it with SetRecorder.
+// Recorders are called from the goroutines that validate, so they must be safe for concurrent use.
+type Recorder interface {
+	// RecordValidation records a validation, i.e. a call to Validate or ValidateValue, with its duration and errors.
+	// The validation passed if there are no errors.
+	RecordValidation(duration time.Duration, errs core.ErrorList)
+
+	// RecordValidatorFailure records a failure, or a warning, of the validator with name, i.e. `min`.
+	RecordValidatorFailure(name string)
+
+	// RecordStruct records the validation of a struct of structType, including nested structs, with its duration.
+	RecordStruct(structType reflect.Type, duration time.Duration)
+}
+
+// Tracer starts spans around validations, i.e. with OpenTelemetry. Set it with SetTracer. An OpenTelemetry tracer
+// is adapted by starting a span with `tracer.Start(ctx, name)` and ending it with its status set by the errors.
+type Tracer interface {
+	// Start starts a span with name, i.e. `validator.Validate`, as a child of the span of ctx, if any. The returned
+	// context is passed to the validators.
+	Start(ctx gocontext.Context, name string) (gocontext.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// End ends the span with the errors of the validation. The validation passed if there are no errors.
+	End(errs core.ErrorList)
+}
+
+// startValidation starts the span and measuring of a validation, if the validator has a tracer or a recorder.
+func (this *context) startValidation(tracer Tracer, name string) {
+	if this.recorder != nil {
+		this.started = time.Now()
+	}
+
+	if tracer != nil {
+		this.ctx, this.span = tracer.Start(this.ctx, name)
+	}
+}
+
+// doneValidation records the validation started by startValidation and ends its span.
+func (this *context) doneValidation(errs core.ErrorList) {
+	if this.recorder != nil {
+		this.recorder.RecordValidation(time.Since(this.started), errs)
+	}
+
+	if this.span != nil {
+		this.span.End(errs)
+	}
+}
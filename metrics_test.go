@@ -0,0 +1,106 @@
+package validator_test
+
+import (
+	gocontext "context"
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeRecorder struct {
+	validations []int
+	failures    map[string]int
+	structs     []reflect.Type
+}
+
+func (this *fakeRecorder) RecordValidation(duration time.Duration, errs core.ErrorList) {
+	this.validations = append(this.validations, errs.Length())
+}
+
+func (this *fakeRecorder) RecordValidatorFailure(name string) {
+	if this.failures == nil {
+		this.failures = map[string]int{}
+	}
+	this.failures[name]++
+}
+
+func (this *fakeRecorder) RecordStruct(structType reflect.Type, duration time.Duration) {
+	this.structs = append(this.structs, structType)
+}
+
+type fakeSpanKey struct{}
+
+type fakeSpan struct {
+	name  string
+	ended bool
+	errs  core.ErrorList
+}
+
+func (this *fakeSpan) End(errs core.ErrorList) {
+	this.ended = true
+	this.errs = errs
+}
+
+type fakeTracer struct {
+	spans []*fakeSpan
+}
+
+func (this *fakeTracer) Start(ctx gocontext.Context, name string) (gocontext.Context, Span) {
+	span := &fakeSpan{name: name}
+	this.spans = append(this.spans, span)
+	return gocontext.WithValue(ctx, fakeSpanKey{}, span), span
+}
+
+func TestThatRecorderRecordsValidationsFailuresAndStructs(t *testing.T) {
+	recorder := &fakeRecorder{}
+
+	validator := New()
+	validator.SetRecorder(recorder)
+
+	validator.Validate(&hookDummy{Name: "ab"})
+	validator.Copy().ValidateValue("", "not_empty")
+
+	if expected := []int{2, 1}; !reflect.DeepEqual(recorder.validations, expected) {
+		t.Fatalf("Expected validations %v, got %v.", expected, recorder.validations)
+	}
+
+	if expected := map[string]int{"min": 1, "not_empty": 2}; !reflect.DeepEqual(recorder.failures, expected) {
+		t.Fatalf("Expected failures %v, got %v.", expected, recorder.failures)
+	}
+
+	expected := []reflect.Type{reflect.TypeOf(hookAddress{}), reflect.TypeOf(hookDummy{})}
+
+	if !reflect.DeepEqual(recorder.structs, expected) {
+		t.Fatalf("Expected structs %v, got %v.", expected, recorder.structs)
+	}
+}
+
+func TestThatTracerStartsSpanForEachValidation(t *testing.T) {
+	tracer := &fakeTracer{}
+
+	validator := New()
+	validator.SetTracer(tracer)
+	validator.Register("traced", func(context core.ValidatorContext, args []interface{}) error {
+		if context.Context().Value(fakeSpanKey{}) == nil {
+			t.Fatal("Expected the context of the span, didn't get any.")
+		}
+		return nil
+	})
+
+	validator.ValidateCtx(gocontext.Background(), &hookDummy{Name: "abc", Address: hookAddress{City: "Oslo"}})
+	validator.ValidateValue("", "traced,not_empty")
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d.", len(tracer.spans))
+	}
+
+	if span := tracer.spans[0]; span.name != "validator.Validate" || !span.ended || span.errs.Any() {
+		t.Fatalf("Expected ended span without errors, got %+v.", span)
+	}
+
+	if span := tracer.spans[1]; span.name != "validator.ValidateValue" || !span.ended || span.errs.Length() != 1 {
+		t.Fatalf("Expected ended span with 1 error, got %+v.", span)
+	}
+}
@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"strings"
+)
+
+// negatedMessageKey is the locale key of the message of negated validators without a message of their own.
+const negatedMessageKey = "negated.mustNotPass"
+
+// negate inverts the result of a negated method, i.e. `!uppercase`. Errors of the type of the value, of the
+// arguments or of the configuration are kept, as the validator wasn't able to check the value.
+func negate(context *context, method *parser.Method, err error) error {
+	if !method.Negated {
+		return err
+	}
+
+	if err == nil {
+		return negatedError(context, method)
+	}
+
+	if messageErr, ok := err.(*core.MessageError); ok && isConfigurationError(messageErr.Key) {
+		return err
+	}
+
+	return nil
+}
+
+func isConfigurationError(key string) bool {
+	return strings.HasPrefix(key, "type.") || strings.HasPrefix(key, "arguments.") || key == "filesystem.accessRequired"
+}
+
+// negatedError returns the error of a negated method whose validator passed. The message is translated from
+// `negated.<validator>`, i.e. `negated.one_of`, with the arguments of the method joined as its argument. Validators
+// without a negated message use a generic message.
+func negatedError(context *context, method *parser.Method) error {
+	var args []interface{}
+
+	if len(method.Arguments) > 0 {
+		values := make([]string, len(method.Arguments))
+
+		for i, arg := range method.Arguments {
+			values[i] = fmt.Sprint(arg)
+		}
+
+		args = append(args, strings.Join(values, ", "))
+	}
+
+	err := context.NewError("negated."+method.Name, args...)
+
+	if _, ok := err.(*core.MessageError); ok {
+		return err
+	}
+
+	return context.NewError(negatedMessageKey)
+}
@@ -0,0 +1,124 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"testing"
+)
+
+type negateDummy struct {
+	Code string `validate:"!uppercase"`
+	Role string `validate:"!one_of(admin,root)"`
+}
+
+func TestThatNegatedValidatorsPassIfTheValidatorFails(t *testing.T) {
+	if errs := Validate(&negateDummy{Code: "abc", Role: "user"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+}
+
+func TestThatNegatedValidatorsFailWithNegatedMessage(t *testing.T) {
+	errs := Validate(&negateDummy{Code: "ABC", Role: "root"})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	if errs[0].Error() != "Code cannot be in upper case." {
+		t.Fatalf("Expected negated message of 'uppercase', got '%s'.", errs[0])
+	}
+
+	if errs[1].Error() != "Role cannot be one of the following values 'admin, root'." {
+		t.Fatalf("Expected negated message of 'one_of', got '%s'.", errs[1])
+	}
+
+	if errs[1].GetValidatorName() != "one_of" {
+		t.Fatalf("Expected validator 'one_of', got '%s'.", errs[1].GetValidatorName())
+	}
+}
+
+func TestThatNegatedValidatorsWithoutNegatedMessageFailWithGenericMessage(t *testing.T) {
+	validator := New()
+
+	validator.Register("even", func(context core.ValidatorContext, args []interface{}) error {
+		if context.Value().(int64)%2 != 0 {
+			return context.NewError("even.mustBeEven")
+		}
+		return nil
+	})
+
+	errs := validator.Validate(&struct {
+		Count int `validate:"!even"`
+	}{Count: 2})
+
+	if errs.Length() != 1 || errs[0].Error() != "Count must not satisfy 'even'." {
+		t.Fatalf("Expected generic negated message, got %s.", errs)
+	}
+}
+
+func TestThatNegatedValidatorsKeepErrorsOfArguments(t *testing.T) {
+	errs := Validate(&struct {
+		Age int `validate:"!min(abc)"`
+	}{Age: 5})
+
+	if errs.Length() != 1 || errs[0].Error() != "Validator 'min' on field 'Age' requires parameter 1 to be of type number." {
+		t.Fatalf("Expected error of arguments, got %s.", errs)
+	}
+}
+
+func TestThatNegatedAliasesAreNegated(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("shouting", "uppercase"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validator.RegisterAlias("code", "not_empty,uppercase"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	errs := validator.Validate(&struct {
+		Name string `validate:"!shouting"`
+	}{Name: "ABC"})
+
+	if errs.Length() != 1 || errs[0].Error() != "Name cannot be in upper case." {
+		t.Fatalf("Expected negated message of 'uppercase', got %s.", errs)
+	}
+
+	errs = validator.Validate(&struct {
+		Name string `validate:"!code"`
+	}{Name: "abc"})
+
+	if errs.Length() != 1 || errs[0].IsFieldError() {
+		t.Fatalf("Expected error of alias with more than one validator, got %s.", errs)
+	}
+}
+
+func TestThatCompileFailsForMethodsThatCannotBeNegated(t *testing.T) {
+	for _, value := range []interface{}{
+		&struct {
+			Name string `validate:"!trim"`
+		}{},
+		&struct {
+			Name string `validate:"!default(abc)"`
+		}{},
+	} {
+		if _, err := Compile(value); err == nil {
+			t.Fatalf("Expected error, got nil.")
+		}
+	}
+}
+
+func TestThatExportedRulesIncludeNegations(t *testing.T) {
+	ruleSet, err := ExportRules(&negateDummy{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := "validator_test.negateDummy.Code: !uppercase\nvalidator_test.negateDummy.Role: !one_of(admin,root)"
+
+	if ruleSet.String() != expected {
+		t.Fatalf("Expected rules '%s', got '%s'.", expected, ruleSet)
+	}
+}
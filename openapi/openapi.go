@@ -0,0 +1,171 @@
+// Package openapi generates OpenAPI 3 schema, parameter and request body objects from the validate tags of
+// structures, so that API documentation stays in sync with the validation rules.
+package openapi
+
+import (
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/jsonschema"
+	"reflect"
+)
+
+// SchemaRefPrefix is the prefix of references to schemas of components.
+const SchemaRefPrefix = "#/components/schemas/"
+
+// Components is the components object of an OpenAPI document.
+type Components struct {
+	Schemas map[string]*jsonschema.Schema `json:"schemas,omitempty"`
+}
+
+// Parameter is a parameter object of an operation.
+type Parameter struct {
+	Name     string             `json:"name"`
+	In       string             `json:"in"`
+	Required bool               `json:"required,omitempty"`
+	Schema   *jsonschema.Schema `json:"schema"`
+}
+
+// MediaType is a media type object of a request body.
+type MediaType struct {
+	Schema *jsonschema.Schema `json:"schema"`
+}
+
+// RequestBody is a request body object of an operation.
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Generator generates OpenAPI objects of structs. Struct types are generated into the schemas of the components and
+// referenced where they're used.
+type Generator struct {
+	validator validator.Validator
+	schemas   *jsonschema.Generator
+}
+
+// NewGenerator creates a generator that uses the aliases, rules and validators of v.
+func NewGenerator(v validator.Validator) *Generator {
+	schemas := jsonschema.NewGenerator(&validator.Plan{})
+	schemas.RefPrefix = SchemaRefPrefix
+
+	return &Generator{
+		validator: v,
+		schemas:   schemas,
+	}
+}
+
+// Schema generates the schema of value and returns a reference to it.
+func (this *Generator) Schema(value interface{}) (*jsonschema.Schema, error) {
+	plan, err := this.validator.Compile(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	this.schemas.Include(plan)
+
+	return this.schemas.Generate(reflect.TypeOf(value)), nil
+}
+
+// RequestBody generates a required JSON request body of value.
+func (this *Generator) RequestBody(value interface{}) (*RequestBody, error) {
+	schema, err := this.Schema(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &RequestBody{
+		Required: true,
+		Content: map[string]*MediaType{
+			"application/json": {Schema: schema},
+		},
+	}, nil
+}
+
+// Parameters generates a parameter for each property of value, located in `in`, i.e. `query` or `path`.
+func (this *Generator) Parameters(value interface{}, in string) ([]*Parameter, error) {
+	ref, err := this.Schema(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema := this.resolve(ref)
+	var parameters []*Parameter
+
+	for _, field := range structFields(reflect.TypeOf(value)) {
+		name := jsonschema.PropertyName(field)
+		propertySchema, ok := schema.Properties[name]
+
+		if !ok {
+			continue
+		}
+
+		parameters = append(parameters, &Parameter{
+			Name:     name,
+			In:       in,
+			Required: in == "path" || contains(schema.Required, name),
+			Schema:   propertySchema,
+		})
+	}
+
+	return parameters, nil
+}
+
+// Components returns the components holding the schemas generated so far.
+func (this *Generator) Components() *Components {
+	return &Components{Schemas: this.schemas.Definitions}
+}
+
+// Generate generates the components of values using the default validator.
+func Generate(values ...interface{}) (*Components, error) {
+	return GenerateWith(validator.Default(), values...)
+}
+
+// GenerateWith generates the components of values using the aliases, rules and validators of v.
+func GenerateWith(v validator.Validator, values ...interface{}) (*Components, error) {
+	generator := NewGenerator(v)
+
+	for _, value := range values {
+		if _, err := generator.Schema(value); err != nil {
+			return nil, err
+		}
+	}
+
+	return generator.Components(), nil
+}
+
+func (this *Generator) resolve(schema *jsonschema.Schema) *jsonschema.Schema {
+	if len(schema.Ref) > 0 {
+		return this.schemas.Definitions[schema.Ref[len(SchemaRefPrefix):]]
+	}
+	return schema
+}
+
+// structFields returns the fields of a struct type in declaration order.
+func structFields(reflectedType reflect.Type) []reflect.StructField {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
+
+	if reflectedType.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make([]reflect.StructField, reflectedType.NumField())
+
+	for i := range fields {
+		fields[i] = reflectedType.Field(i)
+	}
+
+	return fields
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
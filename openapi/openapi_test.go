@@ -0,0 +1,97 @@
+package openapi_test
+
+import (
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/openapi"
+	"testing"
+)
+
+type tag struct {
+	Name string `json:"name" validate:"not_empty,max(20)"`
+}
+
+type category struct {
+	Name   string    `json:"name" validate:"not_empty"`
+	Parent *category `json:"parent"`
+	Tags   []tag     `json:"tags" validate:"max(10)"`
+	Meta   struct {
+		Color string `json:"color" validate:"one_of(red,green)"`
+	} `json:"meta"`
+}
+
+type listQuery struct {
+	Search string `json:"search" validate:"max(50)"`
+	Page   int    `json:"page" validate:"not_empty,min(1)"`
+}
+
+func TestThatGenerateProducesComponentsWithNestedObjectsAndArrays(t *testing.T) {
+	components, err := openapi.Generate(&category{}, &listQuery{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(components.Schemas) != 3 {
+		t.Fatalf("Expected 3 schemas, got %d.", len(components.Schemas))
+	}
+
+	schema := components.Schemas["category"]
+
+	if schema == nil || schema.Type != "object" {
+		t.Fatalf("Expected category object schema, got %v.", schema)
+	}
+
+	if ref := schema.Properties["parent"].Ref; ref != "#/components/schemas/category" {
+		t.Fatalf("Expected parent to reference category, got '%s'.", ref)
+	}
+
+	tags := schema.Properties["tags"]
+
+	if tags.Type != "array" || tags.Items.Ref != "#/components/schemas/tag" || *tags.MaxItems != 10 {
+		t.Fatalf("Expected array of tag references, got %s.", tags)
+	}
+
+	if color := schema.Properties["meta"].Properties["color"]; color == nil || len(color.Enum) != 2 {
+		t.Fatalf("Expected inline meta object with color enum, got %s.", schema.Properties["meta"])
+	}
+
+	if name := components.Schemas["tag"].Properties["name"]; *name.MaxLength != 20 {
+		t.Fatalf("Expected tag name to have max length of 20, got %s.", name)
+	}
+}
+
+func TestThatRequestBodyReferencesSchema(t *testing.T) {
+	generator := openapi.NewGenerator(validator.Default())
+
+	body, err := generator.RequestBody(&category{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if !body.Required || body.Content["application/json"].Schema.Ref != "#/components/schemas/category" {
+		t.Fatalf("Expected required JSON body referencing category, got %v.", body)
+	}
+}
+
+func TestThatParametersAreGeneratedFromFields(t *testing.T) {
+	generator := openapi.NewGenerator(validator.Default())
+
+	parameters, err := generator.Parameters(&listQuery{}, "query")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(parameters) != 2 {
+		t.Fatalf("Expected 2 parameters, got %d.", len(parameters))
+	}
+
+	if parameters[0].Name != "search" || parameters[0].Required || *parameters[0].Schema.MaxLength != 50 {
+		t.Fatalf("Expected optional search parameter, got %v.", parameters[0])
+	}
+
+	if parameters[1].Name != "page" || !parameters[1].Required || *parameters[1].Schema.Minimum != 1 {
+		t.Fatalf("Expected required page parameter, got %v.", parameters[1])
+	}
+}
@@ -0,0 +1,186 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+// Option configures a single call to Validate.
+type Option func(*options)
+
+type options struct {
+	translator core.Translator
+	fieldName  string
+	selection  *fieldSelection
+	groups     []string
+	maxErrors  int
+	limits     limits
+	filesystem bool
+	flatten    bool
+	report     *Report
+	workers    int
+
+	// fieldWorkers is the number of fields of a struct that are validated concurrently.
+	fieldWorkers int
+
+	// sampleLimit is the number of errors reported per field path, and sampler is the sampler of a stream.
+	sampleLimit int
+	sampler     *errorSampler
+	errorBudget int
+
+	// overrides holds the rules that replace the rules of fields, by the name of their struct and field.
+	overrides map[string]string
+}
+
+func newOptions(validator *validator, opts []Option) *options {
+	options := &options{
+		translator: validator.locale,
+		fieldName:  "Value",
+	}
+
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+// WithTranslator translates the error messages using the translator instead of the locale of the validator.
+// I.e. a *core.Locale with messages in another language.
+func WithTranslator(translator core.Translator) Option {
+	return func(options *options) {
+		options.translator = translator
+	}
+}
+
+// WithFieldName sets the name used in place of the field name when validating a single value with ValidateValue.
+// Defaults to `Value`.
+func WithFieldName(name string) Option {
+	return func(options *options) {
+		options.fieldName = name
+	}
+}
+
+// Group selects the scenarios to validate, i.e. `Group("create")`. Validator groups that are limited to scenarios
+// with `scenario(create|update)` are only validated when one of their scenarios is selected.
+func Group(names ...string) Option {
+	return func(options *options) {
+		options.groups = append(options.groups, names...)
+	}
+}
+
+// MaxErrors stops validation once n errors have been found. Zero, the default, means no limit.
+func MaxErrors(n int) Option {
+	return func(options *options) {
+		options.maxErrors = n
+	}
+}
+
+// MaxDepth limits the depth of nested structs, arrays, slices and maps to validate, i.e. of trees or of JSON decoded
+// into nested maps. Values nested deeper are not validated, and a *LimitExceededError is added instead. Zero, the
+// default, means no limit. Cycles, such as pointers back to a parent, are never validated more than once on a path
+// regardless of the depth.
+func MaxDepth(n int) Option {
+	return func(options *options) {
+		options.limits.maxDepth = n
+	}
+}
+
+// MaxVisits stops validation once more than n fields and items of collections have been visited in total, and adds a
+// *LimitExceededError. Zero, the default, means no limit.
+func MaxVisits(n int) Option {
+	return func(options *options) {
+		options.limits.maxVisits = n
+	}
+}
+
+// MaxCollectionSize limits the length of arrays, slices and maps whose items are validated. The items of longer
+// collections are not validated, and a *LimitExceededError is added instead. Validators of the collection itself,
+// such as `max(100)`, still run. Zero, the default, means no limit.
+func MaxCollectionSize(n int) Option {
+	return func(options *options) {
+		options.limits.maxCollectionSize = n
+	}
+}
+
+// FailFast stops validation at the first error.
+func FailFast() Option {
+	return MaxErrors(1)
+}
+
+// AllowFilesystem allows validators to access the filesystem, i.e. `file_exists` and `dir_exists`. Validation has
+// no side effects by default.
+func AllowFilesystem() Option {
+	return func(options *options) {
+		options.filesystem = true
+	}
+}
+
+// WithReport fills report with the validated fields of the validation, and the outcome of each of their validators,
+// i.e. to show which constraints of a form are satisfied. The fields are added to any fields already in the report.
+func WithReport(report *Report) Option {
+	return func(options *options) {
+		options.report = report
+	}
+}
+
+// Workers sets the number of records that ValidateStream validates concurrently. Defaults to GOMAXPROCS.
+func Workers(n int) Option {
+	return func(options *options) {
+		options.workers = n
+	}
+}
+
+// ParallelFields validates up to n fields of a struct concurrently, i.e. for structs with many fields and slow
+// validators. The errors are merged in the order in which the fields are declared, so that they're the same as if
+// the fields were validated one by one. Cross-field validators see the struct as it was before its fields were
+// validated, except for fields with default values, transformers or converters, which are validated first. Nested structs
+// are validated one by one by the worker of the field that holds them. Hooks and recorders are called concurrently.
+// Zero or one, the default, validates fields one by one.
+func ParallelFields(n int) Option {
+	return func(options *options) {
+		options.fieldWorkers = n
+	}
+}
+
+// SampleErrors only reports the first n errors of each field path, i.e. to validate a large malformed file. The fields
+// of the items of a collection passed to Validate share their paths, i.e. `[0].Name` and `[1].Name`, as do the fields
+// of the records of ValidateStream. Zero, the default, means no limit.
+func SampleErrors(n int) Option {
+	return func(options *options) {
+		options.sampleLimit = n
+	}
+}
+
+// ErrorBudget stops ValidateStream from reading records once n errors have been reported in total. Records that are
+// already being validated are still reported. Zero, the default, means no limit.
+func ErrorBudget(n int) Option {
+	return func(options *options) {
+		options.errorBudget = n
+	}
+}
+
+// Override replaces the rules of a field for a single validation, i.e. `Override("User.Age", "min(21)")` to tighten or
+// relax the rules of the tag. The field is named by its struct type and name, and rules that are empty leave the field
+// without validators. Invalid rules are reported as an error of the validation.
+func Override(field string, rules string) Option {
+	return func(options *options) {
+		if options.overrides == nil {
+			options.overrides = make(map[string]string)
+		}
+		options.overrides[field] = rules
+	}
+}
+
+// withErrorSampler shares the sampler of a stream between the validations of its records.
+func withErrorSampler(sampler *errorSampler) Option {
+	return func(options *options) {
+		options.sampler = sampler
+	}
+}
+
+// FlattenEmbedded reports the fields of embedded structs by their promoted names, i.e. `Id` instead of `Base.Id`.
+func FlattenEmbedded() Option {
+	return func(options *options) {
+		options.flatten = true
+	}
+}
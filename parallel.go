@@ -0,0 +1,179 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"sync"
+)
+
+// validateFieldsConcurrently validates the fields of a struct with up to fieldWorkers workers. Each field is
+// validated with a fork of the context, and the forks are joined in the order in which the fields are declared, so
+// that the errors are the same as if the fields were validated one by one. Fields that can change the struct, i.e.
+// by a default value, transformer or converter, and embedded structs are validated one by one before the others, so
+// that cross-field validators see a consistent snapshot of the struct. Panics of validators are recovered by the
+// workers and raised again on the calling goroutine, as if the fields were validated one by one.
+func (this *context) validateFieldsConcurrently(source *structSource, sourceStruct reflect.Value, parentField *core.ReflectedField, fields []*core.ReflectedField) {
+	forks := make([]*context, len(fields))
+	visits := this.visits
+
+	var independent []int
+
+	for i, cachedField := range fields {
+		if cachedField.Ignored || parentField != nil && parentField.IsHidden(cachedField.Name) {
+			continue
+		}
+
+		if !cachedField.Embedded && !this.changesStruct(this.fieldOf(cachedField, parentField)) {
+			independent = append(independent, i)
+			continue
+		}
+
+		forks[i] = this.fork()
+
+		if !walkValidateStructField(forks[i], source, sourceStruct, parentField, cachedField) {
+			break
+		}
+	}
+
+	for _, i := range independent {
+		forks[i] = this.fork()
+	}
+
+	source.reset()
+	snapshot := source.get()
+
+	indexes := make(chan int)
+	workers := this.fieldWorkers
+
+	if workers > len(independent) {
+		workers = len(independent)
+	}
+
+	panics := make([]interface{}, len(fields))
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				panics[index] = validateFork(forks[index], &structSource{value: sourceStruct, boxed: snapshot}, sourceStruct, parentField, fields[index])
+			}
+		}()
+	}
+
+	for _, i := range independent {
+		indexes <- i
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	for _, recovered := range panics {
+		if recovered != nil {
+			panic(recovered)
+		}
+	}
+
+	for _, fork := range forks {
+		if fork != nil && !this.join(fork, visits) {
+			return
+		}
+	}
+}
+
+// validateFork validates a field with a fork, and returns the value of a panic of its validators, if any.
+func validateFork(fork *context, source *structSource, sourceStruct reflect.Value, parentField *core.ReflectedField, field *core.ReflectedField) (recovered interface{}) {
+	defer func() {
+		recovered = recover()
+	}()
+
+	walkValidateStructField(fork, source, sourceStruct, parentField, field)
+
+	return nil
+}
+
+// changesStruct checks whether validating a field can change the struct of the field, i.e. by a default value,
+// transformer or converter.
+func (this *context) changesStruct(field *core.ReflectedField) bool {
+	for _, methods := range field.MethodGroups {
+		for _, method := range methods {
+			if method.Name == defaultDirective || this.validator.registry.IsTransformer(method.Name) || this.validator.registry.IsConverter(method.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// fork returns a context that validates a field of the struct on the current path independently of the other fields,
+// until it's joined. Fields of the fork are validated one by one.
+func (this *context) fork() *context {
+	fork := &context{}
+	*fork = *this
+
+	fork.fieldWorkers = 0
+	fork.walking = append(fork.walkingBuffer[:0], this.walking...)
+	fork.structs = nil
+	fork.batches = nil
+	fork.batchIndex = nil
+	fork.errors = nil
+	fork.warnings = 0
+	fork.unsampled = nil
+
+	if this.sampler != nil {
+		fork.unsampled = make(map[*core.Error]bool)
+	}
+
+	if this.report != nil {
+		fork.report = &Report{}
+	}
+
+	return fork
+}
+
+// join adds the sampled errors, pending batches and report of a fork, which was forked when visits fields and items were
+// visited. Returns false if the remaining fields of the struct shouldn't be validated, i.e. because the field of the
+// fork aborted the struct.
+func (this *context) join(fork *context, visits int) bool {
+	for _, err := range fork.errors {
+		if warning, ok := fork.unsampled[err]; ok && len(this.sample(core.ErrorList{err}, warning)) == 0 {
+			if warning {
+				fork.warnings--
+			}
+			continue
+		}
+
+		this.errors.Add(err)
+	}
+
+	this.warnings += fork.warnings
+
+	for _, batch := range fork.batches {
+		for i, field := range batch.fields {
+			this.deferBatch(deferredValue{validate: batch.validate, method: batch.method, value: batch.values[i], report: batch.reports[i]}, field)
+		}
+	}
+
+	if this.report != nil {
+		this.report.Fields = append(this.report.Fields, fork.report.Fields...)
+	}
+
+	this.cancelled = this.cancelled || fork.cancelled
+	this.limitExceeded = this.limitExceeded || fork.limitExceeded
+	this.visits += fork.visits - visits
+
+	if this.limits.maxVisits > 0 && this.visits > this.limits.maxVisits && !this.limitExceeded {
+		this.errors.AddPlain(&LimitExceededError{Limit: VisitLimit, Max: this.limits.maxVisits, Path: pathOf(fork.field)})
+		this.limitExceeded = true
+	}
+
+	if fork.aborting {
+		this.aborting = true
+		return false
+	}
+
+	return !this.isDone()
+}
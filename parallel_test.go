@@ -0,0 +1,154 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type parallelAddress struct {
+	City string `validate:"slow,not_empty"`
+}
+
+type parallelDummy struct {
+	A       string `validate:"slow,not_empty"`
+	B       string `validate:"slow,not_empty"`
+	C       string `validate:"slow,min(3)"`
+	D       string `validate:"slow,not_empty"`
+	Address parallelAddress
+	Items   []parallelAddress
+}
+
+// newParallelValidator returns a validator with a slow validator, which records the maximum number of validators
+// that ran at the same time.
+func newParallelValidator(concurrent *int32) Validator {
+	var running int32
+
+	validator := New()
+	validator.Register("slow", func(context core.ValidatorContext, args []interface{}) error {
+		count := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			max := atomic.LoadInt32(concurrent)
+
+			if count <= max || atomic.CompareAndSwapInt32(concurrent, max, count) {
+				break
+			}
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+
+	return validator
+}
+
+func TestThatParallelFieldsAreValidatedConcurrentlyWithSameErrors(t *testing.T) {
+	var concurrent int32
+	validator := newParallelValidator(&concurrent)
+	value := &parallelDummy{B: "b", C: "c", Items: []parallelAddress{{"x"}, {}}}
+
+	expected := validator.Validate(value)
+	errs := validator.Validate(value, ParallelFields(4))
+
+	if errs.Error() != expected.Error() {
+		t.Fatalf("Expected errors '%s', got '%s'.", expected, errs)
+	}
+
+	if concurrent < 2 {
+		t.Fatalf("Expected fields to be validated concurrently, got %d at a time.", concurrent)
+	}
+}
+
+func TestThatParallelFieldsHonorAbortStruct(t *testing.T) {
+	errs := newSkippingValidator().Validate(&abortDummy{}, ParallelFields(3))
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Email" {
+		t.Fatalf("Expected error of field 'Email', got %s.", errs)
+	}
+
+	type Dummy struct {
+		Name  string `validate:"not_empty"`
+		Code  string `validate:"abort"`
+		Email string `validate:"not_empty"`
+	}
+
+	errs = newSkippingValidator().Validate(&Dummy{}, ParallelFields(3))
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Name" {
+		t.Fatalf("Expected error of field 'Name', got %s.", errs)
+	}
+}
+
+func TestThatParallelFieldsSeeTransformedSiblings(t *testing.T) {
+	type Dummy struct {
+		Confirmation string `validate:"eqfield(Password)"`
+		Password     string `validate:"trim"`
+	}
+
+	if errs := Validate(&Dummy{Confirmation: "secret", Password: " secret "}, ParallelFields(2)); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+}
+
+func TestThatParallelFieldsMergeReportsAndLimitErrors(t *testing.T) {
+	var concurrent int32
+	validator := newParallelValidator(&concurrent)
+	expectedReport, report := &Report{}, &Report{}
+
+	expected := validator.Validate(&parallelDummy{}, MaxErrors(2), WithReport(expectedReport))
+	errs := validator.Validate(&parallelDummy{}, MaxErrors(2), WithReport(report), ParallelFields(4))
+
+	if errs.Error() != expected.Error() {
+		t.Fatalf("Expected errors '%s', got '%s'.", expected, errs)
+	}
+
+	if len(report.Fields) != len(expectedReport.Fields) {
+		t.Fatalf("Expected %d reported fields, got %d.", len(expectedReport.Fields), len(report.Fields))
+	}
+
+	for i, field := range report.Fields {
+		if field.Name != expectedReport.Fields[i].Name {
+			t.Fatalf("Expected report of '%s', got '%s'.", expectedReport.Fields[i].Name, field.Name)
+		}
+	}
+}
+
+func TestThatParallelFieldsSampleErrorsInDeclarationOrder(t *testing.T) {
+	var concurrent int32
+	validator := newParallelValidator(&concurrent)
+	values := []parallelDummy{{}, {}, {Items: []parallelAddress{{}, {}}}}
+
+	expected := validator.Validate(values, SampleErrors(1))
+
+	for i := 0; i < 10; i++ {
+		if errs := validator.Validate(values, SampleErrors(1), ParallelFields(4)); errs.Error() != expected.Error() {
+			t.Fatalf("Expected errors '%s', got '%s'.", expected, errs)
+		}
+	}
+}
+
+func TestThatParallelFieldsRaisePanicsOfValidatorsOnCallingGoroutine(t *testing.T) {
+	validator := New()
+	validator.Register("panic", func(context core.ValidatorContext, args []interface{}) error {
+		panic("validator panicked")
+	})
+
+	type Dummy struct {
+		A string `validate:"not_empty"`
+		B string `validate:"panic"`
+		C string `validate:"not_empty"`
+	}
+
+	defer func() {
+		if recovered := recover(); recovered != "validator panicked" {
+			t.Fatalf("Expected panic of validator, got %v.", recovered)
+		}
+	}()
+
+	validator.Validate(&Dummy{}, ParallelFields(2))
+	t.Fatal("Expected panic, didn't get any.")
+}
@@ -0,0 +1,258 @@
+// Package playground parses tags in the syntax of go-playground/validator, i.e. `required,gte=1,oneof=a b c`, so that
+// codebases can migrate without rewriting their tags. The validators of a tag are translated into validators of this
+// package by a table of rules, which can be extended with Register.
+//
+// Use it as the parser of a tag, i.e. `validator.New(validator.WithTagParser("validate", playground.Parse))`.
+package playground
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core/parser"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Rule translates a validator of go-playground/validator with its parameter, i.e. `gte` with `1` of `gte=1`, into
+// validators of this package. The parameter is empty if the validator has none.
+type Rule func(param string) (parser.Methods, error)
+
+// Syntax translates tags of go-playground/validator by a table of rules. It's safe for concurrent use.
+type Syntax struct {
+	rules map[string]Rule
+	lock  sync.RWMutex
+}
+
+// New creates a syntax with the default rules.
+func New() *Syntax {
+	syntax := &Syntax{rules: make(map[string]Rule)}
+
+	for name, rule := range defaultRules {
+		syntax.rules[name] = rule
+	}
+
+	return syntax
+}
+
+// Register registers the rule of a validator by name, replacing the default rule if there is one.
+func (this *Syntax) Register(name string, rule Rule) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.rules[name] = rule
+}
+
+func (this *Syntax) getRule(name string) (Rule, bool) {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	rule, ok := this.rules[name]
+	return rule, ok
+}
+
+// Parse translates a tag into validator groups. Validators separated by `,` are all required to pass, and
+// alternatives separated by `|`, i.e. `rgb|rgba`, are translated into alternative groups. `omitempty` and `omitnil`
+// make the field optional by adding an `empty` or `nil` group. Returns error if a validator has no rule.
+func (this *Syntax) Parse(tag string) ([]parser.Methods, error) {
+	methodGroups := []parser.Methods{nil}
+
+	if len(tag) == 0 || tag == "-" {
+		return methodGroups, nil
+	}
+
+	var optional string
+
+	for _, item := range strings.Split(tag, ",") {
+		switch item {
+		case "omitempty":
+			optional = "empty"
+			continue
+		case "omitnil":
+			optional = "nil"
+			continue
+		}
+
+		var alternatives []parser.Methods
+
+		for _, alternative := range strings.Split(item, "|") {
+			name, param := alternative, ""
+
+			if index := strings.Index(alternative, "="); index >= 0 {
+				name, param = alternative[:index], unescape(alternative[index+1:])
+			}
+
+			rule, ok := this.getRule(name)
+
+			if !ok {
+				return nil, errors.New("Validator '" + name + "' of go-playground/validator is not supported.")
+			}
+
+			methods, err := rule(param)
+
+			if err != nil {
+				return nil, errors.New("Unable to translate validator '" + name + "'. " + err.Error())
+			}
+
+			alternatives = append(alternatives, methods)
+		}
+
+		methodGroups = combine(methodGroups, alternatives)
+	}
+
+	if len(optional) > 0 {
+		methodGroups = append([]parser.Methods{{&parser.Method{Name: optional}}}, methodGroups...)
+	}
+
+	return methodGroups, nil
+}
+
+// combine requires one of the alternatives to pass in addition to each group.
+func combine(methodGroups []parser.Methods, alternatives []parser.Methods) []parser.Methods {
+	var combinedGroups []parser.Methods
+
+	for _, methods := range methodGroups {
+		for _, alternative := range alternatives {
+			combined := make(parser.Methods, 0, len(methods)+len(alternative))
+			combined = append(combined, methods...)
+			combined = append(combined, alternative...)
+			combinedGroups = append(combinedGroups, combined)
+		}
+	}
+
+	return combinedGroups
+}
+
+// unescape replaces the escape sequences of commas and pipes in parameters.
+func unescape(param string) string {
+	return strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+}
+
+var defaultSyntax = New()
+
+// Register registers the rule of a validator by name on the default syntax.
+func Register(name string, rule Rule) {
+	defaultSyntax.Register(name, rule)
+}
+
+// Parse translates a tag into validator groups with the default syntax. It's a core.TagParser.
+func Parse(tag string) ([]parser.Methods, error) {
+	return defaultSyntax.Parse(tag)
+}
+
+// Rename translates a validator without parameter into the validator with name, i.e. `hexadecimal` into `hex`.
+func Rename(name string) Rule {
+	return func(param string) (parser.Methods, error) {
+		if len(param) > 0 {
+			return nil, errors.New("Validator does not support a parameter.")
+		}
+		return parser.Methods{{Name: name}}, nil
+	}
+}
+
+// WithString translates a validator into the validator with name, with the parameter as a string argument,
+// i.e. `contains=@` into `contain(@)`.
+func WithString(name string) Rule {
+	return func(param string) (parser.Methods, error) {
+		if len(param) == 0 {
+			return nil, errors.New("Validator requires a parameter.")
+		}
+		return parser.Methods{{Name: name, Arguments: parser.Arguments{param}}}, nil
+	}
+}
+
+// WithNumber translates a validator into the validator with name, with the parameter as an argument that is a
+// number if it can be parsed as one, i.e. `gte=1` into `min(1)`.
+func WithNumber(name string) Rule {
+	return func(param string) (parser.Methods, error) {
+		if len(param) == 0 {
+			return nil, errors.New("Validator requires a parameter.")
+		}
+		return parser.Methods{{Name: name, Arguments: parser.Arguments{argument(param)}}}, nil
+	}
+}
+
+// WithList translates a validator into the validator with name, with the space separated values of the parameter as
+// arguments, i.e. `oneof=a b c` into `one_of(a,b,c)`.
+func WithList(name string) Rule {
+	return func(param string) (parser.Methods, error) {
+		values := strings.Fields(param)
+
+		if len(values) == 0 {
+			return nil, errors.New("Validator requires a parameter.")
+		}
+
+		args := make(parser.Arguments, len(values))
+
+		for i, value := range values {
+			args[i] = argument(value)
+		}
+
+		return parser.Methods{{Name: name, Arguments: args}}, nil
+	}
+}
+
+// Ignore translates a validator into no validators, i.e. for validators that only affect go-playground/validator.
+func Ignore() Rule {
+	return func(param string) (parser.Methods, error) {
+		return nil, nil
+	}
+}
+
+// argument converts a parameter into a number if it can be parsed as one, like arguments of tags of this package.
+func argument(param string) interface{} {
+	if number, err := strconv.ParseFloat(param, 64); err == nil {
+		return number
+	}
+	return param
+}
+
+// defaultRules translates the validators of go-playground/validator that have a counterpart in this package. Note
+// that `required` is translated to `not_empty`, which also fails pointers to zero values.
+var defaultRules = map[string]Rule{
+	"required":           Rename("not_empty"),
+	"isdefault":          Rename("empty"),
+	"len":                WithNumber("length"),
+	"min":                WithNumber("min"),
+	"max":                WithNumber("max"),
+	"gte":                WithNumber("min"),
+	"lte":                WithNumber("max"),
+	"eq":                 WithString("equal"),
+	"ne":                 WithNumber("not"),
+	"oneof":              WithList("one_of"),
+	"contains":           WithString("contain"),
+	"excludes":           WithString("excludes"),
+	"startswith":         WithString("starts_with"),
+	"endswith":           WithString("ends_with"),
+	"alphaunicode":       Rename("alpha"),
+	"alphanumunicode":    Rename("alphanum"),
+	"ascii":              Rename("ascii"),
+	"iso3166_1_alpha2":   Rename("iso3166"),
+	"iso4217":            Rename("iso4217"),
+	"bcp47_language_tag": Rename("bcp47"),
+	"timezone":           Rename("timezone"),
+	"eqfield":            WithString("eqfield"),
+	"nefield":            WithString("nefield"),
+	"gtfield":            WithString("gtfield"),
+	"gtefield":           WithString("gtefield"),
+	"ltfield":            WithString("ltfield"),
+	"ltefield":           WithString("ltefield"),
+	"required_if":        WithList("required_if"),
+	"required_unless":    WithList("required_unless"),
+	"email":              Rename("email"),
+	"url":                Rename("url"),
+	"uri":                Rename("url"),
+	"uuid":               Rename("uuid"),
+	"ip":                 Rename("ip"),
+	"ipv4":               Rename("ipv4"),
+	"ipv6":               Rename("ipv6"),
+	"cidr":               Rename("cidr"),
+	"mac":                Rename("mac"),
+	"hostname_rfc1123":   Rename("hostname"),
+	"fqdn":               Rename("fqdn"),
+	"semver":             Rename("semver"),
+	"numeric":            Rename("numeric"),
+	"hexadecimal":        Rename("hex"),
+	"base64":             Rename("base64"),
+	"json":               Rename("json"),
+	"lowercase":          Rename("lowercase"),
+	"uppercase":          Rename("uppercase"),
+	"credit_card":        Rename("creditcard"),
+}
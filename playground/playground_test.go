@@ -0,0 +1,106 @@
+package playground_test
+
+import (
+	"github.com/typerandom/validator"
+	"github.com/typerandom/validator/core/parser"
+	"github.com/typerandom/validator/playground"
+	"testing"
+)
+
+func TestThatParseTranslatesValidators(t *testing.T) {
+	methodGroups, err := playground.Parse("required,gte=1,lte=10,oneof=a b c")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	expected := "{ name: 'not_empty', args: (none) }, { name: 'min', args: 1 }, { name: 'max', args: 10 }, " +
+		"{ name: 'one_of', args: 'a', 'b', 'c' }"
+
+	if len(methodGroups) != 1 || methodGroups[0].String() != expected {
+		t.Fatalf("Expected '%s', got %v.", expected, methodGroups)
+	}
+}
+
+func TestThatParseTranslatesOmitemptyAndAlternatives(t *testing.T) {
+	methodGroups, err := playground.Parse("omitempty,email|uuid")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(methodGroups) != 3 {
+		t.Fatalf("Expected 3 groups, got %d.", len(methodGroups))
+	}
+
+	for i, name := range []string{"empty", "email", "uuid"} {
+		if methodGroups[i][0].Name != name {
+			t.Fatalf("Expected group %d to be '%s', got '%s'.", i, name, methodGroups[i][0].Name)
+		}
+	}
+}
+
+func TestThatParseUnescapesParameters(t *testing.T) {
+	methodGroups, err := playground.Parse("contains=0x2C")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if arg := methodGroups[0][0].Arguments[0]; arg != "," {
+		t.Fatalf("Expected ',', got '%v'.", arg)
+	}
+}
+
+func TestThatParseFailsForUnsupportedValidators(t *testing.T) {
+	if _, err := playground.Parse("required,dive"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if _, err := playground.Parse("email=abc"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatSyntaxRulesCanBeRegistered(t *testing.T) {
+	syntax := playground.New()
+	syntax.Register("dive", playground.Ignore())
+	syntax.Register("alpha", func(param string) (parser.Methods, error) {
+		return parser.Methods{{Name: "regexp", Arguments: parser.Arguments{"^[a-zA-Z]*$"}}}, nil
+	})
+
+	methodGroups, err := syntax.Parse("dive,alpha")
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(methodGroups[0]) != 1 || methodGroups[0][0].Name != "regexp" {
+		t.Fatalf("Expected a single 'regexp' validator, got %v.", methodGroups)
+	}
+
+	if _, err := playground.Parse("alpha"); err == nil {
+		t.Fatal("Expected rules of a syntax not to affect the default syntax, but they did.")
+	}
+}
+
+func TestThatValidatorCanUseTagsOfGoPlayground(t *testing.T) {
+	type User struct {
+		Name  string `validate:"required,min=2,max=50"`
+		Age   int    `validate:"gte=18,lte=130"`
+		Role  string `validate:"oneof=admin user"`
+		Email string `validate:"omitempty,email"`
+	}
+
+	v := validator.New(validator.WithTagParser("validate", playground.Parse))
+
+	if errs := v.Validate(&User{Name: "John", Age: 30, Role: "admin"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := v.Validate(&User{Name: "J", Age: 12, Role: "guest", Email: "john"})
+
+	if errs.Length() != 4 {
+		t.Fatalf("Expected 4 errors, got %d.", errs.Length())
+	}
+}
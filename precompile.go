@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+	"reflect"
+	"strconv"
+)
+
+// Plan is the compiled validation plan of a struct type and the struct types it contains.
+type Plan struct {
+	Types []*CompiledType
+}
+
+// CompiledType holds the compiled fields of a struct type.
+type CompiledType struct {
+	Type   reflect.Type
+	Fields []*CompiledField
+}
+
+// CompiledField holds the resolved validator groups of a field.
+type CompiledField struct {
+	Field  *core.ReflectedField
+	Groups [][]*CompiledValidator
+}
+
+// CompiledValidator is a validator method of a tag, resolved from the registry. Validate is nil for directives,
+// i.e. `scenario` and `default`, which are handled during validation.
+type CompiledValidator struct {
+	Method   *parser.Method
+	Validate core.ValidatorFn
+}
+
+// Type returns the compiled type of reflectedType, or nil if it's not part of the plan.
+func (this *Plan) Type(reflectedType reflect.Type) *CompiledType {
+	for _, compiledType := range this.Types {
+		if compiledType.Type == reflectedType {
+			return compiledType
+		}
+	}
+	return nil
+}
+
+func (this *validator) Compile(value interface{}) (*Plan, error) {
+	if err := core.CheckInputType(reflect.TypeOf(value)); err != nil {
+		return nil, err
+	}
+
+	this.lock.RLock()
+	fieldCache := this.fieldCache
+	this.lock.RUnlock()
+
+	plan := &Plan{}
+	var errs core.ErrorList
+
+	compileType(this, fieldCache, reflect.TypeOf(value), nil, plan, &errs)
+
+	if errs.Any() {
+		return nil, errs
+	}
+
+	return plan, nil
+}
+
+func (this *validator) Precompile(value interface{}) error {
+	_, err := this.Compile(value)
+	return err
+}
+
+// compileType caches the fields of struct types and resolves their validators. Arrays, slices, maps and pointers
+// are compiled by their element types. Each type is only compiled once.
+func compileType(validator *validator, fieldCache *core.FieldCache, reflectedType reflect.Type, parentField *core.ReflectedField, plan *Plan, errs *core.ErrorList) {
+	if reflectedType == nil {
+		return
+	}
+
+	switch reflectedType.Kind() {
+	case reflect.Ptr, reflect.Array, reflect.Slice, reflect.Map:
+		compileType(validator, fieldCache, reflectedType.Elem(), parentField, plan, errs)
+		return
+	case reflect.Struct:
+	default:
+		return
+	}
+
+	if plan.Type(reflectedType) != nil {
+		return
+	}
+
+	compiledType := &CompiledType{Type: reflectedType}
+	plan.Types = append(plan.Types, compiledType)
+
+	fields, err := fieldCache.GetStructFields(reflect.Zero(reflectedType).Interface())
+
+	if err != nil {
+		errs.AddPlain(errors.New("Unable to compile '" + reflectedType.String() + "'. " + err.Error()))
+		return
+	}
+
+	for _, cachedField := range fields {
+		if cachedField.Ignored {
+			continue
+		}
+
+		field := &core.ReflectedField{}
+		*field = *cachedField
+		field.Parent = parentField
+
+		compiledField := &CompiledField{Field: cachedField}
+
+		for _, methods := range field.MethodGroups {
+			var group []*CompiledValidator
+
+			for _, method := range methods {
+				validate, err := compileMethod(validator, method)
+
+				if err != nil {
+					errs.AddPlain(errors.New("Unable to compile field '" + field.FullName() + "' of '" + reflectedType.String() + "'. " + err.Error()))
+					continue
+				}
+
+				group = append(group, &CompiledValidator{Method: method, Validate: validate})
+			}
+
+			compiledField.Groups = append(compiledField.Groups, group)
+		}
+
+		compiledType.Fields = append(compiledType.Fields, compiledField)
+
+		compileType(validator, fieldCache, reflectedType.Field(field.Index).Type, field, plan, errs)
+	}
+}
+
+// compileMethod resolves the validator of a method, and checks the arguments of directives and whether negated
+// methods can be negated. The arguments of validators are checked by their schemas when the fields are cached.
+func compileMethod(validator *validator, method *parser.Method) (core.ValidatorFn, error) {
+	if method.Negated && isDirective(method.Name) {
+		return nil, errors.New("Directive '" + method.Name + "' cannot be negated.")
+	}
+
+	switch method.Name {
+	case scenarioDirective:
+		if len(method.Arguments) == 0 {
+			return nil, errors.New("Directive '" + method.Name + "' requires at least one argument.")
+		}
+		return nil, nil
+	case defaultDirective, core.LabelDirective:
+		if len(method.Arguments) != 1 {
+			return nil, errors.New("Directive '" + method.Name + "' requires a single argument, got " + strconv.Itoa(len(method.Arguments)) + ".")
+		}
+		return nil, nil
+	}
+
+	validate, err := validator.registry.Get(method.Name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if method.Negated {
+		if err := validator.registry.CheckNegation(method.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return validate, nil
+}
@@ -0,0 +1,175 @@
+package validator_test
+
+import (
+	"encoding/json"
+	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/validators"
+	"strings"
+	"testing"
+)
+
+type precompileAddress struct {
+	City string `validate:"mn(5)"`
+}
+
+type precompileUser struct {
+	Name      string `validate:"not_empty,min(3)|scenario(create),default(x)"`
+	Addresses []*precompileAddress
+}
+
+func TestThatPrecompileSucceedsForRegisteredValidators(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty,scenario(create),default(x)"`
+	}
+
+	if err := Precompile(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatPrecompileFailsForUnknownValidatorsOfNestedTypes(t *testing.T) {
+	err := Precompile(&precompileUser{})
+
+	if err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if !strings.Contains(err.Error(), "Addresses.City") || !strings.Contains(err.Error(), "'mn'") {
+		t.Fatalf("Expected error for unknown validator 'mn' of 'Addresses.City', got '%s'.", err)
+	}
+}
+
+func TestThatCompileFailsForYamlUntilItIsRegistered(t *testing.T) {
+	type Dummy struct {
+		Config string `validate:"yaml"`
+	}
+
+	_, err := Compile(&Dummy{})
+
+	if err == nil || !strings.Contains(err.Error(), "Register YamlValidator") {
+		t.Fatalf("Expected error on how to register 'yaml', got %v.", err)
+	}
+
+	validator := New()
+	validator.Register("yaml", validators.YamlValidator(json.Unmarshal))
+
+	if _, err := validator.Compile(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatPrecompileFailsForInvalidSyntax(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"min(5"`
+	}
+
+	if err := Precompile(Dummy{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatCheckSyntaxChecksValidateTags(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"min(5"`
+	}
+
+	if err := CheckSyntax(&Dummy{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatPrecompileSupportsRecursiveTypes(t *testing.T) {
+	type Node struct {
+		Name     string `validate:"not_empty"`
+		Children []Node
+	}
+
+	if err := Precompile(&Node{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatCompileResolvesValidators(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty,min(3)|empty"`
+	}
+
+	plan, err := Compile(&Dummy{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if len(plan.Types) != 1 || len(plan.Types[0].Fields) != 1 {
+		t.Fatalf("Expected plan of 1 type with 1 field, got %+v.", plan)
+	}
+
+	groups := plan.Types[0].Fields[0].Groups
+
+	if len(groups) != 2 || len(groups[0]) != 2 || groups[0][1].Method.Name != "min" || groups[0][1].Validate == nil {
+		t.Fatalf("Expected resolved validator groups, got %+v.", groups)
+	}
+}
+
+func TestThatCompileChecksArgumentsOfDirectives(t *testing.T) {
+	type Dummy struct {
+		Limit int `validate:"default(1,2)"`
+	}
+
+	if _, err := Compile(&Dummy{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatCompileChecksArgumentsOfValidatorsWithSchemas(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"match(a**)"`
+	}
+
+	if _, err := Compile(&Dummy{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatCompileChecksArgumentsOfDefaultValidators(t *testing.T) {
+	for _, value := range []interface{}{
+		&struct {
+			Age int `validate:"min"`
+		}{},
+		&struct {
+			Age int `validate:"max(1,2)"`
+		}{},
+		&struct {
+			Age int `validate:"between(1)"`
+		}{},
+		&struct {
+			Role string `validate:"one_of"`
+		}{},
+		&struct {
+			Role string `validate:"equal(a,b)"`
+		}{},
+		&struct {
+			Age int `validate:"gt(abc)"`
+		}{},
+		&struct {
+			Name string `validate:"email(dns,mx)"`
+		}{},
+		&struct {
+			Name string `validate:"not_empty(1)"`
+		}{},
+	} {
+		if _, err := Compile(value); err == nil {
+			t.Fatalf("Expected error for %T, didn't get any.", value)
+		}
+	}
+
+	type Dummy struct {
+		Age      int    `validate:"min(1),max(9007199254740993),between(1,100),gt(0),one_of(1,2,3)"`
+		Role     string `validate:"equal(admin),contains(a),required_if(Age,1)"`
+		Birthday string `validate:"time(DateOnly)"`
+	}
+
+	if _, err := Compile(&Dummy{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
@@ -0,0 +1,158 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
+)
+
+// ValidatorStatus is the outcome of a validator of a field.
+type ValidatorStatus int
+
+const (
+	// ValidatorPassed means that the validator ran without error.
+	ValidatorPassed ValidatorStatus = iota
+
+	// ValidatorFailed means that the validator ran and returned an error or a warning.
+	ValidatorFailed
+
+	// ValidatorSkipped means that the validator didn't run, i.e. because the group before it passed, as `empty` of
+	// `empty|email`, or because its group is limited to another scenario.
+	ValidatorSkipped
+)
+
+func (this ValidatorStatus) String() string {
+	switch this {
+	case ValidatorPassed:
+		return "passed"
+	case ValidatorFailed:
+		return "failed"
+	default:
+		return "skipped"
+	}
+}
+
+// ValidatorReport is the outcome of a validator of a field.
+type ValidatorReport struct {
+	// Name is the name of the validator, i.e. `min`.
+	Name string
+
+	// Group is the index of the group of the validator, i.e. 1 for `email` of `empty|email`.
+	Group int
+
+	Status ValidatorStatus
+
+	// Error is the error of a failed validator.
+	Error *core.Error
+
+	method *parser.Method
+}
+
+// fail marks the validator as failed with err. Does nothing for nil reports, i.e. if no report was requested.
+func (this *ValidatorReport) fail(err *core.Error) {
+	if this != nil {
+		this.Status = ValidatorFailed
+		this.Error = err
+	}
+}
+
+func (this *ValidatorReport) skip() {
+	if this != nil {
+		this.Status = ValidatorSkipped
+	}
+}
+
+func (this *ValidatorReport) pass() {
+	if this != nil {
+		this.Status = ValidatorPassed
+	}
+}
+
+// FieldReport lists the validators of a field in the order of its tag.
+type FieldReport struct {
+	Name        string
+	DisplayName string
+	Validators  []*ValidatorReport
+}
+
+// Passed checks whether the field is valid, that is if none of the validators of the group that was reported failed
+// with an error. Warnings don't fail a field.
+func (this *FieldReport) Passed() bool {
+	group := this.reportedGroup()
+
+	for _, validator := range this.Validators {
+		if validator.Group == group && validator.Status == ValidatorFailed && !validator.Error.IsWarning() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// reportedGroup returns the group of the field that was reported, the group that passed or else the last group
+// that ran.
+func (this *FieldReport) reportedGroup() int {
+	group := -1
+
+	for _, validator := range this.Validators {
+		if validator.Status == ValidatorSkipped {
+			continue
+		}
+
+		if validator.Group > group {
+			group = validator.Group
+		}
+	}
+
+	return group
+}
+
+// Report lists the validated fields of a validation, with the outcome of each of their validators.
+type Report struct {
+	Fields []*FieldReport
+}
+
+// Field returns the report of a field by its full name, i.e. `Address.City`, or nil if the field wasn't validated.
+func (this *Report) Field(name string) *FieldReport {
+	for _, field := range this.Fields {
+		if field.Name == name {
+			return field
+		}
+	}
+	return nil
+}
+
+// addField adds the report of a field, given the validators that ran. Validators that didn't run are skipped.
+func (this *Report) addField(field *core.ReflectedField, ran []*ValidatorReport) {
+	fieldReport := &FieldReport{
+		Name:        field.FullName(),
+		DisplayName: field.FullDisplayName(),
+	}
+
+	for group, methods := range field.MethodGroups {
+		for _, method := range methods {
+			if isDirective(method.Name) {
+				continue
+			}
+
+			validatorReport := findValidatorReport(ran, method)
+
+			if validatorReport == nil {
+				validatorReport = &ValidatorReport{Name: method.Name, Status: ValidatorSkipped, method: method}
+			}
+
+			validatorReport.Group = group
+			fieldReport.Validators = append(fieldReport.Validators, validatorReport)
+		}
+	}
+
+	this.Fields = append(this.Fields, fieldReport)
+}
+
+func findValidatorReport(reports []*ValidatorReport, method *parser.Method) *ValidatorReport {
+	for _, report := range reports {
+		if report.method == method {
+			return report
+		}
+	}
+	return nil
+}
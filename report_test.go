@@ -0,0 +1,132 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"testing"
+)
+
+func TestThatReportListsValidatorsOfFields(t *testing.T) {
+	type Dummy struct {
+		Name  string `validate:"not_empty,min(3),max(5)"`
+		Email string `validate:"empty|email"`
+		Notes string
+	}
+
+	var report Report
+
+	errs := Validate(&Dummy{Name: "Johnny"}, WithReport(&report))
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if len(report.Fields) != 3 {
+		t.Fatalf("Expected 3 fields, got %d.", len(report.Fields))
+	}
+
+	if notes := report.Field("Notes"); notes == nil || len(notes.Validators) != 0 || !notes.Passed() {
+		t.Fatal("Expected field 'Notes' to be reported as passed without validators, but it wasn't.")
+	}
+
+	name := report.Field("Name")
+
+	if name == nil || name.Passed() {
+		t.Fatal("Expected field 'Name' to be reported as failed, but it wasn't.")
+	}
+
+	expected := []ValidatorStatus{ValidatorPassed, ValidatorPassed, ValidatorFailed}
+
+	for i, validator := range name.Validators {
+		if validator.Status != expected[i] {
+			t.Fatalf("Expected '%s' to have status '%s', got '%s'.", validator.Name, expected[i], validator.Status)
+		}
+	}
+
+	if name.Validators[2].Error != errs.First() {
+		t.Fatalf("Expected error of 'max' to be reported, got %v.", name.Validators[2].Error)
+	}
+}
+
+func TestThatReportSkipsValidatorsOfGroupsThatDidntRun(t *testing.T) {
+	type Dummy struct {
+		Email string `validate:"empty|email"`
+	}
+
+	var report Report
+
+	if errs := Validate(&Dummy{}, WithReport(&report)); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	email := report.Field("Email")
+
+	if !email.Passed() {
+		t.Fatal("Expected field 'Email' to be reported as passed, but it wasn't.")
+	}
+
+	if email.Validators[0].Status != ValidatorPassed || email.Validators[1].Status != ValidatorSkipped {
+		t.Fatalf("Expected 'empty' to pass and 'email' to be skipped, got '%s' and '%s'.", email.Validators[0].Status, email.Validators[1].Status)
+	}
+
+	if email.Validators[1].Group != 1 {
+		t.Fatalf("Expected 'email' to be in group 1, got %d.", email.Validators[1].Group)
+	}
+}
+
+func TestThatReportPassesFieldsWhenALaterGroupPasses(t *testing.T) {
+	type Dummy struct {
+		Email string `validate:"empty|email"`
+	}
+
+	var report Report
+
+	if errs := Validate(&Dummy{Email: "john@doe.com"}, WithReport(&report)); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	email := report.Field("Email")
+
+	if !email.Passed() {
+		t.Fatal("Expected field 'Email' to be reported as passed, but it wasn't.")
+	}
+
+	if email.Validators[0].Status != ValidatorFailed || email.Validators[1].Status != ValidatorPassed {
+		t.Fatalf("Expected 'empty' to fail and 'email' to pass, got '%s' and '%s'.", email.Validators[0].Status, email.Validators[1].Status)
+	}
+}
+
+func TestThatReportSkipsValidatorsOfInactiveScenarios(t *testing.T) {
+	type Dummy struct {
+		Password string `validate:"scenario(create),not_empty"`
+	}
+
+	var report Report
+
+	if errs := Validate(&Dummy{}, WithReport(&report)); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	password := report.Field("Password")
+
+	if len(password.Validators) != 1 || password.Validators[0].Status != ValidatorSkipped {
+		t.Fatalf("Expected 'not_empty' to be skipped, got %d validators.", len(password.Validators))
+	}
+}
+
+func TestThatReportNamesNestedFields(t *testing.T) {
+	type Address struct {
+		City string `validate:"not_empty"`
+	}
+
+	type Dummy struct {
+		Address Address
+	}
+
+	var report Report
+
+	Validate(&Dummy{}, WithReport(&report))
+
+	if report.Field("Address.City") == nil {
+		t.Fatal("Expected field 'Address.City' to be reported, but it wasn't.")
+	}
+}
@@ -0,0 +1,122 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/typerandom/validator/core/parser"
+	"github.com/typerandom/validator/validators"
+	"reflect"
+	"strings"
+)
+
+// RuleBuilder registers rules for the fields of a struct type, for types that cannot carry tags (i.e. generated or
+// third-party types). The rules are merged with any tag rules of the fields, and both are required to pass.
+type RuleBuilder struct {
+	validator *validator
+	value     interface{}
+}
+
+func (this *validator) Rules(value interface{}) *RuleBuilder {
+	return &RuleBuilder{
+		validator: this,
+		value:     value,
+	}
+}
+
+// Field registers rules for a field by name, i.e. `Field("Name", "not_empty", "min(3)")`. Rules that are invalid, or
+// fields that doesn't exist, result in an error when the type is validated.
+func (this *RuleBuilder) Field(name string, rules ...string) *RuleBuilder {
+	this.validator.registry.RegisterFieldRules(this.value, name, strings.Join(rules, ","))
+
+	this.validator.invalidateFieldCache()
+
+	return this
+}
+
+func (this *validator) LoadRules(data []byte, types ...interface{}) error {
+	return this.LoadRulesWith(data, json.Unmarshal, types...)
+}
+
+func (this *validator) LoadRulesWith(data []byte, unmarshal validators.UnmarshalFn, types ...interface{}) error {
+	var ruleFile map[string]map[string]interface{}
+
+	if err := unmarshal(data, &ruleFile); err != nil {
+		return errors.New("Unable to load rules. " + err.Error())
+	}
+
+	typesByName := make(map[string]interface{}, len(types))
+
+	for _, value := range types {
+		typesByName[reflect.Indirect(reflect.ValueOf(value)).Type().Name()] = value
+	}
+
+	// All rules are checked before any of them are registered, so that invalid files don't register some of them.
+	type fieldRules struct {
+		value interface{}
+		field string
+		rules string
+	}
+
+	var loaded []fieldRules
+
+	for typeName, fields := range ruleFile {
+		value, ok := typesByName[typeName]
+
+		if !ok {
+			return errors.New("Unable to load rules of type '" + typeName + "'. Type is not registered.")
+		}
+
+		structType := reflect.Indirect(reflect.ValueOf(value)).Type()
+
+		for fieldName, fieldValue := range fields {
+			if field, ok := structType.FieldByName(fieldName); !ok || len(field.PkgPath) > 0 {
+				return errors.New("Unable to load rules of field '" + typeName + "." + fieldName + "'. Field does not exist.")
+			}
+
+			rules, err := ruleStrings(fieldValue)
+
+			if err != nil {
+				return errors.New("Unable to load rules of field '" + typeName + "." + fieldName + "'. " + err.Error())
+			}
+
+			if _, err := parser.Parse(rules); err != nil {
+				return errors.New("Unable to load rules of field '" + typeName + "." + fieldName + "'. " + err.Error())
+			}
+
+			loaded = append(loaded, fieldRules{value: value, field: fieldName, rules: rules})
+		}
+	}
+
+	for _, fieldRules := range loaded {
+		this.registry.RegisterFieldRules(fieldRules.value, fieldRules.field, fieldRules.rules)
+	}
+
+	this.invalidateFieldCache()
+
+	return nil
+}
+
+// ruleStrings joins the rules of a field of a rule file, which are either a string or a list of strings.
+func ruleStrings(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case []interface{}:
+		rules := make([]string, len(typedValue))
+
+		for i, rule := range typedValue {
+			ruleString, ok := rule.(string)
+
+			if !ok {
+				return "", errors.New("Rules must be strings, got " + fmt.Sprintf("%T", rule) + ".")
+			}
+
+			rules[i] = ruleString
+		}
+
+		return strings.Join(rules, ","), nil
+	default:
+		return "", errors.New("Rules must be a string or a list of strings, got " + fmt.Sprintf("%T", value) + ".")
+	}
+}
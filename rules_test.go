@@ -0,0 +1,188 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"strings"
+	"testing"
+)
+
+func TestThatRulesCanBeRegisteredForFieldsWithoutTags(t *testing.T) {
+	type Dummy struct {
+		Name string
+	}
+
+	validator := New()
+	validator.Rules(Dummy{}).Field("Name", "not_empty", "min(3)")
+
+	if errs := validator.Validate(&Dummy{Name: "John"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&Dummy{Name: "Jo"})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs.First().GetValidatorName() != "min" {
+		t.Fatalf("Expected error of 'min', got '%s'.", errs.First().GetValidatorName())
+	}
+}
+
+func TestThatRulesAreMergedWithTagRules(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"max(5)"`
+	}
+
+	validator := New()
+
+	if errs := validator.Validate(&Dummy{Name: "Jo"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	validator.Rules(&Dummy{}).Field("Name", "min(3)")
+
+	if errs := validator.Validate(&Dummy{Name: "Jo"}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := validator.Validate(&Dummy{Name: "Johnny"}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatRulesAreCombinedWithTagGroups(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"empty|min(3)"`
+	}
+
+	validator := New()
+	validator.Rules(Dummy{}).Field("Name", "max(5)")
+
+	for _, name := range []string{"", "John"} {
+		if errs := validator.Validate(&Dummy{Name: name}); errs.Any() {
+			t.Fatalf("Didn't expect error for '%s', got %s.", name, errs.First())
+		}
+	}
+
+	for _, name := range []string{"Jo", "Johnny"} {
+		if errs := validator.Validate(&Dummy{Name: name}); !errs.Any() {
+			t.Fatalf("Expected error for '%s', didn't get any.", name)
+		}
+	}
+}
+
+func TestThatRulesOfUnknownFieldsResultInError(t *testing.T) {
+	type Dummy struct {
+		Name string
+	}
+
+	validator := New()
+	validator.Rules(Dummy{}).Field("Unknown", "not_empty")
+
+	if errs := validator.Validate(&Dummy{}); !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+type ruleFileDummy struct {
+	Name string `validate:"not_empty"`
+	Age  int
+}
+
+func TestThatRulesCanBeLoadedFromFile(t *testing.T) {
+	validator := New()
+
+	err := validator.LoadRules([]byte(`{"ruleFileDummy": {"Name": "max(5)", "Age": ["min(18)", "max(65)"]}}`), ruleFileDummy{})
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if errs := validator.Validate(&ruleFileDummy{Name: "Jane", Age: 30}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&ruleFileDummy{Age: 70})
+
+	if errs.Length() != 2 || errs[0].GetValidatorName() != "not_empty" || errs[1].GetValidatorName() != "max" {
+		t.Fatalf("Expected errors of 'not_empty' and 'max', got %s.", errs)
+	}
+}
+
+func TestThatRulesCanBeLoadedWithUnmarshal(t *testing.T) {
+	validator := New()
+
+	unmarshal := func(data []byte, value interface{}) error {
+		*value.(*map[string]map[string]interface{}) = map[string]map[string]interface{}{
+			"ruleFileDummy": {"Age": "min(" + string(data) + ")"},
+		}
+		return nil
+	}
+
+	if err := validator.LoadRulesWith([]byte("21"), unmarshal, &ruleFileDummy{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if errs := validator.Validate(&ruleFileDummy{Name: "Jane", Age: 20}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatLoadingInvalidRulesFails(t *testing.T) {
+	validator := New()
+
+	tests := map[string]string{
+		`{"Unknown": {"Name": "min(1)"}}`:        "Unable to load rules of type 'Unknown'. Type is not registered.",
+		`{"ruleFileDummy": {"Email": "min(1)"}}`: "Unable to load rules of field 'ruleFileDummy.Email'. Field does not exist.",
+		`{"ruleFileDummy": {"Name": 5}}`:         "Unable to load rules of field 'ruleFileDummy.Name'. Rules must be a string or a list of strings, got float64.",
+		`not json`:                               "Unable to load rules. invalid character 'o' in literal null (expecting 'u')",
+	}
+
+	for data, expected := range tests {
+		if err := validator.LoadRules([]byte(data), ruleFileDummy{}); err == nil || err.Error() != expected {
+			t.Fatalf("Expected error '%s', got %v.", expected, err)
+		}
+	}
+
+	if errs := validator.Validate(&ruleFileDummy{Name: "Jane"}); errs.Any() {
+		t.Fatalf("Didn't expect rules of invalid files, got %s.", errs)
+	}
+}
+
+func TestThatOverrideReplacesRulesOfField(t *testing.T) {
+	type User struct {
+		Name string `validate:"not_empty"`
+		Age  int    `validate:"min(18)"`
+	}
+
+	errs := Validate(&User{Name: "Jane", Age: 20}, Override("User.Age", "min(21)"))
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Age" {
+		t.Fatalf("Expected error of 'Age', got %s.", errs)
+	}
+
+	if errs := Validate(&User{Name: "Jane", Age: 16}, Override("User.Age", "min(16)")); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+
+	if errs := Validate(&User{Age: 16}, Override("User.Age", ""), Override("User.Name", "")); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+
+	if errs := Validate(&User{Name: "Jane", Age: 16}); errs.Length() != 1 {
+		t.Fatalf("Expected rules of tags after override, got %d errors.", errs.Length())
+	}
+}
+
+func TestThatOverrideWithInvalidRulesFails(t *testing.T) {
+	type User struct {
+		Age int `validate:"min(18)"`
+	}
+
+	errs := Validate(&User{Age: 20}, Override("User.Age", "min(21"))
+
+	if errs.Length() != 1 || !strings.HasPrefix(errs.First().Error(), "Unable to override rules of field 'User.Age'.") {
+		t.Fatalf("Expected error of invalid override, got %s.", errs)
+	}
+}
@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"github.com/typerandom/validator/core"
+	"sync"
+)
+
+// errorSampler limits the number of errors that are reported per field path, i.e. to validate a large malformed file
+// without keeping an error for every record. It's shared by the validations of a stream, so it's safe for concurrent
+// use.
+type errorSampler struct {
+	lock   sync.Mutex
+	limit  int
+	counts map[string]int
+}
+
+func newErrorSampler(limit int) *errorSampler {
+	return &errorSampler{limit: limit, counts: make(map[string]int)}
+}
+
+// sample returns the errors that are within the limit of their field path. The fields of the items of a collection
+// passed to Validate share their paths, i.e. `[0].Name` and `[1].Name` are both sampled as `Name`.
+func (this *errorSampler) sample(errs core.ErrorList) core.ErrorList {
+	if this == nil || len(errs) == 0 {
+		return errs
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	var sampled core.ErrorList
+
+	for i, err := range errs {
+		path := trimItemKey(err.GetFieldName())
+
+		if this.counts[path] < this.limit {
+			this.counts[path]++
+
+			if sampled != nil {
+				sampled = append(sampled, err)
+			}
+		} else if sampled == nil {
+			// Errors are only copied once one of them is dropped.
+			sampled = append(make(core.ErrorList, 0, len(errs)), errs[:i]...)
+		}
+	}
+
+	if sampled == nil {
+		return errs
+	}
+
+	return sampled
+}
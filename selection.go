@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"strings"
+)
+
+// fieldSelection selects the fields to validate by their full name, i.e. `Address.City`.
+// A nil selection selects all fields.
+type fieldSelection struct {
+	paths  []string
+	except bool
+}
+
+func (this *fieldSelection) matches(path string) bool {
+	path = trimItemKey(path)
+
+	for _, selectedPath := range this.paths {
+		if path == selectedPath || strings.HasPrefix(path, selectedPath+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// includes checks whether the validators of the field at path should run.
+func (this *fieldSelection) includes(path string) bool {
+	if this == nil {
+		return true
+	}
+
+	return this.matches(path) != this.except
+}
+
+// traverses checks whether the field at path, or any of its children, should be validated.
+func (this *fieldSelection) traverses(path string) bool {
+	if this.includes(path) {
+		return true
+	}
+
+	if this.except {
+		return false
+	}
+
+	path = trimItemKey(path)
+
+	for _, selectedPath := range this.paths {
+		if strings.HasPrefix(selectedPath, path+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trimItemKey trims the index or key of an item of the collection passed to Validate from a path, i.e. `[0].Name`, so
+// that fields of items are selected as if they were validated on their own.
+func trimItemKey(path string) string {
+	if !strings.HasPrefix(path, "[") {
+		return path
+	}
+
+	if index := strings.Index(path, "]."); index >= 0 {
+		return path[index+2:]
+	}
+
+	if strings.HasSuffix(path, "]") {
+		return ""
+	}
+
+	return path
+}
+
+// withFieldSelection limits validation to a selection of fields.
+func withFieldSelection(selection *fieldSelection) Option {
+	return func(options *options) {
+		options.selection = selection
+	}
+}
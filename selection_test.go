@@ -0,0 +1,59 @@
+package validator_test
+
+import (
+	. "github.com/typerandom/validator"
+	"testing"
+)
+
+type selectionAddress struct {
+	Street string `validate:"not_empty"`
+	City   string `validate:"not_empty"`
+}
+
+type selectionUser struct {
+	Name    string `validate:"not_empty"`
+	Email   string `validate:"not_empty"`
+	Address selectionAddress
+}
+
+func TestThatValidateFieldsOnlyValidatesSelectedFields(t *testing.T) {
+	errs := ValidateFields(&selectionUser{}, "Name")
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs.First().GetFieldName() != "Name" {
+		t.Fatalf("Expected error for 'Name', got '%s'.", errs.First().GetFieldName())
+	}
+}
+
+func TestThatValidateFieldsSupportsNestedPaths(t *testing.T) {
+	errs := ValidateFields(&selectionUser{}, "Address.City")
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs.First().GetFieldName() != "Address.City" {
+		t.Fatalf("Expected error for 'Address.City', got '%s'.", errs.First().GetFieldName())
+	}
+}
+
+func TestThatValidateFieldsValidatesAllChildrenOfSelectedStruct(t *testing.T) {
+	if errs := ValidateFields(&selectionUser{}, "Address"); errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidateExceptSkipsSelectedFields(t *testing.T) {
+	errs := ValidateExcept(&selectionUser{}, "Email", "Address.Street")
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	if errs.WithField("Name").Length() != 1 || errs.WithField("Address.City").Length() != 1 {
+		t.Fatalf("Expected errors for 'Name' and 'Address.City', got %v.", errs.ByField())
+	}
+}
@@ -0,0 +1,109 @@
+package validator
+
+import (
+	gocontext "context"
+	"github.com/typerandom/validator/core"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// Result is the result of the validation of a record of a stream.
+type Result struct {
+	// Index is the position of the record in the stream, starting at zero.
+	Index int
+
+	// Value is the record.
+	Value interface{}
+
+	// Errors are the errors of the record. The record passed if there are none.
+	Errors core.ErrorList
+}
+
+// streamRecord is a record of a stream, waiting to be validated by a worker.
+type streamRecord struct {
+	index int
+	value interface{}
+}
+
+func (this *validator) ValidateStream(ctx gocontext.Context, records <-chan interface{}, opts ...Option) <-chan Result {
+	options := newOptions(this, opts)
+	workers := options.workers
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	// The records share a sampler, so that errors are sampled across the stream.
+	if options.sampleLimit > 0 {
+		opts = append(opts[:len(opts):len(opts)], withErrorSampler(newErrorSampler(options.sampleLimit)))
+	}
+
+	// Reading records stops once the error budget is spent.
+	var errorCount int64
+	var stopOnce sync.Once
+	stop := make(chan struct{})
+
+	pending := make(chan streamRecord)
+	results := make(chan Result)
+
+	// Records are only read when a worker is ready to validate them, which provides backpressure.
+	go func() {
+		defer close(pending)
+
+		for index := 0; ; index++ {
+			select {
+			case value, ok := <-records:
+				if !ok {
+					return
+				}
+
+				select {
+				case pending <- streamRecord{index: index, value: value}:
+				case <-stop:
+					return
+				case <-ctx.Done():
+					return
+				}
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for record := range pending {
+				result := Result{
+					Index:  record.index,
+					Value:  record.value,
+					Errors: this.ValidateCtx(ctx, record.value, opts...),
+				}
+
+				if options.errorBudget > 0 && atomic.AddInt64(&errorCount, int64(result.Errors.Errors().Length())) >= int64(options.errorBudget) {
+					stopOnce.Do(func() { close(stop) })
+				}
+
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return results
+}
@@ -0,0 +1,118 @@
+package validator_test
+
+import (
+	"context"
+	. "github.com/typerandom/validator"
+	"testing"
+)
+
+type streamDummy struct {
+	Name string `validate:"not_empty"`
+}
+
+func TestThatValidateStreamValidatesEachRecord(t *testing.T) {
+	records := make(chan interface{})
+
+	go func() {
+		defer close(records)
+
+		for i := 0; i < 100; i++ {
+			if i%3 == 0 {
+				records <- &streamDummy{}
+			} else {
+				records <- &streamDummy{Name: "Jane"}
+			}
+		}
+	}()
+
+	seen := make(map[int]bool)
+
+	for result := range ValidateStream(context.Background(), records, Workers(4)) {
+		if seen[result.Index] {
+			t.Fatalf("Expected a single result for record %d.", result.Index)
+		}
+
+		seen[result.Index] = true
+
+		if failed := result.Index%3 == 0; result.Errors.Any() != failed {
+			t.Fatalf("Expected record %d to fail: %t, got errors %s.", result.Index, failed, result.Errors)
+		}
+
+		if result.Errors.Any() && result.Value.(*streamDummy).Name != "" {
+			t.Fatalf("Expected errors of record %d to belong to its value, got %v.", result.Index, result.Value)
+		}
+	}
+
+	if len(seen) != 100 {
+		t.Fatalf("Expected 100 results, got %d.", len(seen))
+	}
+}
+
+func TestThatValidateStreamStopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	records := make(chan interface{})
+
+	results := ValidateStream(ctx, records, Workers(2))
+
+	records <- &streamDummy{Name: "Jane"}
+
+	if result := <-results; result.Index != 0 || result.Errors.Any() {
+		t.Fatalf("Expected passing result of record 0, got %+v.", result)
+	}
+
+	cancel()
+
+	for range results {
+	}
+}
+
+func streamRecords(n int) <-chan interface{} {
+	records := make(chan interface{})
+
+	go func() {
+		defer close(records)
+
+		for i := 0; i < n; i++ {
+			records <- &streamDummy{}
+		}
+	}()
+
+	return records
+}
+
+func TestThatValidateStreamStopsWhenErrorBudgetIsSpent(t *testing.T) {
+	records := streamRecords(1000)
+	count := 0
+
+	for range ValidateStream(context.Background(), records, Workers(2), ErrorBudget(10)) {
+		count++
+	}
+
+	// Records that are being validated when the budget is spent are still reported.
+	if count < 10 || count > 12 {
+		t.Fatalf("Expected 10 to 12 results, got %d.", count)
+	}
+
+	for range records {
+	}
+}
+
+func TestThatValidateStreamSamplesErrorsAcrossRecords(t *testing.T) {
+	errorCount := 0
+
+	for result := range ValidateStream(context.Background(), streamRecords(50), Workers(4), SampleErrors(3)) {
+		errorCount += result.Errors.Length()
+	}
+
+	if errorCount != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errorCount)
+	}
+}
+
+func TestThatValidateSamplesErrorsOfItems(t *testing.T) {
+	errs := Validate(make([]streamDummy, 20), SampleErrors(2))
+
+	if errs.Length() != 2 || errs[0].GetFieldName() != "[0].Name" || errs[1].GetFieldName() != "[1].Name" {
+		t.Fatalf("Expected errors of '[0].Name' and '[1].Name', got %v.", errs)
+	}
+}
@@ -2,8 +2,11 @@
 package validator
 
 import (
+	gocontext "context"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"github.com/typerandom/validator/validators"
+	"reflect"
 	"sync"
 )
 
@@ -12,34 +15,184 @@ type Validator interface {
 	// Default: Empty string that defaults to the field name.
 	SetDisplayNameTag(name string)
 
+	// SetDisplayNameFunc sets a function that resolves the display name of a field, i.e. core.JsonDisplayNameResolver.
+	// If the function returns an empty string, then the field name is used.
+	SetDisplayNameFunc(resolver core.DisplayNameResolver)
+
+	// SetNilPolicy sets how validators treat nil values, i.e. `core.FailNil`. Default: core.FailNil.
+	SetNilPolicy(policy core.NilPolicy)
+
+	// SetUnsupportedPolicy sets how fields of channels, functions and unsafe pointers are treated, i.e.
+	// `core.FailUnsupported`. Default: core.SkipUnsupported.
+	SetUnsupportedPolicy(policy core.UnsupportedPolicy)
+
+	// SetUnexportedPolicy sets how unexported fields of structs are treated, i.e. `core.ValidateUnexported`.
+	// Default: core.SkipUnexported.
+	SetUnexportedPolicy(policy core.UnexportedPolicy)
+
+	// SetMemoization caches the results of pure validators (see RegisterPure) for up to limit distinct fields, values
+	// and arguments, i.e. when validating streams with many duplicate records. Default: 0, which disables it.
+	SetMemoization(limit int)
+
+	// SetLengthUnit sets how length based validators, such as `min` and `length`, count the length of strings,
+	// i.e. `core.RuneLength`. Default: core.ByteLength.
+	SetLengthUnit(unit core.LengthUnit)
+
+	// AddHooks adds hooks that are called during validation, i.e. `AddHooks(Hooks{OnFieldError: logError})`.
+	// Hooks are called in the order they were added.
+	AddHooks(hooks Hooks)
+
+	// SetRecorder sets the recorder of metrics of validation, i.e. the number of validations and failures of each
+	// validator. Default: nil, which doesn't record metrics.
+	SetRecorder(recorder Recorder)
+
+	// SetTracer sets the tracer that starts a span for each validation, i.e. with OpenTelemetry. Default: nil.
+	SetTracer(tracer Tracer)
+
 	// Locale retrieves the locale for this validator.
 	Locale() *core.Locale
 
 	// Register registers a validator by name.
 	Register(name string, validator core.ValidatorFn)
 
-	// Validate validates fields of a structure, or structures of a map, slice or array.
-	Validate(value interface{}) core.ErrorList
+	// RegisterWithSchema registers a validator by name, with arguments that are checked and converted by schema
+	// when tags are parsed.
+	RegisterWithSchema(name string, validator core.ValidatorFn, schema *core.ArgumentSchema)
+
+	// RegisterWarning registers a validator by name whose errors are warnings. Warnings are part of the result of
+	// Validate, see ErrorList.Warnings(), but don't fail validation.
+	RegisterWarning(name string, validator core.ValidatorFn)
+
+	// RegisterNilHandler registers a validator by name that handles nil values itself, i.e. `not_empty`. It receives
+	// nil values regardless of the nil policy.
+	RegisterNilHandler(name string, validator core.ValidatorFn)
+
+	// RegisterAlias registers a set of validators by name, i.e. `RegisterAlias("username", "not_empty,min(3),max(30)")`.
+	// Returns error if the rules cannot be parsed.
+	RegisterAlias(name string, rules string) error
+
+	// RegisterTypeAdapter registers an adapter that converts values of a type before they are validated,
+	// i.e. `RegisterTypeAdapter(reflect.TypeOf(decimal.Decimal{}), func(v interface{}) (interface{}, bool) {...})`.
+	RegisterTypeAdapter(reflectedType reflect.Type, adapter core.TypeAdapter)
+
+	// RegisterPure registers a validator whose result only depends on the value and arguments it's run with, so that
+	// its results can be memoized, i.e. `RegisterPure("vat_id", validateVatId)`. See SetMemoization.
+	RegisterPure(name string, validator core.ValidatorFn)
+
+	// RegisterOptionType registers an option type, whose values are validated as nil if they're not set, i.e.
+	// `RegisterOptionType(reflect.TypeOf(Option[string]{}), func(v interface{}) (interface{}, bool) {...})`. The Null
+	// types of database/sql are supported without being registered.
+	RegisterOptionType(reflectedType reflect.Type, adapter core.OptionAdapter)
+
+	// RegisterComparer registers a function that compares values of a type with the arguments of `min`, `max` and
+	// `between`, i.e. `RegisterComparer(reflect.TypeOf(decimal.Decimal{}), func(v, arg interface{}) (int, bool) {...})`.
+	RegisterComparer(reflectedType reflect.Type, comparer core.Comparer)
+
+	// RegisterBatch registers a validator by name that validates the values of all fields that use it at once, after
+	// the other validators, i.e. `RegisterBatch("unique", validators.UniqueValidator(exists))`.
+	RegisterBatch(name string, validator core.BatchValidatorFn)
+
+	// Rules returns a builder that registers rules for the fields of a struct type without using tags,
+	// i.e. `Rules(User{}).Field("Name", "not_empty", "min(3)")`.
+	Rules(value interface{}) *RuleBuilder
+
+	// LoadRules registers rules for the fields of struct types from a JSON rule file that maps the names of types to
+	// the rules of their fields, i.e. `{"User": {"Name": "min(3),max(32)", "Age": ["min(18)", "max(65)"]}}`. The types
+	// are given by value, i.e. `LoadRules(data, User{})`. The rules are merged with the tag rules of the fields, and
+	// both are required to pass. Returns error, without registering any rules, if the file is invalid.
+	LoadRules(data []byte, types ...interface{}) error
+
+	// LoadRulesWith loads rules like LoadRules from a file of another format, i.e. with yaml.Unmarshal.
+	LoadRulesWith(data []byte, unmarshal validators.UnmarshalFn, types ...interface{}) error
+
+	// Validate validates fields of a structure, or structures of a map, slice or array. Errors are returned in a stable
+	// order: fields in the order in which they are declared, the errors of a field in the order of its validators,
+	// items in the order of their index or map key, and errors of batch validators last. The fields of items of a
+	// collection passed to Validate are named by the index or key of the item, i.e. `[0].Name`.
+	Validate(value interface{}, options ...Option) core.ErrorList
+
+	// ValidateCtx validates like Validate, but passes ctx to the validators through ValidatorContext.Context().
+	// If ctx is cancelled, validation stops and the error of ctx is added to the returned errors. A nil ctx is treated
+	// as context.Background().
+	ValidateCtx(ctx gocontext.Context, value interface{}, options ...Option) core.ErrorList
+
+	// ValidateFields validates only the fields of value with the specified names, including nested paths such as
+	// `Address.City`. Struct level validation (core.Validatable) is only run for structs that are selected as a whole.
+	ValidateFields(value interface{}, fields ...string) core.ErrorList
+
+	// ValidateExcept validates all fields of value, except the fields with the specified names.
+	ValidateExcept(value interface{}, fields ...string) core.ErrorList
+
+	// ValidateStream validates the records of a stream concurrently, i.e. for ETL jobs, and sends a result for each
+	// record. Records are read as workers become available, so a slow consumer of the results slows down the reading
+	// of records. Results are sent in the order in which records are validated, so use Result.Index to associate them
+	// with records. The results are closed once records is closed and all records are validated, or ctx is done.
+	ValidateStream(ctx gocontext.Context, records <-chan interface{}, options ...Option) <-chan Result
+
+	// ValidateValue validates a single value against rules, i.e. `ValidateValue("john@doe.com", "not_empty,email")`.
+	// Returns a core.ErrorList as error if validation fails, otherwise nil.
+	ValidateValue(value interface{}, rules string, options ...Option) error
+
+	// Compile parses the tags of the struct types of value, including nested types, and resolves their validators.
+	// Returns error if any validator isn't registered, i.e. `mn(5)`. Parsed tags are cached, so that they don't have
+	// to be parsed during validation.
+	Compile(value interface{}) (*Plan, error)
+
+	// Precompile compiles value like Compile, but only returns the error.
+	Precompile(value interface{}) error
+
+	// ExportRules describes the rules that are enforced for the struct types of values, including nested types, i.e.
+	// to diff or audit the rules between releases. The rule set is stable, and can be serialized as JSON. Returns error
+	// if any of the types can't be compiled.
+	ExportRules(values ...interface{}) (*RuleSet, error)
 
-	// Copy deep copies the validator and returns a new instance.
+	// Describe returns the descriptions of the registered validators and aliases of the validator, sorted by name.
+	Describe() []core.Description
+
+	// SetDescription documents a registered validator or alias by name, i.e. its summary, usage and supported kinds.
+	SetDescription(name string, description core.Description)
+
+	// Copy deep copies the validator and returns a new instance. It's the same as Clone.
 	Copy() Validator
+
+	// Clone returns a new validator with the configuration, locale and registered validators of the validator.
+	// Validators registered with the clone don't affect the validator, and the other way around.
+	Clone() Validator
+
+	// Registry returns the registry of the validator.
+	Registry() *core.ValidatorRegistry
 }
 
 // Validator represents a validator with it's own configuration set.
 type validator struct {
-	displayNameTag *string
+	displayNameResolver core.DisplayNameResolver
+	fieldCache          *core.FieldCache
+	tagNames            []string
+	tagParsers          map[string]core.TagParser
+	nilPolicy           core.NilPolicy
+	unsupportedPolicy   core.UnsupportedPolicy
+	unexportedPolicy    core.UnexportedPolicy
+	memoLimit           int
+	memo                *memoCache
+	lengthUnit          core.LengthUnit
+	hooks               hookList
+	recorder            Recorder
+	tracer              Tracer
 
-	registry core.ValidatorRegistry
+	registry *core.ValidatorRegistry
 	locale   *core.Locale
-	lock     sync.Mutex
+	lock     sync.RWMutex
 }
 
 func newValidator() *validator {
 	validator := &validator{
 		registry: core.NewValidatorRegistry(),
 		locale:   core.NewLocale(),
+		tagNames: []string{"validate"},
 	}
 
+	validator.resetFieldCache()
+
 	validators.RegisterDefaultLocale(validator.locale)
 	validators.RegisterDefaultValidators(validator.registry)
 
@@ -47,11 +200,28 @@ func newValidator() *validator {
 }
 
 func (this *validator) Copy() Validator {
+	return this.Clone()
+}
+
+func (this *validator) Clone() Validator {
 	newValidator := newValidator()
 
-	newValidator.displayNameTag = this.displayNameTag
+	this.lock.RLock()
+	newValidator.SetDisplayNameFunc(this.displayNameResolver)
+	newValidator.nilPolicy = this.nilPolicy
+	newValidator.unsupportedPolicy = this.unsupportedPolicy
+	newValidator.unexportedPolicy = this.unexportedPolicy
+	newValidator.memoLimit = this.memoLimit
+	newValidator.lengthUnit = this.lengthUnit
+	newValidator.hooks = this.hooks
+	newValidator.recorder = this.recorder
+	newValidator.tracer = this.tracer
+	newValidator.tagNames = this.tagNames
+	newValidator.tagParsers = this.tagParsers
+	this.lock.RUnlock()
 	newValidator.locale = this.locale.Copy()
-	newValidator.registry = this.registry
+	newValidator.registry = this.registry.Copy()
+	newValidator.resetFieldCache()
 
 	return newValidator
 }
@@ -60,39 +230,371 @@ func (this *validator) Locale() *core.Locale {
 	return this.locale
 }
 
+func (this *validator) Registry() *core.ValidatorRegistry {
+	return this.registry
+}
+
 func (this *validator) SetDisplayNameTag(tagName string) {
 	if len(tagName) == 0 {
-		this.displayNameTag = nil
+		this.SetDisplayNameFunc(nil)
 	} else {
-		this.displayNameTag = &tagName
+		this.SetDisplayNameFunc(core.TagDisplayNameResolver(tagName))
+	}
+}
+
+func (this *validator) SetDisplayNameFunc(resolver core.DisplayNameResolver) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.displayNameResolver = resolver
+	this.resetFieldCache()
+}
+
+func (this *validator) SetNilPolicy(policy core.NilPolicy) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.nilPolicy = policy
+}
+
+func (this *validator) SetUnsupportedPolicy(policy core.UnsupportedPolicy) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.unsupportedPolicy = policy
+}
+
+func (this *validator) SetUnexportedPolicy(policy core.UnexportedPolicy) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.unexportedPolicy = policy
+	// Unexported fields are only cached if they're validated.
+	this.resetFieldCache()
+}
+
+func (this *validator) SetMemoization(limit int) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.memoLimit = limit
+	this.resetFieldCache()
+}
+
+func (this *validator) SetLengthUnit(unit core.LengthUnit) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.lengthUnit = unit
+}
+
+func (this *validator) AddHooks(hooks Hooks) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	// Contexts hold the current list, so it's never appended to in place.
+	this.hooks = append(this.hooks[:len(this.hooks):len(this.hooks)], hooks)
+}
+
+func (this *validator) getHooks() hookList {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	return this.hooks
+}
+
+func (this *validator) SetRecorder(recorder Recorder) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.recorder = recorder
+}
+
+func (this *validator) SetTracer(tracer Tracer) {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.tracer = tracer
+}
+
+// getInstrumentation returns the recorder and tracer of the validator.
+func (this *validator) getInstrumentation() (Recorder, Tracer) {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	return this.recorder, this.tracer
+}
+
+// withLengthUnit returns ctx with the length unit of the validator, unless it's the default.
+func (this *validator) withLengthUnit(ctx gocontext.Context) gocontext.Context {
+	this.lock.RLock()
+	unit := this.lengthUnit
+	this.lock.RUnlock()
+
+	if unit != core.ByteLength {
+		ctx = core.WithLengthUnit(ctx, unit)
+	}
+
+	return ctx
+}
+
+// withComparers returns ctx with the registry of the validator, if it has comparers.
+func (this *validator) withComparers(ctx gocontext.Context) gocontext.Context {
+	if this.registry.HasComparers() {
+		ctx = core.WithComparers(ctx, this.registry)
+	}
+
+	return ctx
+}
+
+func (this *validator) getNilPolicy() core.NilPolicy {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+	return this.nilPolicy
+}
+
+// resetFieldCache replaces the field cache with a cache for the current configuration. Callers must hold the lock,
+// unless the validator is being created.
+func (this *validator) resetFieldCache() {
+	tags := make([]core.Tag, len(this.tagNames))
+
+	for i, tagName := range this.tagNames {
+		tags[i] = core.Tag{Name: tagName, Parser: this.tagParsers[tagName]}
+	}
+
+	this.fieldCache = core.NewFieldCacheWithTags(tags, this.displayNameResolver, this.registry)
+
+	if this.unexportedPolicy != core.SkipUnexported {
+		this.fieldCache.IncludeUnexported()
+	}
+
+	// Results are memoized by the methods of the cached fields, so they're reset with the cache.
+	this.memo = nil
+
+	if this.memoLimit > 0 {
+		this.memo = newMemoCache(this.memoLimit)
 	}
 }
 
 func (this *validator) Register(name string, validator core.ValidatorFn) {
 	this.registry.Register(name, validator)
+	this.invalidateFieldCache()
+}
+
+func (this *validator) RegisterWithSchema(name string, validator core.ValidatorFn, schema *core.ArgumentSchema) {
+	this.registry.RegisterWithSchema(name, validator, schema)
+	this.invalidateFieldCache()
+}
+
+// invalidateFieldCache resets the field cache after the registry has changed, since aliases, field rules and argument
+// schemas of the registry are applied when fields are cached.
+func (this *validator) invalidateFieldCache() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	this.resetFieldCache()
+}
+
+func (this *validator) RegisterWarning(name string, validator core.ValidatorFn) {
+	this.registry.RegisterWarning(name, validator)
+	this.invalidateFieldCache()
+}
+
+func (this *validator) RegisterNilHandler(name string, validator core.ValidatorFn) {
+	this.registry.RegisterNilHandler(name, validator)
+	this.invalidateFieldCache()
+}
+
+func (this *validator) RegisterAlias(name string, rules string) error {
+	if err := this.registry.RegisterAlias(name, rules); err != nil {
+		return err
+	}
+
+	this.invalidateFieldCache()
+
+	return nil
+}
+
+func (this *validator) RegisterTypeAdapter(reflectedType reflect.Type, adapter core.TypeAdapter) {
+	this.registry.RegisterTypeAdapter(reflectedType, adapter)
+}
+
+func (this *validator) RegisterOptionType(reflectedType reflect.Type, adapter core.OptionAdapter) {
+	this.registry.RegisterOptionType(reflectedType, adapter)
+}
+
+func (this *validator) RegisterComparer(reflectedType reflect.Type, comparer core.Comparer) {
+	this.registry.RegisterComparer(reflectedType, comparer)
+}
+
+func (this *validator) Describe() []core.Description {
+	return this.registry.Describe()
+}
+
+func (this *validator) SetDescription(name string, description core.Description) {
+	this.registry.SetDescription(name, description)
+}
+
+func (this *validator) RegisterPure(name string, validator core.ValidatorFn) {
+	this.registry.RegisterPure(name, validator)
+	this.invalidateFieldCache()
+}
+
+func (this *validator) RegisterBatch(name string, validator core.BatchValidatorFn) {
+	this.registry.RegisterBatch(name, validator)
+	this.invalidateFieldCache()
+}
+
+func (this *validator) Validate(value interface{}, opts ...Option) core.ErrorList {
+	return this.ValidateCtx(gocontext.Background(), value, opts...)
+}
+
+func (this *validator) ValidateCtx(ctx gocontext.Context, value interface{}, opts ...Option) core.ErrorList {
+	if ctx == nil {
+		ctx = gocontext.Background()
+	}
+
+	context := this.newContext(ctx, opts)
+
+	if err := core.CheckInput(value); err != nil {
+		context.errors.AddPlain(err)
+		return context.result()
+	}
+
+	// Generated code only validates exported fields.
+	if fn, ok := getGeneratedFunc(value); ok && context.selection == nil && context.unexportedPolicy == core.SkipUnexported && context.fieldWorkers <= 1 {
+		fn(&GeneratedValidation{context: context}, value, nil)
+	} else {
+		walkValidate(context, value, reflect.ValueOf(value), nil)
+	}
+
+	return context.result()
 }
 
-func (this *validator) Validate(value interface{}) core.ErrorList {
+func (this *validator) newContext(ctx gocontext.Context, opts []Option) *context {
+	options := newOptions(this, opts)
+
+	this.lock.RLock()
+	fieldCache := this.fieldCache
+	nilPolicy := this.nilPolicy
+	unsupportedPolicy := this.unsupportedPolicy
+	unexportedPolicy := this.unexportedPolicy
+	memo := this.memo
+	hooks := this.hooks
+	recorder := this.recorder
+	tracer := this.tracer
+	this.lock.RUnlock()
+
+	if options.filesystem {
+		ctx = core.WithFilesystemAccess(ctx)
+	}
+
+	ctx = this.withLengthUnit(ctx)
+	ctx = this.withComparers(ctx)
+
+	sampler := options.sampler
+
+	if sampler == nil && options.sampleLimit > 0 {
+		sampler = newErrorSampler(options.sampleLimit)
+	}
+
 	context := &context{
-		validator: this,
+		ctx:        ctx,
+		validator:  this,
+		fieldCache: fieldCache,
+		translator: options.translator,
+		selection:  options.selection,
+		groups:     options.groups,
+		maxErrors:  options.maxErrors,
+		limits:     options.limits,
+		nilPolicy:  nilPolicy,
+		hooks:      hooks,
+		recorder:   recorder,
+		sampler:    sampler,
+
+		unsupportedPolicy: unsupportedPolicy,
+		unexportedPolicy:  unexportedPolicy,
+		memo:              memo,
+
+		flattenEmbedded: options.flatten,
+		report:          options.report,
+		fieldWorkers:    options.fieldWorkers,
 	}
 
-	walkValidate(context, value, nil)
+	context.setOverrides(options.overrides)
+	context.startValidation(tracer, "validator.Validate")
+
+	return context
+}
+
+// Compile parses the tags of the struct types of value and resolves their validators using the default validator.
+func Compile(value interface{}) (*Plan, error) {
+	return getGlobalValidator().Compile(value)
+}
+
+// Precompile parses and checks the tags of the struct types of value using the default validator.
+func Precompile(value interface{}) error {
+	return getGlobalValidator().Precompile(value)
+}
 
-	return context.errors
+// ExportRules describes the rules of the struct types of values using the default validator.
+func ExportRules(values ...interface{}) (*RuleSet, error) {
+	return getGlobalValidator().ExportRules(values...)
 }
 
 // CheckSyntax checks the validate tag syntax of a structure.
 func CheckSyntax(value interface{}) error {
-	if _, err := core.GetStructFields(value, "validator", nil); err != nil {
+	if _, err := core.GetStructFields(value, "validate", nil); err != nil {
 		return err
 	}
 	return nil
 }
 
-// New creates a new validator.
-func New() Validator {
-	return newValidator()
+// New creates a new validator, i.e. `New(WithTag("valid"))`.
+func New(opts ...ValidatorOption) Validator {
+	validator := newValidator()
+
+	if len(opts) > 0 {
+		for _, opt := range opts {
+			opt(validator)
+		}
+
+		validator.resetFieldCache()
+	}
+
+	return validator
+}
+
+// ValidatorOption configures a validator created with New.
+type ValidatorOption func(*validator)
+
+// WithTag sets the tags that rules are read from. Default: `validate`. The rules of several tags are merged, and are
+// all required to pass, i.e. `WithTag("binding", "validate")` when migrating from another library.
+func WithTag(names ...string) ValidatorOption {
+	return func(validator *validator) {
+		validator.tagNames = append([]string(nil), names...)
+	}
+}
+
+// WithTagParser parses the rules of a tag with parse instead of the syntax of this package, i.e.
+// `New(WithTag("validate"), WithTagParser("validate", playground.Parse))` for tags of go-playground/validator.
+func WithTagParser(tagName string, parse core.TagParser) ValidatorOption {
+	return func(validator *validator) {
+		tagParsers := make(map[string]core.TagParser, len(validator.tagParsers)+1)
+
+		for name, tagParser := range validator.tagParsers {
+			tagParsers[name] = tagParser
+		}
+
+		tagParsers[tagName] = parse
+		validator.tagParsers = tagParsers
+	}
+}
+
+// WithBaseRegistry registers the validators of the validator on top of a registry shared with other validators, i.e.
+// one created with NewRegistry. Validators registered with the validator don't change the base registry, and
+// override its validators by name. Validators registered with the base registry after the validator has cached the
+// fields of a type aren't applied to aliases, field rules and argument schemas of that type.
+func WithBaseRegistry(base *core.ValidatorRegistry) ValidatorOption {
+	return func(validator *validator) {
+		validator.registry = core.NewValidatorRegistryWithBase(base)
+	}
+}
+
+// NewRegistry creates a registry with the default validators, that can be shared by validators with
+// WithBaseRegistry.
+func NewRegistry() *core.ValidatorRegistry {
+	registry := core.NewValidatorRegistry()
+	validators.RegisterDefaultValidators(registry)
+	return registry
 }
 
 // Default retrieves the default global validator (singleton).
@@ -106,7 +608,168 @@ func Register(name string, validator core.ValidatorFn) {
 	getGlobalValidator().Register(name, validator)
 }
 
+// RegisterWithSchema registers a validator method with an argument schema by name on the default validator.
+func RegisterWithSchema(name string, validator core.ValidatorFn, schema *core.ArgumentSchema) {
+	getGlobalValidator().RegisterWithSchema(name, validator, schema)
+}
+
+// Rules returns a builder that registers rules for the fields of a struct type on the default validator.
+func Rules(value interface{}) *RuleBuilder {
+	return getGlobalValidator().Rules(value)
+}
+
+// LoadRules registers rules for the fields of struct types from a JSON rule file on the default validator.
+func LoadRules(data []byte, types ...interface{}) error {
+	return getGlobalValidator().LoadRules(data, types...)
+}
+
+// RegisterWarning registers a validator whose errors are warnings by name on the default validator.
+func RegisterWarning(name string, validator core.ValidatorFn) {
+	getGlobalValidator().RegisterWarning(name, validator)
+}
+
+// RegisterNilHandler registers a validator that handles nil values itself by name on the default validator.
+func RegisterNilHandler(name string, validator core.ValidatorFn) {
+	getGlobalValidator().RegisterNilHandler(name, validator)
+}
+
+// RegisterAlias registers a set of validators by name on the default validator.
+func RegisterAlias(name string, rules string) error {
+	return getGlobalValidator().RegisterAlias(name, rules)
+}
+
+// RegisterTypeAdapter registers a type adapter on the default validator.
+func RegisterTypeAdapter(reflectedType reflect.Type, adapter core.TypeAdapter) {
+	getGlobalValidator().RegisterTypeAdapter(reflectedType, adapter)
+}
+
+// Describe returns the descriptions of the registered validators and aliases of the default validator.
+func Describe() []core.Description {
+	return getGlobalValidator().Describe()
+}
+
+// RegisterPure registers a pure validator by name on the default validator.
+func RegisterPure(name string, validator core.ValidatorFn) {
+	getGlobalValidator().RegisterPure(name, validator)
+}
+
+// RegisterOptionType registers an option type on the default validator.
+func RegisterOptionType(reflectedType reflect.Type, adapter core.OptionAdapter) {
+	getGlobalValidator().RegisterOptionType(reflectedType, adapter)
+}
+
+// RegisterComparer registers the comparer of a type on the default validator.
+func RegisterComparer(reflectedType reflect.Type, comparer core.Comparer) {
+	getGlobalValidator().RegisterComparer(reflectedType, comparer)
+}
+
+// RegisterBatch registers a batch validator by name on the default validator.
+func RegisterBatch(name string, validator core.BatchValidatorFn) {
+	getGlobalValidator().RegisterBatch(name, validator)
+}
+
+// ValidateFields validates only the specified fields of value using the default validator.
+func ValidateFields(value interface{}, fields ...string) core.ErrorList {
+	return getGlobalValidator().ValidateFields(value, fields...)
+}
+
+// ValidateExcept validates all fields of value, except the specified fields, using the default validator.
+func ValidateExcept(value interface{}, fields ...string) core.ErrorList {
+	return getGlobalValidator().ValidateExcept(value, fields...)
+}
+
+// ValidateValue validates a single value against rules using the default validator.
+func ValidateValue(value interface{}, rules string, options ...Option) error {
+	return getGlobalValidator().ValidateValue(value, rules, options...)
+}
+
 // Validate validates fields of a structure, or structures of a map, slice or array using the default validator.
-func Validate(value interface{}) core.ErrorList {
-	return getGlobalValidator().Validate(value)
+func Validate(value interface{}, options ...Option) core.ErrorList {
+	return getGlobalValidator().Validate(value, options...)
+}
+
+// ValidateStream validates the records of a stream concurrently using the default validator.
+func ValidateStream(ctx gocontext.Context, records <-chan interface{}, options ...Option) <-chan Result {
+	return getGlobalValidator().ValidateStream(ctx, records, options...)
+}
+
+// ValidateCtx validates like Validate using the default validator, but passes ctx to the validators.
+func ValidateCtx(ctx gocontext.Context, value interface{}, options ...Option) core.ErrorList {
+	return getGlobalValidator().ValidateCtx(ctx, value, options...)
+}
+
+func (this *validator) ValidateFields(value interface{}, fields ...string) core.ErrorList {
+	return this.Validate(value, withFieldSelection(&fieldSelection{paths: fields}))
+}
+
+func (this *validator) ValidateExcept(value interface{}, fields ...string) core.ErrorList {
+	return this.Validate(value, withFieldSelection(&fieldSelection{paths: fields, except: true}))
+}
+
+func (this *validator) ValidateValue(value interface{}, rules string, opts ...Option) error {
+	options := newOptions(this, opts)
+
+	methodGroups, err := parser.Parse(rules)
+
+	if err != nil {
+		return err
+	}
+
+	if methodGroups, err = this.registry.ExpandAliases(methodGroups); err != nil {
+		return err
+	}
+
+	if methodGroups, err = this.registry.ConvertArguments(methodGroups); err != nil {
+		return err
+	}
+
+	normalized, err := this.registry.Normalize(reflect.ValueOf(value))
+
+	if err != nil {
+		return err
+	}
+
+	ctx := gocontext.Background()
+
+	if options.filesystem {
+		ctx = core.WithFilesystemAccess(ctx)
+	}
+
+	ctx = this.withLengthUnit(ctx)
+	ctx = this.withComparers(ctx)
+
+	context := &context{
+		ctx:        ctx,
+		validator:  this,
+		translator: options.translator,
+		groups:     options.groups,
+		report:     options.report,
+		nilPolicy:  this.getNilPolicy(),
+		hooks:      this.getHooks(),
+	}
+
+	recorder, tracer := this.getInstrumentation()
+	context.recorder = recorder
+	context.startValidation(tracer, "validator.ValidateValue")
+
+	field := &core.ReflectedField{
+		Name:         options.fieldName,
+		MethodGroups: methodGroups,
+	}
+
+	// The label is copied, so that it's only allocated for fields that have one.
+	if label, ok := core.GetLabel(methodGroups); ok {
+		displayName := label
+		field.DisplayName = &displayName
+	}
+
+	walkValidateField(context, field, nil, &normalized, reflect.Value{}, reflect.Value{})
+	context.runBatches()
+	context.doneValidation(context.errors)
+
+	if context.errors.Any() {
+		return context.errors
+	}
+
+	return nil
 }
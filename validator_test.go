@@ -1,8 +1,14 @@
 package validator_test
 
 import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
 	. "github.com/typerandom/validator"
 	"github.com/typerandom/validator/core"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -146,3 +152,1288 @@ func TestThatValidatorDoesntValidateNilStructValue(t *testing.T) {
 		t.Fatalf("Expected error to be 'NonNilStruct.Value cannot be empty.' but it was '%s'.", firstError.String())
 	}
 }
+
+func TestThatValidatorCollectsErrorsOfAllFieldsAndValidators(t *testing.T) {
+	type Dummy struct {
+		ValueA string `validate:"not_empty,min(5)"`
+		ValueB string `validate:"unknown_validator"`
+		ValueC int    `validate:"min(10)"`
+	}
+
+	errs := Validate(&Dummy{ValueC: 5})
+
+	if errs.Length() != 4 {
+		t.Fatalf("Expected 4 errors, but got %d.", errs.Length())
+	}
+
+	if fieldErrs := errs.ByField()["ValueA"]; fieldErrs.Length() != 2 {
+		t.Fatalf("Expected 2 errors for field 'ValueA', but got %d.", fieldErrs.Length())
+	}
+
+	if fieldErr := errs.ByField()["ValueB"].First(); fieldErr == nil || fieldErr.Error() != "Validator 'unknown_validator' is not registered." {
+		t.Fatalf("Expected unregistered validator error for field 'ValueB', but got '%v'.", fieldErr)
+	}
+
+	if fieldErr := errs.ByField()["ValueC"].First(); fieldErr == nil || fieldErr.Error() != "ValueC cannot be less than 10." {
+		t.Fatalf("Expected min error for field 'ValueC', but got '%v'.", fieldErr)
+	}
+}
+
+func TestThatValidatorCanValidateWithUnboundedRegexpArgument(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"match(^\\d{4}-\\d{2}$)"`
+	}
+
+	if errs := Validate(&Dummy{Value: "2015-01"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(&Dummy{Value: "2015-1"})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if errs.First().Error() != "Value must match pattern '^\\d{4}-\\d{2}$'." {
+		t.Fatalf("Expected must match pattern error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorCanCompareSiblingFields(t *testing.T) {
+	type Dummy struct {
+		Password        string `validate:"eqfield(PasswordConfirm)"`
+		PasswordConfirm string
+	}
+
+	if errs := Validate(&Dummy{Password: "secret", PasswordConfirm: "secret"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(&Dummy{Password: "secret", PasswordConfirm: "other"})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if errs.First().Error() != "Password must equal PasswordConfirm." {
+		t.Fatalf("Expected must equal field error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorComparesFieldsOfNilEmbeddedPointersAsNil(t *testing.T) {
+	type Base struct {
+		Start int
+	}
+
+	type Dummy struct {
+		*Base
+		End int `validate:"gtfield(Start)"`
+	}
+
+	errs := Validate(&Dummy{End: 5})
+
+	if errs.Length() != 1 || errs.First().Error() != "End must be greater than Start." {
+		t.Fatalf("Expected must be greater than field error, got %s.", errs)
+	}
+
+	if errs := Validate(&Dummy{Base: &Base{Start: 1}, End: 5}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorFailsForMissingFuncMethodOfTopLevelField(t *testing.T) {
+	type Dummy struct {
+		F string `validate:"func(Missing)"`
+	}
+
+	errs := Validate(&Dummy{})
+
+	if errs.Length() != 1 || errs.First().Error() != "Validation method 'Missing' on field 'F' does not exist." {
+		t.Fatalf("Expected missing method error, got %s.", errs)
+	}
+}
+
+type structHookDummy struct {
+	Min int `validate:"min(0)"`
+	Max int
+}
+
+func (this *structHookDummy) ValidateStruct(context core.ValidatorContext) error {
+	if this.Min > this.Max {
+		return errors.New("Min cannot be greater than Max.")
+	}
+	return nil
+}
+
+func TestThatValidatorCallsStructHookAfterFieldValidators(t *testing.T) {
+	errs := Validate(&structHookDummy{Min: -1, Max: -2})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d.", errs.Length())
+	}
+
+	if errs[0].Error() != "Min cannot be less than 0." {
+		t.Fatalf("Expected field error first, got %s.", errs[0])
+	}
+
+	if errs[1].Error() != "Min cannot be greater than Max." {
+		t.Fatalf("Expected struct error last, got %s.", errs[1])
+	}
+
+	if errs := Validate(&structHookDummy{Min: 1, Max: 2}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorReportsNestedStructHookErrorsWithPath(t *testing.T) {
+	type Dummy struct {
+		Range structHookDummy
+	}
+
+	errs := Validate(&Dummy{Range: structHookDummy{Min: 2, Max: 1}})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, but got %d.", errs.Length())
+	}
+
+	if errs.First().GetFieldName() != "Range" {
+		t.Fatalf("Expected error on field 'Range', got '%s'.", errs.First().GetFieldName())
+	}
+
+	if errs.First().Error() != "Min cannot be greater than Max." {
+		t.Fatalf("Expected struct error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorPassesWhenAnyValidatorGroupPasses(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"empty|email"`
+	}
+
+	for _, value := range []string{"", "bob@example.com"} {
+		if errs := Validate(&Dummy{Value: value}); errs.Any() {
+			t.Fatalf("Didn't expect error for '%s', got %s.", value, errs.First())
+		}
+	}
+}
+
+func TestThatValidatorReportsLastGroupWithAlternativesWhenAllGroupsFail(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"empty|email|min(50),uppercase"`
+	}
+
+	errs := Validate(&Dummy{Value: "abc"})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, but got %d.", errs.Length())
+	}
+
+	if errs[0].GetValidatorName() != "min" || errs[1].GetValidatorName() != "uppercase" {
+		t.Fatalf("Expected errors of last group, got '%s' and '%s'.", errs[0].GetValidatorName(), errs[1].GetValidatorName())
+	}
+
+	alternatives := errs[0].Alternatives()
+
+	if alternatives.Length() != 2 {
+		t.Fatalf("Expected 2 alternative errors, but got %d.", alternatives.Length())
+	}
+
+	if alternatives[0].GetValidatorName() != "empty" || alternatives[1].GetValidatorName() != "email" {
+		t.Fatalf("Expected alternatives of 'empty' and 'email', got '%s' and '%s'.", alternatives[0].GetValidatorName(), alternatives[1].GetValidatorName())
+	}
+}
+
+func TestThatValidatorRestoresValueForEachValidatorGroup(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"numeric,min(100)|min(3)"`
+	}
+
+	if errs := Validate(&Dummy{Value: "12"}); !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	} else if errs.First().Error() != "Value cannot be shorter than 3 characters." {
+		t.Fatalf("Expected string length error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorPassesNamedArgumentsToValidator(t *testing.T) {
+	validator := New()
+
+	validator.Register("named", func(ctx core.ValidatorContext, args []interface{}) error {
+		if len(args) != 1 || args[0] != "abc" {
+			return errors.New("Unexpected positional arguments.")
+		}
+		if named := ctx.NamedArguments(); len(named) != 2 || named["min"] != float64(1) || named["max"] != float64(10) {
+			return errors.New("Unexpected named arguments.")
+		}
+		return nil
+	})
+
+	type Dummy struct {
+		Value string `validate:"named(abc,min=1,max=10)"`
+	}
+
+	if errs := validator.Validate(&Dummy{}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorPassesPatternsWithEqualsSignToMatch(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"match(^a=b$)"`
+	}
+
+	if errs := Validate(&Dummy{Value: "a=b"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := Validate(&Dummy{Value: "ab"}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %s.", errs)
+	}
+}
+
+func TestThatBetweenValidatorSupportsNamedBounds(t *testing.T) {
+	type Dummy struct {
+		Age int `validate:"between(min=18,max=99)"`
+	}
+
+	if errs := Validate(&Dummy{Age: 30}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := Validate(&Dummy{Age: 17}); errs.Length() != 1 || errs[0].Error() != "Age must be between 18 and 99." {
+		t.Fatalf("Expected error of 'between', got %s.", errs)
+	}
+}
+
+func TestThatValidatorUsesErrorMessageOfTag(t *testing.T) {
+	type Dummy struct {
+		Password string `validate:"min(8)" errmsg:"Password too short"`
+	}
+
+	errs := Validate(&Dummy{Password: "abc"})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if errs.First().Error() != "Password too short" {
+		t.Fatalf("Expected overridden error message, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorCanTranslateErrorsWithTranslator(t *testing.T) {
+	swedish := core.NewLocale()
+	swedish.Set("min.cannotBeShorterThan", "{field} får inte vara kortare än %v tecken.")
+
+	type Dummy struct {
+		Name string `validate:"min(5)"`
+	}
+
+	errs := Validate(&Dummy{Name: "Bob"}, WithTranslator(swedish))
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "Name får inte vara kortare än 5 tecken."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+
+	if expectedErr := "Name cannot be shorter than 5 characters."; errs.First().Translate(Default().Locale()) != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First().Translate(Default().Locale()))
+	}
+}
+
+func TestThatValidatorCanUseJsonTagAsDisplayName(t *testing.T) {
+	type Dummy struct {
+		UserName string `json:"user_name,omitempty" validate:"not_empty"`
+	}
+
+	validator := New()
+	validator.SetDisplayNameFunc(core.JsonDisplayNameResolver)
+
+	errs := validator.Validate(&Dummy{})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "user_name cannot be empty."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorUsesDisplayNameTagVerbatim(t *testing.T) {
+	type Dummy struct {
+		UserName string `label:"User name, work" validate:"not_empty"`
+	}
+
+	validator := New()
+	validator.SetDisplayNameTag("label")
+
+	errs := validator.Validate(&Dummy{})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "User name, work cannot be empty."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorCanUseDisplayNameFunc(t *testing.T) {
+	type Dummy struct {
+		UserName string `validate:"not_empty"`
+	}
+
+	validator := New()
+	validator.SetDisplayNameFunc(func(field reflect.StructField) string {
+		return strings.ToLower(field.Name)
+	})
+
+	errs := validator.Validate(&Dummy{})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "username cannot be empty."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorUsesLabelAsDisplayName(t *testing.T) {
+	type Dummy struct {
+		UserName string `json:"user_name" validate:"as(User name),not_empty"`
+	}
+
+	validator := New()
+	validator.SetDisplayNameTag("json")
+
+	errs := validator.Validate(&Dummy{})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "User name cannot be empty."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+
+	if err := validator.ValidateValue("", "as(´Email, work´),not_empty"); err == nil || err.Error() != "Email, work cannot be empty." {
+		t.Fatalf("Expected error of label, got %v.", err)
+	}
+}
+
+func TestThatValidatorPassesContextToValidators(t *testing.T) {
+	type contextKey struct{}
+
+	validator := New()
+
+	validator.Register("has_context_value", func(ctx core.ValidatorContext, args []interface{}) error {
+		if ctx.Context().Value(contextKey{}) != "test" {
+			return errors.New("Expected context value.")
+		}
+		return nil
+	})
+
+	type Dummy struct {
+		Value string `validate:"has_context_value"`
+	}
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "test")
+
+	if errs := validator.ValidateCtx(ctx, &Dummy{}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorTreatsNilContextAsBackground(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty"`
+	}
+
+	errs := New().ValidateCtx(nil, &Dummy{})
+
+	if errs.Length() != 1 || errs.First().Error() != "Name cannot be empty." {
+		t.Fatalf("Expected error of 'not_empty', got %s.", errs)
+	}
+}
+
+func TestThatValidatorStopsWhenContextIsCancelled(t *testing.T) {
+	validator := New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	validator.Register("cancel", func(ctx core.ValidatorContext, args []interface{}) error {
+		cancel()
+		return nil
+	})
+
+	type Dummy struct {
+		ValueA string `validate:"cancel"`
+		ValueB string `validate:"not_empty"`
+	}
+
+	errs := validator.ValidateCtx(ctx, []*Dummy{{}, {}})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, but got %d.", errs.Length())
+	}
+
+	if errs.First().Error() != context.Canceled.Error() {
+		t.Fatalf("Expected context cancelled error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorCanCompareParsedTimes(t *testing.T) {
+	type Dummy struct {
+		Date string `validate:"time(DateOnly),min(2015-01-01),max(2015-12-31)"`
+	}
+
+	if errs := Validate(&Dummy{Date: "2015-06-01"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(&Dummy{Date: "2016-01-01"})
+
+	if !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "Date cannot be after 2015-12-31."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorAliasesAreExpanded(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("username", "not_empty,min(3),max(30)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	type Dummy struct {
+		Username string `validate:"username"`
+	}
+
+	if errs := validator.Validate(&Dummy{Username: "john"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&Dummy{Username: "jo"})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if name := errs.First().GetValidatorName(); name != "min" {
+		t.Fatalf("Expected error to be attributed to 'min', got '%s'.", name)
+	}
+}
+
+func TestThatValidatorAliasesCanReferenceOtherAliases(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("short", "max(3)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validator.RegisterAlias("code", "not_empty,short"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	type Dummy struct {
+		Code string `validate:"code"`
+	}
+
+	if errs := validator.Validate(&Dummy{Code: "abcd"}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidatorAliasesWithArgumentsAreInvalid(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("username", "not_empty"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	type Dummy struct {
+		Username string `validate:"username(5)"`
+	}
+
+	if errs := validator.Validate(&Dummy{}); !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatValidatorAliasesOfSingleValidatorTakeArguments(t *testing.T) {
+	type Dummy struct {
+		Email string `validate:"contains(@)"`
+		Name  string `validate:"!contains(@)"`
+	}
+
+	errs := Validate(&Dummy{Email: "john", Name: "john@example.com"})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %s.", errs)
+	}
+
+	if errs[0].Error() != "Email must contain one of the following values '@'." || errs[0].GetValidatorName() != "contain" {
+		t.Fatalf("Expected error of 'contain', got %s.", errs[0])
+	}
+
+	if errs[1].Error() != "Name cannot contain any of the following values '@'." {
+		t.Fatalf("Expected negated error of 'contain', got %s.", errs[1])
+	}
+}
+
+func TestThatValidatorAliasesCannotReferenceThemselves(t *testing.T) {
+	validator := New()
+
+	if err := validator.RegisterAlias("loop", "not_empty,loop"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	type Dummy struct {
+		Value string `validate:"loop"`
+	}
+
+	if errs := validator.Validate(&Dummy{}); !errs.Any() {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatValidatorAliasesCannotContainGroups(t *testing.T) {
+	if err := New().RegisterAlias("choice", "min(3)|empty"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatValidateValueValidatesSingleValues(t *testing.T) {
+	if err := ValidateValue("john@doe.com", "not_empty,email"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	err := ValidateValue("", "not_empty")
+
+	if err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "Value cannot be empty."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+
+	errs, ok := err.(core.ErrorList)
+
+	if !ok || errs.First().GetValidatorName() != "not_empty" {
+		t.Fatalf("Expected error list with 'not_empty' error, got %#v.", err)
+	}
+}
+
+func TestThatValidateValueUsesFieldName(t *testing.T) {
+	err := ValidateValue(2, "min(3)", WithFieldName("Age"))
+
+	if err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if expectedErr := "Age cannot be less than 3."; err.Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, err)
+	}
+}
+
+func TestThatValidateValueReturnsErrorForInvalidRules(t *testing.T) {
+	if err := ValidateValue("", "min("); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatFilesystemValidatorsRequireAllowFilesystemOption(t *testing.T) {
+	type Dummy struct {
+		Path string `validate:"dir_exists"`
+	}
+
+	dummy := &Dummy{Path: t.TempDir()}
+
+	if errs := Validate(dummy); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := Validate(dummy, AllowFilesystem()); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+type adapterTestDecimal struct {
+	units int64
+	cents int64
+}
+
+func newAdapterTestValidator() Validator {
+	validator := New()
+
+	validator.RegisterTypeAdapter(reflect.TypeOf(adapterTestDecimal{}), func(value interface{}) (interface{}, bool) {
+		decimal, ok := value.(adapterTestDecimal)
+
+		if !ok {
+			return nil, false
+		}
+
+		return float64(decimal.units) + float64(decimal.cents)/100, true
+	})
+
+	return validator
+}
+
+func TestThatValidatorTypeAdaptersConvertValues(t *testing.T) {
+	validator := newAdapterTestValidator()
+
+	type Dummy struct {
+		Price adapterTestDecimal `validate:"min(1),max(10)"`
+	}
+
+	if errs := validator.Validate(&Dummy{Price: adapterTestDecimal{units: 9, cents: 99}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := validator.Validate(&Dummy{Price: adapterTestDecimal{units: 10, cents: 1}})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if name := errs.First().GetValidatorName(); name != "max" {
+		t.Fatalf("Expected error to be attributed to 'max', got '%s'.", name)
+	}
+}
+
+func TestThatValidatorTypeAdaptersConvertPointers(t *testing.T) {
+	validator := newAdapterTestValidator()
+
+	type Dummy struct {
+		Price *adapterTestDecimal `validate:"empty|min(1)"`
+	}
+
+	if errs := validator.Validate(&Dummy{}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := validator.Validate(&Dummy{Price: &adapterTestDecimal{cents: 50}}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatSqlNullTypesAreValidatedAsNilIfNotValid(t *testing.T) {
+	type Dummy struct {
+		Name  sql.NullString  `validate:"required"`
+		Age   sql.NullInt64   `validate:"omitempty,min(18)"`
+		Email *sql.NullString `validate:"not_empty"`
+	}
+
+	errs := Validate(&Dummy{Age: sql.NullInt64{Int64: 10}})
+
+	if errs.Length() != 2 || errs[0].GetFieldName() != "Name" || errs[1].GetFieldName() != "Email" {
+		t.Fatalf("Expected errors of 'Name' and 'Email', got %s.", errs)
+	}
+
+	email := sql.NullString{String: "john@doe.com", Valid: true}
+
+	if errs := Validate(&Dummy{Name: sql.NullString{String: "John", Valid: true}, Email: &email}); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+
+	errs = Validate(&Dummy{Name: sql.NullString{Valid: true}, Age: sql.NullInt64{Int64: 10, Valid: true}, Email: &email})
+
+	if errs.Length() != 1 || errs.First().GetValidatorName() != "min" {
+		t.Fatalf("Expected error of 'min', got %s.", errs)
+	}
+}
+
+type optionTestString struct {
+	value string
+	set   bool
+}
+
+func TestThatValidatorOptionTypesAreValidatedAsNilIfNotSet(t *testing.T) {
+	validator := New()
+
+	validator.RegisterOptionType(reflect.TypeOf(optionTestString{}), func(value interface{}) (interface{}, bool) {
+		option := value.(optionTestString)
+		return option.value, option.set
+	})
+
+	type Dummy struct {
+		Name optionTestString `validate:"omitempty,min(3)"`
+	}
+
+	if errs := validator.Validate(&Dummy{Name: optionTestString{value: "ab"}}); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+
+	if errs := validator.Validate(&Dummy{Name: optionTestString{value: "ab", set: true}}); errs.Length() != 1 || errs.First().GetValidatorName() != "min" {
+		t.Fatalf("Expected error of 'min', got %s.", errs)
+	}
+}
+
+func TestThatValidatorTypeAdaptersAreUsedBySiblingValidators(t *testing.T) {
+	validator := newAdapterTestValidator()
+
+	type Dummy struct {
+		Minimum adapterTestDecimal
+		Maximum adapterTestDecimal `validate:"gtfield(Minimum)"`
+	}
+
+	if errs := validator.Validate(&Dummy{Minimum: adapterTestDecimal{units: 1}, Maximum: adapterTestDecimal{units: 2}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := validator.Validate(&Dummy{Minimum: adapterTestDecimal{units: 2}, Maximum: adapterTestDecimal{units: 1}}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidatorTypeAdaptersAreUsedByValidateValue(t *testing.T) {
+	validator := newAdapterTestValidator()
+
+	if err := validator.ValidateValue(adapterTestDecimal{units: 4}, "max(4)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validator.ValidateValue(adapterTestDecimal{units: 5}, "max(4)"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+type comparerTestVersion struct {
+	major, minor int
+}
+
+func TestThatValidatorComparersAreUsedByMinMaxAndBetween(t *testing.T) {
+	validator := New()
+
+	validator.RegisterComparer(reflect.TypeOf(comparerTestVersion{}), func(value interface{}, argument interface{}) (int, bool) {
+		var bound comparerTestVersion
+
+		if text, ok := argument.(string); !ok {
+			return 0, false
+		} else if _, err := fmt.Sscanf(text, "v%d.%d", &bound.major, &bound.minor); err != nil {
+			return 0, false
+		}
+
+		version := value.(comparerTestVersion)
+
+		if version.major != bound.major {
+			return version.major - bound.major, true
+		}
+
+		return version.minor - bound.minor, true
+	})
+
+	type Dummy struct {
+		Version *comparerTestVersion `validate:"min(v1.2),max(v2.0),between(v1.0,v3.0)"`
+	}
+
+	if errs := validator.Validate(&Dummy{Version: &comparerTestVersion{1, 10}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+
+	if errs := validator.Validate(&Dummy{Version: &comparerTestVersion{1, 1}}); errs.Length() != 1 || errs.First().GetValidatorName() != "min" {
+		t.Fatalf("Expected error of 'min', got %s.", errs)
+	}
+
+	if errs := validator.Validate(&Dummy{Version: &comparerTestVersion{2, 1}}); errs.Length() != 1 || errs.First().GetValidatorName() != "max" {
+		t.Fatalf("Expected error of 'max', got %s.", errs)
+	}
+
+	if err := validator.ValidateValue(comparerTestVersion{3, 1}, "between(v1.0,v3.0)"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func newWarningTestValidator() Validator {
+	validator := New()
+
+	validator.RegisterWarning("deprecated_format", func(context core.ValidatorContext, args []interface{}) error {
+		if value, ok := context.Value().(string); ok && strings.HasPrefix(value, "00") {
+			return errors.New("{field} uses a deprecated format.")
+		}
+		return nil
+	})
+
+	return validator
+}
+
+func TestThatValidatorWarningsDontFailValidation(t *testing.T) {
+	validator := newWarningTestValidator()
+
+	type Dummy struct {
+		Phone string `validate:"not_empty,deprecated_format"`
+	}
+
+	errs := validator.Validate(&Dummy{Phone: "0046701234567"})
+
+	if errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 warning, got %d.", errs.Warnings().Length())
+	}
+
+	if expected := "Phone uses a deprecated format."; errs.Warnings().First().Error() != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, errs.Warnings().First())
+	}
+}
+
+func TestThatValidatorWarningsAreReportedWithErrors(t *testing.T) {
+	validator := newWarningTestValidator()
+
+	type Dummy struct {
+		Phone string `validate:"min(20),deprecated_format"`
+		Name  string `validate:"not_empty"`
+	}
+
+	errs := validator.Validate(&Dummy{Phone: "0046701234567"}, MaxErrors(1))
+
+	if errs.Errors().Length() != 1 || errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 error and 1 warning, got %d errors and %d warnings.", errs.Errors().Length(), errs.Warnings().Length())
+	}
+}
+
+func TestThatValidatorWarningsDontCountTowardsMaxErrors(t *testing.T) {
+	validator := newWarningTestValidator()
+
+	type Dummy struct {
+		Phone string `validate:"deprecated_format"`
+		Name  string `validate:"not_empty"`
+	}
+
+	errs := validator.Validate(&Dummy{Phone: "0046701234567"}, FailFast())
+
+	if errs.Errors().Length() != 1 || errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 error and 1 warning, got %d errors and %d warnings.", errs.Errors().Length(), errs.Warnings().Length())
+	}
+}
+
+func TestThatValidateValueDoesntFailForWarnings(t *testing.T) {
+	validator := newWarningTestValidator()
+
+	if err := validator.ValidateValue("0046701234567", "deprecated_format"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+type nilPolicyDummy struct {
+	Min   *int    `validate:"min(1)"`
+	Max   *int    `validate:"max(10)"`
+	Name  *string `validate:"not_empty"`
+	Email *string `validate:"nil|email"`
+}
+
+func TestThatValidatorFailsNilValuesByDefault(t *testing.T) {
+	errs := New().Validate(&nilPolicyDummy{})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	if expectedErr := "Min cannot be nil."; errs[0].Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs[0])
+	}
+
+	if expectedErr := "Max cannot be nil."; errs[1].Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs[1])
+	}
+
+	if name := errs[2].GetValidatorName(); name != "not_empty" {
+		t.Fatalf("Expected error to be attributed to 'not_empty', got '%s'.", name)
+	}
+}
+
+func TestThatValidatorCanSkipNilValues(t *testing.T) {
+	validator := New()
+	validator.SetNilPolicy(core.SkipNil)
+
+	errs := validator.Validate(&nilPolicyDummy{})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if name := errs.First().GetValidatorName(); name != "not_empty" {
+		t.Fatalf("Expected error to be attributed to 'not_empty', got '%s'.", name)
+	}
+}
+
+func TestThatValidatorCanValidateNilValuesAsZeroValues(t *testing.T) {
+	validator := New()
+	validator.SetNilPolicy(core.ZeroValueNil)
+
+	errs := validator.Validate(&nilPolicyDummy{})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	if expectedErr := "Min cannot be less than 1."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorNilPolicyIsCopied(t *testing.T) {
+	validator := New()
+	validator.SetNilPolicy(core.SkipNil)
+
+	if errs := validator.Copy().Validate(&nilPolicyDummy{}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidatorNilHandlersReceiveNilValues(t *testing.T) {
+	validator := New()
+	validator.SetNilPolicy(core.SkipNil)
+
+	called := false
+
+	validator.RegisterNilHandler("present", func(context core.ValidatorContext, args []interface{}) error {
+		called = true
+		if context.IsNil() {
+			return errors.New("{field} must be present.")
+		}
+		return nil
+	})
+
+	type Dummy struct {
+		Value *int `validate:"present"`
+	}
+
+	if errs := validator.Validate(&Dummy{}); !called || errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidatorDistinguishesRequiredFromNotEmpty(t *testing.T) {
+	type Dummy struct {
+		Name *string `validate:"required"`
+		Bio  *string `validate:"required,not_empty"`
+	}
+
+	empty := ""
+
+	if errs := Validate(&Dummy{Name: &empty, Bio: &empty}); errs.Length() != 1 || errs.First().GetValidatorName() != "not_empty" {
+		t.Fatalf("Expected 1 error of 'not_empty', got %d errors.", errs.Length())
+	}
+
+	errs := Validate(&Dummy{})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	if expectedErr := "Name is required."; errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got '%s'.", expectedErr, errs.First())
+	}
+}
+
+func TestThatValidatorCanUseCustomTag(t *testing.T) {
+	type Dummy struct {
+		Name  string `valid:"not_empty"`
+		Email string `validate:"not_empty"`
+	}
+
+	validator := New(WithTag("valid"))
+
+	errs := validator.Validate(&Dummy{})
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "Name" {
+		t.Fatalf("Expected 1 error of 'Name', got %d errors.", errs.Length())
+	}
+
+	if errs := validator.Copy().Validate(&Dummy{}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatValidatorMergesRulesOfTags(t *testing.T) {
+	type Dummy struct {
+		Name  string `binding:"not_empty" validate:"max(3)"`
+		Email string `validate:"empty|email"`
+	}
+
+	validator := New(WithTag("binding", "validate"))
+
+	if errs := validator.Validate(&Dummy{Name: "John", Email: "john"}); errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	if errs := validator.Validate(&Dummy{}); errs.Length() != 1 || errs.First().GetValidatorName() != "not_empty" {
+		t.Fatalf("Expected 1 error of 'not_empty', got %d errors.", errs.Length())
+	}
+}
+
+func TestThatValidatorCountsLengthOfStringsInLengthUnit(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"max(5)"`
+	}
+
+	dummy := &Dummy{Name: "he\u0301llo"}
+
+	if errs := New().Validate(dummy); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	validator := New()
+	validator.SetLengthUnit(core.GraphemeLength)
+
+	if errs := validator.Validate(dummy); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if err := validator.Copy().ValidateValue("héllo", "length(5)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := New().ValidateValue("héllo", "length(5)"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	validator.SetLengthUnit(core.RuneLength)
+
+	if err := validator.ValidateValue("héllo", "length(5)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+type funcValueDummy struct {
+	Username string `validate:"func(CheckUsername)"`
+}
+
+func (this *funcValueDummy) CheckUsername(username string) error {
+	if username == "root" {
+		return errors.New("{field} is reserved.")
+	}
+	return nil
+}
+
+func TestThatFuncValidatorCallsMethodWithValueOfField(t *testing.T) {
+	if errs := Validate(&funcValueDummy{Username: "john"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(funcValueDummy{Username: "root"})
+
+	if expectedErr := "Username is reserved."; errs.Length() != 1 || errs.First().Error() != expectedErr {
+		t.Fatalf("Expected '%s', got %v.", expectedErr, errs)
+	}
+}
+
+type abortInnerDummy struct {
+	Code string `validate:"abort,not_empty"`
+	Name string `validate:"not_empty"`
+}
+
+type abortDummy struct {
+	Name  string `validate:"skip,not_empty"`
+	Inner abortInnerDummy
+	Email string `validate:"not_empty"`
+}
+
+func (this abortInnerDummy) ValidateStruct(context core.ValidatorContext) error {
+	return errors.New("Aborted struct was validated.")
+}
+
+func newSkippingValidator() Validator {
+	validator := New()
+	validator.Register("skip", func(context core.ValidatorContext, args []interface{}) error {
+		context.SkipField()
+		return nil
+	})
+	validator.Register("abort", func(context core.ValidatorContext, args []interface{}) error {
+		context.AbortStruct()
+		return nil
+	})
+	return validator
+}
+
+func TestThatValidatorCanSkipFieldAndAbortStruct(t *testing.T) {
+	errs := newSkippingValidator().Validate(&abortDummy{})
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d (%s).", errs.Length(), errs)
+	}
+
+	if errs.First().GetFieldName() != "Email" {
+		t.Fatalf("Expected error of field 'Email', got %s.", errs.First().GetFieldName())
+	}
+}
+
+func TestThatSkipFieldSkipsAlternativeGroups(t *testing.T) {
+	validator := newSkippingValidator()
+
+	if err := validator.ValidateValue("", "skip,email|not_empty"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	errs, _ := validator.ValidateValue("", "min(1),skip|not_empty").(core.ErrorList)
+
+	if errs.Length() != 1 || errs.First().GetValidatorName() != "min" || errs.First().Alternatives().Any() {
+		t.Fatalf("Expected error of 'min' without alternatives, got %s.", errs)
+	}
+}
+
+func TestThatValidatorOmitsEmptyFields(t *testing.T) {
+	if err := ValidateValue("", "omitempty,email"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := ValidateValue((*string)(nil), "omitempty,not_empty"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := ValidateValue("invalid", "omitempty,email"); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatClonedValidatorsDoNotShareRegisteredValidators(t *testing.T) {
+	validatorA := New()
+	validatorB := validatorA.Clone()
+
+	validatorB.Register("numeric", func(context core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if err := validatorA.ValidateValue("abc", "numeric"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err := validatorB.ValidateValue("abc", "numeric"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatValidatorCopyDoesNotShareRegisteredValidators(t *testing.T) {
+	validatorA := New()
+	validatorB := validatorA.Copy()
+
+	validatorB.Register("copied", func(context core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if err := validatorA.ValidateValue("abc", "copied"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+}
+
+func TestThatValidatorsUseValidatorsOfBaseRegistry(t *testing.T) {
+	base := NewRegistry()
+
+	base.Register("is_shared", func(context core.ValidatorContext, args []interface{}) error {
+		return context.NewError("shared")
+	})
+
+	validatorA := New(WithBaseRegistry(base))
+	validatorB := New(WithBaseRegistry(base))
+
+	validatorB.Register("is_shared", func(context core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	if err := validatorA.ValidateValue("abc", "is_shared"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err := validatorB.ValidateValue("abc", "is_shared"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := validatorA.ValidateValue("", "not_empty"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if _, err := base.Get("is_shared"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if validatorB.Registry().Base() != base {
+		t.Fatalf("Expected base registry, got %v.", validatorB.Registry().Base())
+	}
+}
+
+func TestThatValidatorsUseAliasesOfBaseRegistry(t *testing.T) {
+	base := NewRegistry()
+
+	if err := base.RegisterAlias("username", "not_empty,min(3)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	validator := New(WithBaseRegistry(base))
+
+	if err := validator.ValidateValue("ab", "username"); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err := validator.ValidateValue("abc", "username"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatErrorsOfDefaultValidatorsCanBeFound(t *testing.T) {
+	err := ValidateValue("", "not_empty")
+
+	if !errors.Is(err, ErrNotEmpty) || errors.Is(err, ErrMin) {
+		t.Fatalf("Expected error of 'not_empty' only, got %s.", err)
+	}
+
+	var fieldErr *core.Error
+
+	if !errors.As(err, &fieldErr) || fieldErr.GetValidatorName() != "not_empty" {
+		t.Fatalf("Expected error of 'not_empty', got %v.", fieldErr)
+	}
+}
+
+func TestThatValidatorDescribesRegisteredValidators(t *testing.T) {
+	validator := New()
+
+	validator.RegisterPure("is_code", func(context core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	validator.SetDescription("is_code", core.Description{Summary: "Validates codes.", Usage: "is_code"})
+
+	if err := validator.RegisterAlias("username", "not_empty,min(3)"); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	described := make(map[string]core.Description)
+
+	for _, description := range validator.Describe() {
+		described[description.Name] = description
+	}
+
+	if code := described["is_code"]; code.Summary != "Validates codes." || !code.Pure {
+		t.Fatalf("Expected description of pure validator 'is_code', got %+v.", code)
+	}
+
+	if username := described["username"]; len(username.Alias) != 2 {
+		t.Fatalf("Expected description of alias 'username', got %+v.", username)
+	}
+
+	if trim := described["trim"]; !trim.Transformer || len(trim.Summary) == 0 {
+		t.Fatalf("Expected description of transformer 'trim', got %+v.", trim)
+	}
+
+	validator.Register("is_code", func(context core.ValidatorContext, args []interface{}) error {
+		return nil
+	})
+
+	for _, description := range validator.Describe() {
+		if description.Name == "is_code" && (description.Pure || len(description.Summary) > 0) {
+			t.Fatalf("Expected description to be removed, got %+v.", description)
+		}
+	}
+}
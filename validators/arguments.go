@@ -0,0 +1,109 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+var (
+	noArguments          = &core.ArgumentSchema{}
+	singleArgument       = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentAny}, Required: 1}
+	oneOrMoreArguments   = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentAny}, Required: 1, Variadic: true}
+	anyNumberOfArguments = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentAny}, Variadic: true}
+	singleNumber         = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentNumber}, Required: 1}
+	singleString         = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentString}, Required: 1}
+	oneOrMoreStrings     = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentString}, Required: 1, Variadic: true}
+	optionalString       = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentString}}
+	optionalStrings      = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentString}, Variadic: true}
+	fieldValuePairs      = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentString, core.ArgumentAny}, Required: 2, Variadic: true}
+)
+
+// defaultSchemas declare the arguments of the default validators by name, so that tags with too few, too many or
+// mistyped arguments fail to compile. Arguments whose type depends on the value, i.e. of `min` for times and numbers,
+// are only counted.
+var defaultSchemas = map[string]*core.ArgumentSchema{
+	"not":             singleArgument,
+	"nil":             noArguments,
+	"empty":           noArguments,
+	"not_empty":       noArguments,
+	"omitempty":       noArguments,
+	"min":             singleArgument,
+	"max":             singleArgument,
+	"lowercase":       noArguments,
+	"uppercase":       noArguments,
+	"contain":         singleString,
+	"starts_with":     oneOrMoreStrings,
+	"ends_with":       oneOrMoreStrings,
+	"excludes":        oneOrMoreStrings,
+	"alpha":           noArguments,
+	"alphanum":        noArguments,
+	"ascii":           noArguments,
+	"printable":       noArguments,
+	"equal":           singleString,
+	"numeric":         noArguments,
+	"integer":         optionalString,
+	"decimal":         optionalString,
+	"hex":             optionalString,
+	"base64":          noArguments,
+	"json":            optionalString,
+	"xml":             optionalString,
+	"time":            optionalString,
+	"iso8601":         noArguments,
+	"func":            {Types: []core.ArgumentType{core.ArgumentString, core.ArgumentAny}, Variadic: true},
+	"email":           optionalString,
+	"url":             optionalStrings,
+	"uuid":            anyNumberOfArguments,
+	"ip":              noArguments,
+	"ipv4":            noArguments,
+	"ipv6":            noArguments,
+	"cidr":            noArguments,
+	"mac":             noArguments,
+	"hostname":        noArguments,
+	"fqdn":            noArguments,
+	"semver":          {Named: []string{"prerelease", "build"}},
+	"eqfield":         singleString,
+	"nefield":         singleString,
+	"gtfield":         singleString,
+	"gtefield":        singleString,
+	"ltfield":         singleString,
+	"ltefield":        singleString,
+	"required":        noArguments,
+	"required_if":     fieldValuePairs,
+	"required_unless": fieldValuePairs,
+	"phone":           optionalString,
+	"luhn":            noArguments,
+	"creditcard":      optionalStrings,
+	"iban":            noArguments,
+	"iso3166":         optionalStrings,
+	"iso4217":         noArguments,
+	"bcp47":           noArguments,
+	"timezone":        noArguments,
+	"password":        {Named: []string{"classes", "upper", "lower", "digit", "symbol", "entropy"}},
+	"filepath":        noArguments,
+	"file_exists":     noArguments,
+	"dir_exists":      noArguments,
+	"between":         {Types: []core.ArgumentType{core.ArgumentAny, core.ArgumentAny}, Required: 2, Named: []string{"min", "max"}},
+	"gt":              singleNumber,
+	"gte":             singleNumber,
+	"lt":              singleNumber,
+	"lte":             singleNumber,
+	"positive":        noArguments,
+	"negative":        noArguments,
+	"non_negative":    noArguments,
+	"one_of":          oneOrMoreArguments,
+	"as_string":       noArguments,
+	"trim":            noArguments,
+	"lower":           noArguments,
+	"upper":           noArguments,
+	"truncate":        singleNumber,
+	"to_int":          noArguments,
+	"to_float":        noArguments,
+	"to_bool":         noArguments,
+	"to_time":         optionalString,
+}
+
+// RegisterDefaultSchemas declares the arguments of the default validators of a registry.
+func RegisterDefaultSchemas(r *core.ValidatorRegistry) {
+	for name, schema := range defaultSchemas {
+		r.SetSchema(name, schema)
+	}
+}
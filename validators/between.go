@@ -0,0 +1,88 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"time"
+)
+
+// BetweenValidator validates that a number or time is within an inclusive range, i.e. `between(1,10)` or
+// `between(min=1,max=10)`.
+func BetweenValidator(context core.ValidatorContext, args []interface{}) error {
+	args = betweenBounds(context, args)
+
+	if len(args) != 2 {
+		return context.NewError("arguments.twoRequired")
+	}
+
+	value := context.Value()
+
+	if compare, ok := core.ComparerOf(context.Context(), value); ok {
+		minResult, err := compareWithComparer(context, compare, args[0], 1)
+
+		if err != nil {
+			return err
+		}
+
+		maxResult, err := compareWithComparer(context, compare, args[1], 2)
+
+		if err != nil {
+			return err
+		}
+
+		if minResult < 0 || maxResult > 0 {
+			return context.NewError("between.mustBeBetween", args[0], args[1])
+		}
+
+		return nil
+	}
+
+	bounds := make([]interface{}, len(args))
+
+	for i, arg := range args {
+		switch value.(type) {
+		case int64, uint64, float64:
+			if !isNumber(arg) {
+				return context.NewError("arguments.invalidType", i+1, "number")
+			}
+			bounds[i] = arg
+		case time.Time:
+			timeArg, ok := parseTimeArgument(arg)
+
+			if !ok {
+				return context.NewError("arguments.invalidType", i+1, "time")
+			}
+
+			bounds[i] = timeArg
+		default:
+			return context.NewError("type.unsupported")
+		}
+	}
+
+	minResult, _ := compareValues(value, bounds[0])
+	maxResult, _ := compareValues(value, bounds[1])
+
+	if minResult < 0 || maxResult > 0 {
+		return context.NewError("between.mustBeBetween", args[0], args[1])
+	}
+
+	return nil
+}
+
+// betweenBounds appends the named bounds of a range to the positional bounds, i.e. `between(1,max=10)`.
+func betweenBounds(context core.ValidatorContext, args []interface{}) []interface{} {
+	named := context.NamedArguments()
+
+	if len(named) == 0 {
+		return args
+	}
+
+	bounds := append([]interface{}{}, args...)
+
+	for _, name := range []string{"min", "max"} {
+		if value, ok := named[name]; ok {
+			bounds = append(bounds, value)
+		}
+	}
+
+	return bounds
+}
@@ -0,0 +1,86 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+	"time"
+)
+
+func TestThatBetweenValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext(5)
+
+	if err := BetweenValidator(ctx, []interface{}{float64(1)}); err == nil || err.Error() != "arguments.twoRequired" {
+		t.Fatalf("Expected two arguments required error, got %v.", err)
+	}
+
+	if err := BetweenValidator(ctx, []interface{}{float64(1), "abc"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatBetweenValidatorSucceedsForNumbersWithinRange(t *testing.T) {
+	for _, value := range []interface{}{1, 5, 10, uint(7), 2.5} {
+		if err := BetweenValidator(core.NewTestContext(value), []interface{}{float64(1), float64(10)}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+}
+
+func TestThatBetweenValidatorFailsForNumbersOutsideRange(t *testing.T) {
+	for _, value := range []interface{}{0, 11, -5, 10.5} {
+		err := BetweenValidator(core.NewTestContext(value), []interface{}{float64(1), float64(10)})
+
+		if err == nil || err.Error() != "between.mustBeBetween" {
+			t.Fatalf("Expected between error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatBetweenValidatorValidatesNilNumberAsZero(t *testing.T) {
+	var dummy *int
+
+	if err := BetweenValidator(core.NewTestContext(dummy), []interface{}{float64(0), float64(10)}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := BetweenValidator(core.NewTestContext(dummy), []interface{}{float64(1), float64(10)}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatBetweenValidatorSupportsTime(t *testing.T) {
+	args := []interface{}{"2015-01-01", "2015-12-31"}
+
+	if err := BetweenValidator(core.NewTestContext(time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)), args); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := BetweenValidator(core.NewTestContext(time.Date(2016, 6, 1, 0, 0, 0, 0, time.UTC)), args); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatBetweenValidatorFailsForUnsupportedType(t *testing.T) {
+	if err := BetweenValidator(core.NewTestContext("abc"), []interface{}{float64(1), float64(10)}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
+
+func TestThatBetweenValidatorComparesWithRegisteredComparer(t *testing.T) {
+	args := []interface{}{float64(100), float64(200)}
+
+	if err := BetweenValidator(newMoneyContext(testMoney{150}), args); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	for _, value := range []testMoney{{99}, {201}} {
+		if err := BetweenValidator(newMoneyContext(value), args); err == nil || err.Error() != "between.mustBeBetween" {
+			t.Fatalf("Expected between error for %v, got %v.", value, err)
+		}
+	}
+
+	if err := BetweenValidator(newMoneyContext(testMoney{150}), []interface{}{float64(100), "abc"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
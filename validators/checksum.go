@@ -0,0 +1,163 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// isLuhnValid checks the Luhn checksum of a string of digits.
+func isLuhnValid(digits string) bool {
+	var sum int
+	double := false
+
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+
+		if double {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// LuhnValidator validates the Luhn checksum of a string of digits, or of a positive integer.
+func LuhnValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	var digits string
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		digits = typedValue
+	case int64:
+		digits = strconv.FormatInt(typedValue, 10)
+	case uint64:
+		digits = strconv.FormatUint(typedValue, 10)
+	default:
+		return context.NewError("type.unsupported")
+	}
+
+	if !digitsPattern.MatchString(digits) || !isLuhnValid(digits) {
+		return context.NewError("luhn.mustHaveValidChecksum")
+	}
+
+	return nil
+}
+
+var creditCardBrands = map[string]*regexp.Regexp{
+	"visa":       regexp.MustCompile(`^4[0-9]{12}([0-9]{3}){0,2}$`),
+	"mastercard": regexp.MustCompile(`^(5[1-5][0-9]{4}|222[1-9][0-9]{2}|22[3-9][0-9]{3}|2[3-6][0-9]{4}|27[01][0-9]{3}|2720[0-9]{2})[0-9]{10}$`),
+	"amex":       regexp.MustCompile(`^3[47][0-9]{13}$`),
+	"discover":   regexp.MustCompile(`^6(011|5[0-9]{2}|4[4-9][0-9])[0-9]{12,15}$`),
+	"dinersclub": regexp.MustCompile(`^3(0[0-5]|[68][0-9])[0-9]{11,16}$`),
+	"jcb":        regexp.MustCompile(`^35(2[89]|[3-8][0-9])[0-9]{12,15}$`),
+	"unionpay":   regexp.MustCompile(`^62[0-9]{14,17}$`),
+}
+
+var creditCardReplacer = strings.NewReplacer(" ", "", "-", "")
+
+// CreditCardValidator validates credit card numbers by length and Luhn checksum. Spaces and dashes are ignored.
+// Arguments restrict the accepted brands, i.e. `creditcard(visa,mastercard)`.
+func CreditCardValidator(context core.ValidatorContext, args []interface{}) error {
+	var brands []string
+
+	for i, arg := range args {
+		brand, ok := arg.(string)
+
+		if !ok {
+			return context.NewError("arguments.invalidType", i+1, "string")
+		}
+
+		if _, ok := creditCardBrands[brand]; !ok {
+			return context.NewError("arguments.invalid")
+		}
+
+		brands = append(brands, brand)
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		number := creditCardReplacer.Replace(typedValue)
+
+		if len(number) < 12 || len(number) > 19 || !digitsPattern.MatchString(number) || !isLuhnValid(number) {
+			return context.NewError("creditCard.mustBeValidCreditCard")
+		}
+
+		if len(brands) == 0 {
+			return nil
+		}
+
+		for _, brand := range brands {
+			if creditCardBrands[brand].MatchString(number) {
+				return nil
+			}
+		}
+
+		return context.NewError("creditCard.mustBeBrand", strings.Join(brands, ", "))
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// ibanLengths holds the length of IBANs by country code.
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BR": 29,
+	"BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "DO": 28, "EE": 20, "EG": 29,
+	"ES": 24, "FI": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23, "GL": 18, "GR": 27, "GT": 28,
+	"HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27, "JO": 30, "KW": 30, "KZ": 20,
+	"LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "MC": 27, "MD": 24, "ME": 22, "MK": 19,
+	"MR": 27, "MT": 31, "MU": 30, "NL": 18, "NO": 15, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29,
+	"RO": 24, "RS": 22, "SA": 24, "SC": 31, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+var ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+
+// IbanValidator validates International Bank Account Numbers by country length and mod 97 checksum.
+// Spaces are ignored, i.e. `SE45 5000 0000 0583 9825 7466`.
+func IbanValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		iban := strings.ToUpper(strings.Replace(typedValue, " ", "", -1))
+
+		if !ibanPattern.MatchString(iban) || ibanLengths[iban[:2]] != len(iban) {
+			return context.NewError("iban.mustBeValidIban")
+		}
+
+		// Move the country code and check digits to the end, and replace letters with numbers, i.e. A = 10.
+		var digits strings.Builder
+
+		for _, char := range iban[4:] + iban[:4] {
+			if char >= 'A' && char <= 'Z' {
+				digits.WriteString(strconv.Itoa(int(char-'A') + 10))
+			} else {
+				digits.WriteRune(char)
+			}
+		}
+
+		number, _ := new(big.Int).SetString(digits.String(), 10)
+
+		if new(big.Int).Mod(number, big.NewInt(97)).Int64() != 1 {
+			return context.NewError("iban.mustBeValidIban")
+		}
+
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
@@ -0,0 +1,69 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatLuhnValidatorValidatesChecksum(t *testing.T) {
+	for _, value := range []interface{}{"79927398713", 79927398713, "0"} {
+		if err := LuhnValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []interface{}{"79927398710", "", "7992-7398-713", 79927398710} {
+		if err := LuhnValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "luhn.mustHaveValidChecksum" {
+			t.Fatalf("Expected checksum error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatCreditCardValidatorFailsForInvalidOptions(t *testing.T) {
+	if err := CreditCardValidator(core.NewTestContext("4111111111111111"), []interface{}{"unknown"}); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+}
+
+func TestThatCreditCardValidatorValidatesNumbers(t *testing.T) {
+	for _, value := range []string{"4111111111111111", "4111 1111 1111 1111", "5500-0000-0000-0004", "378282246310005"} {
+		if err := CreditCardValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "4111111111111112", "1234", "4111a11111111111"} {
+		if err := CreditCardValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "creditCard.mustBeValidCreditCard" {
+			t.Fatalf("Expected credit card error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatCreditCardValidatorValidatesBrands(t *testing.T) {
+	args := []interface{}{"visa", "amex"}
+
+	for _, value := range []string{"4111111111111111", "378282246310005"} {
+		if err := CreditCardValidator(core.NewTestContext(value), args); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	if err := CreditCardValidator(core.NewTestContext("5500000000000004"), args); err == nil || err.Error() != "creditCard.mustBeBrand" {
+		t.Fatalf("Expected brand error, got %v.", err)
+	}
+}
+
+func TestThatIbanValidatorValidatesIbans(t *testing.T) {
+	for _, value := range []string{"SE4550000000058398257466", "SE45 5000 0000 0583 9825 7466", "GB82WEST12345698765432", "de89370400440532013000"} {
+		if err := IbanValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "SE4550000000058398257467", "GB82WEST1234569876543", "XX82WEST12345698765432"} {
+		if err := IbanValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "iban.mustBeValidIban" {
+			t.Fatalf("Expected IBAN error for '%s', got %v.", value, err)
+		}
+	}
+}
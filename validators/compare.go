@@ -0,0 +1,114 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"math"
+	"reflect"
+)
+
+// compareWithComparer compares the value with the argument at index by the comparer registered for the type of the
+// value. Returns error if the argument can't be compared with the value.
+func compareWithComparer(context core.ValidatorContext, compare core.Comparer, arg interface{}, index int) (int, error) {
+	result, ok := compare(context.Value(), arg)
+
+	if !ok {
+		return 0, context.NewError("arguments.invalidType", index, reflect.TypeOf(context.Value()).String())
+	}
+
+	return result, nil
+}
+
+// compareNumber compares the value, which must be a number, with bound. The comparison result is passed to isValid,
+// and if that returns false the localeKey error is returned. Unlike min and max, the length of strings and
+// collections is never compared. NaN is not ordered, so it fails every comparison.
+func compareNumber(context core.ValidatorContext, bound interface{}, localeKey string, isValid func(int) bool, errArgs ...interface{}) error {
+	value := context.Value()
+
+	if !isNumber(value) {
+		return context.NewError("type.unsupported")
+	}
+
+	if floatValue, ok := value.(float64); ok && math.IsNaN(floatValue) {
+		return context.NewError(localeKey, errArgs...)
+	}
+
+	if result, ok := compareNumbers(value, bound); !ok || !isValid(result) {
+		return context.NewError(localeKey, errArgs...)
+	}
+
+	return nil
+}
+
+// compareNumberArgument compares the value with the single number argument, i.e. `gt(0)`.
+func compareNumberArgument(context core.ValidatorContext, args []interface{}, localeKey string, isValid func(int) bool) error {
+	if len(args) != 1 {
+		return context.NewError("arguments.singleRequired")
+	}
+
+	if !isNumber(args[0]) {
+		return context.NewError("arguments.invalidType", 1, "number")
+	}
+
+	return compareNumber(context, args[0], localeKey, isValid, args[0])
+}
+
+// GreaterThanValidator validates that a number is strictly greater than the argument, i.e. `gt(0)`.
+func GreaterThanValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareNumberArgument(context, args, "gt.mustBeGreaterThan", func(result int) bool {
+		return result > 0
+	})
+}
+
+// GreaterThanOrEqualValidator validates that a number is greater than or equal to the argument, i.e. `gte(0)`.
+func GreaterThanOrEqualValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareNumberArgument(context, args, "gte.mustBeGreaterThanOrEqual", func(result int) bool {
+		return result >= 0
+	})
+}
+
+// LessThanValidator validates that a number is strictly less than the argument, i.e. `lt(100)`.
+func LessThanValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareNumberArgument(context, args, "lt.mustBeLessThan", func(result int) bool {
+		return result < 0
+	})
+}
+
+// LessThanOrEqualValidator validates that a number is less than or equal to the argument, i.e. `lte(100)`.
+func LessThanOrEqualValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareNumberArgument(context, args, "lte.mustBeLessThanOrEqual", func(result int) bool {
+		return result <= 0
+	})
+}
+
+// PositiveValidator validates that a number is greater than zero.
+func PositiveValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return compareNumber(context, int64(0), "positive.mustBePositive", func(result int) bool {
+		return result > 0
+	})
+}
+
+// NegativeValidator validates that a number is less than zero.
+func NegativeValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return compareNumber(context, int64(0), "negative.mustBeNegative", func(result int) bool {
+		return result < 0
+	})
+}
+
+// NonNegativeValidator validates that a number is greater than or equal to zero.
+func NonNegativeValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return compareNumber(context, int64(0), "nonNegative.cannotBeNegative", func(result int) bool {
+		return result >= 0
+	})
+}
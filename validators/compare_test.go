@@ -0,0 +1,105 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"math"
+	"testing"
+)
+
+func TestThatComparisonValidatorsFailForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext(5)
+
+	if err := GreaterThanValidator(ctx, []interface{}{}); err == nil || err.Error() != "arguments.singleRequired" {
+		t.Fatalf("Expected single argument required error, got %v.", err)
+	}
+
+	if err := LessThanValidator(ctx, []interface{}{"abc"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+
+	if err := PositiveValidator(ctx, []interface{}{float64(1)}); err == nil || err.Error() != "arguments.noneSupported" {
+		t.Fatalf("Expected no arguments supported error, got %v.", err)
+	}
+}
+
+func TestThatComparisonValidatorsCompareNumbersStrictly(t *testing.T) {
+	tests := []struct {
+		validator core.ValidatorFn
+		value     interface{}
+		limit     float64
+		valid     bool
+	}{
+		{GreaterThanValidator, 1, 0, true},
+		{GreaterThanValidator, 0, 0, false},
+		{GreaterThanValidator, 0.5, 0.25, true},
+		{GreaterThanOrEqualValidator, 0, 0, true},
+		{GreaterThanOrEqualValidator, -1, 0, false},
+		{LessThanValidator, uint(9), 10, true},
+		{LessThanValidator, uint(10), 10, false},
+		{LessThanOrEqualValidator, 10, 10, true},
+		{LessThanOrEqualValidator, 10.5, 10, false},
+	}
+
+	for _, test := range tests {
+		err := test.validator(core.NewTestContext(test.value), []interface{}{test.limit})
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for %v and %v, but got one (%s).", test.value, test.limit, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for %v and %v, didn't get any.", test.value, test.limit)
+		}
+	}
+}
+
+func TestThatComparisonValidatorsDoNotCompareLengths(t *testing.T) {
+	if err := GreaterThanValidator(core.NewTestContext("abc"), []interface{}{float64(1)}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+
+	if err := LessThanValidator(core.NewTestContext([]int{1}), []interface{}{float64(5)}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
+
+func TestThatComparisonValidatorsFailForNaN(t *testing.T) {
+	if err := GreaterThanOrEqualValidator(core.NewTestContext(math.NaN()), []interface{}{float64(0)}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if err := NonNegativeValidator(core.NewTestContext(math.NaN()), nil); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatSignValidatorsValidateSign(t *testing.T) {
+	tests := []struct {
+		validator core.ValidatorFn
+		value     interface{}
+		valid     bool
+	}{
+		{PositiveValidator, 1, true},
+		{PositiveValidator, 0, false},
+		{PositiveValidator, 0.001, true},
+		{NegativeValidator, -1, true},
+		{NegativeValidator, uint(0), false},
+		{NegativeValidator, -0.5, true},
+		{NonNegativeValidator, 0, true},
+		{NonNegativeValidator, uint64(math.MaxUint64), true},
+		{NonNegativeValidator, -0.001, false},
+	}
+
+	for _, test := range tests {
+		err := test.validator(core.NewTestContext(test.value), nil)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", test.value, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for %v, didn't get any.", test.value)
+		}
+	}
+}
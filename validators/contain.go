@@ -17,7 +17,7 @@ func ContainValidator(context core.ValidatorContext, args []interface{}) error {
 				return context.NewError("arguments.invalid")
 			}
 
-			if context.IsNil() || !strings.Contains(typedValue, testValue) {
+			if !strings.Contains(typedValue, testValue) {
 				return context.NewError("contain.mustContainValue", testValue)
 			}
 
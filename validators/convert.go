@@ -0,0 +1,160 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// convertArguments checks the arguments of converters, which only support the named `target` argument.
+func convertArguments(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	if target, ok := context.NamedArguments()["target"]; ok {
+		if _, ok := target.(string); !ok {
+			return context.NewError("arguments.invalidType", "target", "string")
+		}
+	}
+
+	return nil
+}
+
+// ToIntConverter converts strings to integers, i.e. `"-12"` to -12, and floats without fractions to integers. The
+// converted value can be validated by the following validators, i.e. `to_int,min(18)`.
+func ToIntConverter(context core.ValidatorContext, args []interface{}) error {
+	if err := convertArguments(context, args); err != nil {
+		return err
+	}
+
+	if context.IsNil() {
+		return nil
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		value, err := strconv.ParseInt(strings.TrimSpace(typedValue), 10, 64)
+
+		if err != nil {
+			return context.NewError("toInt.mustBeInteger")
+		}
+
+		return context.SetValue(value)
+	case int64:
+		return nil
+	case uint64:
+		if typedValue > math.MaxInt64 {
+			return context.NewError("toInt.mustBeInteger")
+		}
+		return context.SetValue(int64(typedValue))
+	case float64:
+		if typedValue != math.Trunc(typedValue) || float64(int64(typedValue)) != typedValue {
+			return context.NewError("toInt.mustBeInteger")
+		}
+		return context.SetValue(int64(typedValue))
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// ToFloatConverter converts strings and integers to floats, i.e. `"1.5"` to 1.5.
+func ToFloatConverter(context core.ValidatorContext, args []interface{}) error {
+	if err := convertArguments(context, args); err != nil {
+		return err
+	}
+
+	if context.IsNil() {
+		return nil
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		value, err := strconv.ParseFloat(strings.TrimSpace(typedValue), 64)
+
+		if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+			return context.NewError("toFloat.mustBeNumber")
+		}
+
+		return context.SetValue(value)
+	case int64:
+		return context.SetValue(float64(typedValue))
+	case uint64:
+		return context.SetValue(float64(typedValue))
+	case float64:
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// ToBoolConverter converts strings to booleans, i.e. `"true"`, `"1"` or `"F"`, as parsed by strconv.ParseBool.
+func ToBoolConverter(context core.ValidatorContext, args []interface{}) error {
+	if err := convertArguments(context, args); err != nil {
+		return err
+	}
+
+	if context.IsNil() {
+		return nil
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		value, err := strconv.ParseBool(strings.TrimSpace(typedValue))
+
+		if err != nil {
+			return context.NewError("toBool.mustBeBoolean")
+		}
+
+		return context.SetValue(value)
+	case bool:
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// ToTimeConverter converts strings to times by a layout, i.e. `to_time(DateOnly)`, or as RFC 3339 times without a
+// layout. Layouts can be Go time layouts or the named layouts of the time validator.
+func ToTimeConverter(context core.ValidatorContext, args []interface{}) error {
+	layout := time.RFC3339
+
+	if len(args) == 1 {
+		if argLayout, ok := args[0].(string); ok {
+			layout = argLayout
+		} else {
+			return context.NewError("arguments.invalidType", 1, "string")
+		}
+
+		if namedLayout, ok := timeLayouts[layout]; ok {
+			layout = namedLayout
+		}
+
+		args = nil
+	}
+
+	if err := convertArguments(context, args); err != nil {
+		return err
+	}
+
+	if context.IsNil() {
+		return nil
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		value, err := time.Parse(layout, strings.TrimSpace(typedValue))
+
+		if err != nil {
+			return context.NewError("toTime.mustBeTime")
+		}
+
+		return context.SetValue(value)
+	case time.Time:
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
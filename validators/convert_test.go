@@ -0,0 +1,69 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+	"time"
+)
+
+func testThatConverterConverts(t *testing.T, converter core.ValidatorFn, value interface{}, args []interface{}, expected interface{}) {
+	ctx := core.NewTestContext(value)
+
+	if err := converter(ctx, args); err != nil {
+		t.Fatalf("Tested %v. Didn't expect error, got %s.", value, err)
+	}
+
+	if ctx.Value() != expected {
+		t.Fatalf("Tested %v. Expected %T (%v), got %T (%v).", value, expected, expected, ctx.Value(), ctx.Value())
+	}
+}
+
+func testThatConverterFails(t *testing.T, converter core.ValidatorFn, value interface{}, args []interface{}, expected string) {
+	if err := converter(core.NewTestContext(value), args); err == nil || err.Error() != expected {
+		t.Fatalf("Tested %v. Expected error '%s', got %v.", value, expected, err)
+	}
+}
+
+func TestThatToIntConverterConvertsToInteger(t *testing.T) {
+	testThatConverterConverts(t, ToIntConverter, "-12", nil, int64(-12))
+	testThatConverterConverts(t, ToIntConverter, " 42 ", nil, int64(42))
+	testThatConverterConverts(t, ToIntConverter, 42.0, nil, int64(42))
+	testThatConverterConverts(t, ToIntConverter, uint8(7), nil, int64(7))
+	testThatConverterFails(t, ToIntConverter, "1.5", nil, "toInt.mustBeInteger")
+	testThatConverterFails(t, ToIntConverter, 1.5, nil, "toInt.mustBeInteger")
+	testThatConverterFails(t, ToIntConverter, "99999999999999999999", nil, "toInt.mustBeInteger")
+	testThatConverterFails(t, ToIntConverter, true, nil, "type.unsupported")
+}
+
+func TestThatToFloatConverterConvertsToFloat(t *testing.T) {
+	testThatConverterConverts(t, ToFloatConverter, "1.5", nil, 1.5)
+	testThatConverterConverts(t, ToFloatConverter, 3, nil, 3.0)
+	testThatConverterFails(t, ToFloatConverter, "abc", nil, "toFloat.mustBeNumber")
+	testThatConverterFails(t, ToFloatConverter, "NaN", nil, "toFloat.mustBeNumber")
+}
+
+func TestThatToBoolConverterConvertsToBoolean(t *testing.T) {
+	testThatConverterConverts(t, ToBoolConverter, "true", nil, true)
+	testThatConverterConverts(t, ToBoolConverter, "0", nil, false)
+	testThatConverterFails(t, ToBoolConverter, "yes", nil, "toBool.mustBeBoolean")
+	testThatConverterFails(t, ToBoolConverter, 1, nil, "type.unsupported")
+}
+
+func TestThatToTimeConverterConvertsToTime(t *testing.T) {
+	testThatConverterConverts(t, ToTimeConverter, "2015-01-02", []interface{}{"DateOnly"}, time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC))
+	testThatConverterConverts(t, ToTimeConverter, "2015-01-02T03:04:05Z", nil, time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC))
+	testThatConverterFails(t, ToTimeConverter, "2015-01-02", nil, "toTime.mustBeTime")
+	testThatConverterFails(t, ToTimeConverter, "2015-01-02", []interface{}{1.0}, "arguments.invalidType")
+}
+
+func TestThatConvertersFailForInvalidArguments(t *testing.T) {
+	testThatConverterFails(t, ToIntConverter, "1", []interface{}{"abc"}, "arguments.noneSupported")
+
+	ctx := core.NewTestContext("1")
+	ctx.SetNamedArguments(map[string]interface{}{"target": 1.0})
+
+	if err := ToIntConverter(ctx, nil); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
@@ -0,0 +1,109 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"reflect"
+)
+
+var (
+	textKinds        = []reflect.Kind{reflect.String}
+	numberKinds      = []reflect.Kind{reflect.Int64, reflect.Uint64, reflect.Float64}
+	numericTextKinds = []reflect.Kind{reflect.String, reflect.Int64, reflect.Uint64, reflect.Float64}
+	comparableKinds  = []reflect.Kind{reflect.String, reflect.Int64, reflect.Uint64, reflect.Float64, reflect.Bool}
+	orderedKinds     = []reflect.Kind{reflect.Int64, reflect.Uint64, reflect.Float64, reflect.Struct}
+	lengthKinds      = []reflect.Kind{reflect.String, reflect.Slice, reflect.Array, reflect.Map}
+	boundKinds       = []reflect.Kind{reflect.String, reflect.Int64, reflect.Uint64, reflect.Float64, reflect.Slice, reflect.Array, reflect.Map, reflect.Struct}
+)
+
+// defaultDescriptions documents the default validators by name.
+var defaultDescriptions = map[string]core.Description{
+	"not":             {Summary: "Validates that the value is not equal to the argument.", Usage: "not(value)", Kinds: comparableKinds},
+	"nil":             {Summary: "Validates that the value is nil.", Usage: "nil"},
+	"empty":           {Summary: "Validates that the value is empty, that is nil, zero or without items.", Usage: "empty"},
+	"not_empty":       {Summary: "Validates that the value is not empty, that is not nil, zero or without items.", Usage: "not_empty"},
+	"omitempty":       {Summary: "Skips the remaining validators of the field if the value is empty.", Usage: "omitempty"},
+	"min":             {Summary: "Validates that a number, time, or length of a string or collection is at least the argument.", Usage: "min(n)", Kinds: boundKinds},
+	"max":             {Summary: "Validates that a number, time, or length of a string or collection is at most the argument.", Usage: "max(n)", Kinds: boundKinds},
+	"lowercase":       {Summary: "Validates that a string is lower case.", Usage: "lowercase", Kinds: textKinds},
+	"uppercase":       {Summary: "Validates that a string is upper case.", Usage: "uppercase", Kinds: textKinds},
+	"contain":         {Summary: "Validates that a string contains the argument.", Usage: "contain(text)", Kinds: textKinds},
+	"contains":        {Summary: "Alias of contain.", Usage: "contains(text)", Kinds: textKinds},
+	"starts_with":     {Summary: "Validates that a string starts with one of the arguments.", Usage: "starts_with(text,...)", Kinds: textKinds},
+	"ends_with":       {Summary: "Validates that a string ends with one of the arguments.", Usage: "ends_with(text,...)", Kinds: textKinds},
+	"excludes":        {Summary: "Validates that a string contains none of the arguments.", Usage: "excludes(text,...)", Kinds: textKinds},
+	"alpha":           {Summary: "Validates that a string only contains letters.", Usage: "alpha", Kinds: textKinds},
+	"alphanum":        {Summary: "Validates that a string only contains letters and digits.", Usage: "alphanum", Kinds: textKinds},
+	"ascii":           {Summary: "Validates that a string only contains ASCII characters.", Usage: "ascii", Kinds: textKinds},
+	"printable":       {Summary: "Validates that a string only contains printable characters.", Usage: "printable", Kinds: textKinds},
+	"equal":           {Summary: "Validates that the value is equal to the argument.", Usage: "equal(value)", Kinds: comparableKinds},
+	"regexp":          {Summary: "Validates that a string matches the regular expression of the argument.", Usage: "regexp(pattern)", Kinds: textKinds},
+	"match":           {Summary: "Validates that a string matches the regular expression of the argument.", Usage: "match(pattern)", Kinds: textKinds},
+	"numeric":         {Summary: "Validates that the value is a number, or a string of a number.", Usage: "numeric", Kinds: numericTextKinds},
+	"integer":         {Summary: "Validates that the value is an integer, or a string of an integer.", Usage: "integer", Kinds: numericTextKinds},
+	"decimal":         {Summary: "Validates that the value is a number, or a string of a decimal number.", Usage: "decimal", Kinds: numericTextKinds},
+	"hex":             {Summary: "Validates that a string is hexadecimal.", Usage: "hex", Kinds: textKinds},
+	"base64":          {Summary: "Validates that a string is base64 encoded.", Usage: "base64", Kinds: textKinds},
+	"json":            {Summary: "Validates that a string is a JSON document.", Usage: "json", Kinds: textKinds},
+	"xml":             {Summary: "Validates that a string is an XML document.", Usage: "xml", Kinds: textKinds},
+	"time":            {Summary: "Validates that a string is a time of the layout of the argument, and converts it to a time.", Usage: "time(layout)", Kinds: []reflect.Kind{reflect.String, reflect.Struct}},
+	"iso8601":         {Summary: "Validates that a string is an ISO 8601 time, and converts it to a time.", Usage: "iso8601", Kinds: []reflect.Kind{reflect.String, reflect.Struct}},
+	"func":            {Summary: "Validates the value with a method of the struct being validated.", Usage: "func(method,...)"},
+	"email":           {Summary: "Validates that a string is an email address.", Usage: "email", Kinds: textKinds},
+	"url":             {Summary: "Validates that a string is an absolute URL.", Usage: "url", Kinds: textKinds},
+	"uuid":            {Summary: "Validates that a string is a UUID, optionally of the versions of the arguments.", Usage: "uuid(version,...,uppercase,braced)", Kinds: textKinds},
+	"ip":              {Summary: "Validates that a string is an IPv4 or IPv6 address.", Usage: "ip", Kinds: textKinds},
+	"ipv4":            {Summary: "Validates that a string is an IPv4 address.", Usage: "ipv4", Kinds: textKinds},
+	"ipv6":            {Summary: "Validates that a string is an IPv6 address.", Usage: "ipv6", Kinds: textKinds},
+	"cidr":            {Summary: "Validates that a string is an IP address and prefix length in CIDR notation.", Usage: "cidr", Kinds: textKinds},
+	"mac":             {Summary: "Validates that a string is a MAC address.", Usage: "mac", Kinds: textKinds},
+	"hostname":        {Summary: "Validates that a string is a host name as defined by RFC 1123.", Usage: "hostname", Kinds: textKinds},
+	"fqdn":            {Summary: "Validates that a string is a fully qualified domain name.", Usage: "fqdn", Kinds: textKinds},
+	"semver":          {Summary: "Validates that a string is a semantic version.", Usage: "semver", Kinds: textKinds},
+	"eqfield":         {Summary: "Validates that the value is equal to the value of the sibling field of the argument.", Usage: "eqfield(field)"},
+	"nefield":         {Summary: "Validates that the value is not equal to the value of the sibling field of the argument.", Usage: "nefield(field)"},
+	"gtfield":         {Summary: "Validates that the value is greater than the value of the sibling field of the argument.", Usage: "gtfield(field)", Kinds: orderedKinds},
+	"gtefield":        {Summary: "Validates that the value is at least the value of the sibling field of the argument.", Usage: "gtefield(field)", Kinds: orderedKinds},
+	"ltfield":         {Summary: "Validates that the value is less than the value of the sibling field of the argument.", Usage: "ltfield(field)", Kinds: orderedKinds},
+	"ltefield":        {Summary: "Validates that the value is at most the value of the sibling field of the argument.", Usage: "ltefield(field)", Kinds: orderedKinds},
+	"required":        {Summary: "Validates that the value is present, that is not a nil pointer, interface, slice or map.", Usage: "required"},
+	"required_if":     {Summary: "Validates that the value is not empty if all of the sibling fields have the given values.", Usage: "required_if(field,value,...)"},
+	"required_unless": {Summary: "Validates that the value is not empty unless any of the sibling fields has the given value.", Usage: "required_unless(field,value,...)"},
+	"phone":           {Summary: "Validates that a string is a phone number.", Usage: "phone", Kinds: textKinds},
+	"luhn":            {Summary: "Validates that a number, or string of digits, passes the Luhn checksum.", Usage: "luhn", Kinds: []reflect.Kind{reflect.String, reflect.Int64}},
+	"creditcard":      {Summary: "Validates that a string is a credit card number.", Usage: "creditcard", Kinds: textKinds},
+	"iban":            {Summary: "Validates that a string is an IBAN.", Usage: "iban", Kinds: textKinds},
+	"iso3166":         {Summary: "Validates that a string is an ISO 3166 country code.", Usage: "iso3166", Kinds: textKinds},
+	"iso4217":         {Summary: "Validates that a string is an ISO 4217 currency code.", Usage: "iso4217", Kinds: textKinds},
+	"bcp47":           {Summary: "Validates that a string is a BCP 47 language tag.", Usage: "bcp47", Kinds: textKinds},
+	"timezone":        {Summary: "Validates that a string is the name of a time zone.", Usage: "timezone", Kinds: textKinds},
+	"password":        {Summary: "Validates that a string is a strong password.", Usage: "password(classes=n,entropy=n)", Kinds: textKinds},
+	"filepath":        {Summary: "Validates that a string is a file path.", Usage: "filepath", Kinds: textKinds},
+	"file_exists":     {Summary: "Validates that a string is the path of an existing file.", Usage: "file_exists", Kinds: textKinds},
+	"dir_exists":      {Summary: "Validates that a string is the path of an existing directory.", Usage: "dir_exists", Kinds: textKinds},
+	"between":         {Summary: "Validates that a number or time is between the arguments.", Usage: "between(min,max) or between(min=n,max=n)", Kinds: orderedKinds},
+	"gt":              {Summary: "Validates that a number is greater than the argument.", Usage: "gt(n)", Kinds: numberKinds},
+	"gte":             {Summary: "Validates that a number is at least the argument.", Usage: "gte(n)", Kinds: numberKinds},
+	"lt":              {Summary: "Validates that a number is less than the argument.", Usage: "lt(n)", Kinds: numberKinds},
+	"lte":             {Summary: "Validates that a number is at most the argument.", Usage: "lte(n)", Kinds: numberKinds},
+	"positive":        {Summary: "Validates that a number is greater than zero.", Usage: "positive", Kinds: numberKinds},
+	"negative":        {Summary: "Validates that a number is less than zero.", Usage: "negative", Kinds: numberKinds},
+	"non_negative":    {Summary: "Validates that a number is zero or greater.", Usage: "non_negative", Kinds: numberKinds},
+	"length":          {Summary: "Validates that the length of a string or collection is the argument, or between the arguments.", Usage: "length(n) or length(min,max)", Kinds: lengthKinds},
+	"one_of":          {Summary: "Validates that the value is one of the arguments.", Usage: "one_of(value,...)", Kinds: comparableKinds},
+	"as_string":       {Summary: "Converts the value to its textual form for the following validators.", Usage: "as_string"},
+	"trim":            {Summary: "Removes leading and trailing white space of a string.", Usage: "trim", Kinds: textKinds},
+	"lower":           {Summary: "Converts a string to lower case.", Usage: "lower", Kinds: textKinds},
+	"upper":           {Summary: "Converts a string to upper case.", Usage: "upper", Kinds: textKinds},
+	"truncate":        {Summary: "Truncates a string to the number of characters of the argument.", Usage: "truncate(n)", Kinds: textKinds},
+	"to_int":          {Summary: "Converts a string to an integer, and writes it to the field of the target argument.", Usage: "to_int or to_int(target=Field)", Kinds: numericTextKinds},
+	"to_float":        {Summary: "Converts a string to a float, and writes it to the field of the target argument.", Usage: "to_float or to_float(target=Field)", Kinds: numericTextKinds},
+	"to_bool":         {Summary: "Converts a string to a boolean, and writes it to the field of the target argument.", Usage: "to_bool or to_bool(target=Field)", Kinds: []reflect.Kind{reflect.String, reflect.Bool}},
+	"to_time":         {Summary: "Converts a string to a time of the layout of the argument, and writes it to the field of the target argument.", Usage: "to_time(layout) or to_time(layout,target=Field)", Kinds: []reflect.Kind{reflect.String, reflect.Struct}},
+}
+
+// RegisterDefaultDescriptions documents the default validators of a registry.
+func RegisterDefaultDescriptions(r *core.ValidatorRegistry) {
+	for name, description := range defaultDescriptions {
+		r.SetDescription(name, description)
+	}
+}
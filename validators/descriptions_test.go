@@ -0,0 +1,18 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatDefaultValidatorsAreDescribed(t *testing.T) {
+	registry := core.NewValidatorRegistry()
+	RegisterDefaultValidators(registry)
+
+	for _, description := range registry.Describe() {
+		if len(description.Summary) == 0 || len(description.Usage) == 0 {
+			t.Fatalf("Expected description of '%s', got none.", description.Name)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"github.com/typerandom/validator/core"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// UnmarshalFn unmarshals a document into value, i.e. yaml.Unmarshal of gopkg.in/yaml.v3.
+type UnmarshalFn func(data []byte, value interface{}) error
+
+// documentType returns the required top level type of a document by the optional argument, `object` or `array`.
+func documentType(context core.ValidatorContext, args []interface{}) (string, error) {
+	if len(args) > 1 {
+		return "", context.NewError("arguments.singleRequired")
+	}
+
+	if len(args) == 0 {
+		return "", nil
+	}
+
+	if typedArg, ok := args[0].(string); ok && (typedArg == "object" || typedArg == "array") {
+		return typedArg, nil
+	}
+
+	return "", context.NewError("arguments.invalidType", 1, "object or array")
+}
+
+// JsonValidator validates that a string is a valid JSON document. The top level value can be required to be an
+// object or an array, i.e. `json(object)`.
+func JsonValidator(context core.ValidatorContext, args []interface{}) error {
+	requiredType, err := documentType(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if !json.Valid([]byte(value)) {
+		return context.NewError("json.mustBeValidJson")
+	}
+
+	switch strings.TrimLeft(value, " \t\r\n")[0] {
+	case '{':
+		if requiredType == "array" {
+			return context.NewError("json.mustBeType", requiredType)
+		}
+	case '[':
+		if requiredType == "object" {
+			return context.NewError("json.mustBeType", requiredType)
+		}
+	default:
+		if requiredType != "" {
+			return context.NewError("json.mustBeType", requiredType)
+		}
+	}
+
+	return nil
+}
+
+// YamlValidator returns a validator that validates that a string is a valid YAML document, using unmarshal of a
+// YAML package, i.e. `Register("yaml", YamlValidator(yaml.Unmarshal))`. The top level value can be required to be
+// an object (a mapping) or an array (a sequence), i.e. `yaml(object)`.
+func YamlValidator(unmarshal UnmarshalFn) core.ValidatorFn {
+	return func(context core.ValidatorContext, args []interface{}) error {
+		requiredType, err := documentType(context, args)
+
+		if err != nil {
+			return err
+		}
+
+		value, ok := stringValue(context)
+
+		if !ok {
+			return context.NewError("type.unsupported")
+		}
+
+		var document interface{}
+
+		if err := unmarshal([]byte(value), &document); err != nil {
+			return context.NewError("yaml.mustBeValidYaml")
+		}
+
+		kind := reflect.Invalid
+
+		if document != nil {
+			kind = reflect.TypeOf(document).Kind()
+		}
+
+		if (requiredType == "object" && kind != reflect.Map) || (requiredType == "array" && kind != reflect.Slice) {
+			return context.NewError("yaml.mustBeType", requiredType)
+		}
+
+		return nil
+	}
+}
+
+// XmlValidator validates that a string is a well-formed XML document, with a single root element. The name of the
+// root element can be required, i.e. `xml(config)`.
+func XmlValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 1 {
+		return context.NewError("arguments.singleRequired")
+	}
+
+	rootName := ""
+
+	if len(args) == 1 {
+		typedArg, ok := args[0].(string)
+
+		if !ok {
+			return context.NewError("arguments.invalidType", 1, "string")
+		}
+
+		rootName = typedArg
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	depth, roots := 0, 0
+
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return context.NewError("xml.mustBeValidXml")
+		}
+
+		switch typedToken := token.(type) {
+		case xml.StartElement:
+			if depth == 0 {
+				if roots++; roots > 1 {
+					return context.NewError("xml.mustBeValidXml")
+				}
+
+				if rootName != "" && typedToken.Name.Local != rootName {
+					return context.NewError("xml.mustHaveRoot", rootName)
+				}
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 && len(bytes.TrimSpace(typedToken)) > 0 {
+				return context.NewError("xml.mustBeValidXml")
+			}
+		}
+	}
+
+	if roots == 0 {
+		return context.NewError("xml.mustBeValidXml")
+	}
+
+	return nil
+}
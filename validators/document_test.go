@@ -0,0 +1,91 @@
+package validators_test
+
+import (
+	"encoding/json"
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatJsonValidatorValidatesDocuments(t *testing.T) {
+	tests := []struct {
+		value string
+		args  []interface{}
+		valid bool
+	}{
+		{`{"a": [1, 2]}`, nil, true},
+		{`"text"`, nil, true},
+		{`{"a": }`, nil, false},
+		{``, nil, false},
+		{` {"a": 1}`, []interface{}{"object"}, true},
+		{`[1, 2]`, []interface{}{"object"}, false},
+		{`[1, 2]`, []interface{}{"array"}, true},
+		{`1`, []interface{}{"array"}, false},
+	}
+
+	for _, test := range tests {
+		err := JsonValidator(core.NewTestContext(test.value), test.args)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", test.value, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for '%s', didn't get any.", test.value)
+		}
+	}
+}
+
+func TestThatJsonValidatorFailsForInvalidOptions(t *testing.T) {
+	if err := JsonValidator(core.NewTestContext("{}"), []interface{}{"map"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+
+	if err := JsonValidator(core.NewTestContext(5), nil); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
+
+func TestThatYamlValidatorUsesUnmarshalFunction(t *testing.T) {
+	validate := YamlValidator(json.Unmarshal)
+
+	if err := validate(core.NewTestContext(`{"a": 1}`), []interface{}{"object"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := validate(core.NewTestContext(`{"a": 1}`), []interface{}{"array"}); err == nil || err.Error() != "yaml.mustBeType" {
+		t.Fatalf("Expected must be type error, got %v.", err)
+	}
+
+	if err := validate(core.NewTestContext(`{"a"`), nil); err == nil || err.Error() != "yaml.mustBeValidYaml" {
+		t.Fatalf("Expected must be valid error, got %v.", err)
+	}
+}
+
+func TestThatXmlValidatorValidatesDocuments(t *testing.T) {
+	tests := []struct {
+		value string
+		args  []interface{}
+		valid bool
+	}{
+		{`<?xml version="1.0"?><config><a>1</a></config>`, nil, true},
+		{` <config/> `, []interface{}{"config"}, true},
+		{`<settings/>`, []interface{}{"config"}, false},
+		{`<config><a></config>`, nil, false},
+		{`<a/><b/>`, nil, false},
+		{`text`, nil, false},
+		{``, nil, false},
+	}
+
+	for _, test := range tests {
+		err := XmlValidator(core.NewTestContext(test.value), test.args)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", test.value, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for '%s', didn't get any.", test.value)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"net"
+	"net/mail"
+	"strings"
+)
+
+func EmailValidator(context core.ValidatorContext, args []interface{}) error {
+	var checkDns bool
+
+	if len(args) > 1 {
+		return context.NewError("arguments.invalid")
+	} else if len(args) == 1 {
+		if option, ok := args[0].(string); ok && option == "dns" {
+			checkDns = true
+		} else {
+			return context.NewError("arguments.invalid")
+		}
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if len(typedValue) == 0 {
+			return context.NewError("email.mustBeValidEmail")
+		}
+
+		address, err := mail.ParseAddress(typedValue)
+
+		// Only accept plain addresses, i.e. not `Bob <bob@example.com>`.
+		if err != nil || address.Address != typedValue {
+			return context.NewError("email.mustBeValidEmail")
+		}
+
+		domain := address.Address[strings.LastIndex(address.Address, "@")+1:]
+
+		if checkDns {
+			records, err := net.DefaultResolver.LookupMX(context.Context(), domain)
+
+			// Lookups that were cancelled or timed out say nothing about the domain.
+			if ctxErr := context.Context().Err(); err != nil && ctxErr != nil {
+				return ctxErr
+			}
+
+			if err != nil || len(records) == 0 {
+				return context.NewError("email.mustHaveMailServer", domain)
+			}
+		}
+
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
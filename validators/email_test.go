@@ -0,0 +1,94 @@
+package validators_test
+
+import (
+	gocontext "context"
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatEmailValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("bob@example.com")
+
+	err := EmailValidator(ctx, []interface{}{"abc"})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %s.", err)
+	}
+
+	err = EmailValidator(ctx, []interface{}{"dns", "dns"})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %s.", err)
+	}
+}
+
+func TestThatEmailValidatorReturnsErrorOfCancelledContextForDnsOption(t *testing.T) {
+	ctx := core.NewTestContext("bob@example.com")
+
+	cancelledCtx, cancel := gocontext.WithCancel(gocontext.Background())
+	cancel()
+	ctx.SetContext(cancelledCtx)
+
+	if err := EmailValidator(ctx, []interface{}{"dns"}); err != gocontext.Canceled {
+		t.Fatalf("Expected context cancelled error, got %v.", err)
+	}
+}
+
+func TestThatEmailValidatorSucceedsForValidEmail(t *testing.T) {
+	for _, value := range []string{"bob@example.com", "bob.tables+test@sub.example.com", "bob@localhost"} {
+		ctx := core.NewTestContext(value)
+
+		if err := EmailValidator(ctx, []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+}
+
+func TestThatEmailValidatorFailsForInvalidEmail(t *testing.T) {
+	for _, value := range []string{"", "bob", "bob@", "@example.com", "Bob <bob@example.com>", "bob@example.com "} {
+		ctx := core.NewTestContext(value)
+
+		err := EmailValidator(ctx, []interface{}{})
+
+		if err == nil {
+			t.Fatalf("Expected error for '%s', didn't get any.", value)
+		}
+
+		if err.Error() != "email.mustBeValidEmail" {
+			t.Fatalf("Expected must be valid email error for '%s', got %s.", value, err)
+		}
+	}
+}
+
+func TestThatEmailValidatorFailsForNilValue(t *testing.T) {
+	var dummy *string
+
+	ctx := core.NewTestContext(dummy)
+	err := EmailValidator(ctx, []interface{}{})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err.Error() != "email.mustBeValidEmail" {
+		t.Fatalf("Expected must be valid email error, got %s.", err)
+	}
+}
+
+func TestThatEmailValidatorFailsForUnsupportedValueType(t *testing.T) {
+	ctx := core.NewTestContext(123)
+	err := EmailValidator(ctx, []interface{}{})
+
+	if err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %s.", err)
+	}
+}
@@ -2,7 +2,6 @@ package validators
 
 import (
 	"github.com/typerandom/validator/core"
-	"reflect"
 	"time"
 )
 
@@ -40,16 +39,23 @@ func EmptyValidator(context core.ValidatorContext, args []interface{}) error {
 		}
 	}
 
-	switch context.OriginalKind() {
-	case reflect.Array, reflect.Slice:
-		if reflect.ValueOf(context.Value()).Len() == 0 {
-			return nil
-		}
-	case reflect.Map:
-		if len(reflect.ValueOf(context.Value()).MapKeys()) == 0 {
-			return nil
-		}
+	if length, ok := core.Length(context.Value()); ok && length == 0 {
+		return nil
 	}
 
 	return context.NewError("empty.isNotEmpty")
 }
+
+// OmitEmptyValidator skips the remaining validators of the field if its value is empty or nil, i.e. `omitempty,email`.
+// Unlike `empty|email`, the errors of the validators that follow aren't reported with an alternative.
+func OmitEmptyValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	if EmptyValidator(context, args) == nil {
+		context.SkipField()
+	}
+
+	return nil
+}
@@ -122,3 +122,27 @@ func TestThatEmptyValidatorFailsForUnhandledType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatOmitEmptyValidatorSkipsEmptyField(t *testing.T) {
+	ctx := core.NewTestContext("")
+
+	if err := OmitEmptyValidator(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if !ctx.IsFieldSkipped() {
+		t.Fatal("Expected field to be skipped.")
+	}
+}
+
+func TestThatOmitEmptyValidatorDoesNotSkipNonEmptyField(t *testing.T) {
+	ctx := core.NewTestContext("value")
+
+	if err := OmitEmptyValidator(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if ctx.IsFieldSkipped() {
+		t.Fatal("Didn't expect field to be skipped.")
+	}
+}
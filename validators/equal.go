@@ -13,14 +13,22 @@ func EqualValidator(context core.ValidatorContext, args []interface{}) error {
 	if testValue, ok := args[0].(string); ok {
 		switch typedValue := context.Value().(type) {
 		case string:
-			if !context.IsNil() && typedValue == testValue {
+			if typedValue == testValue {
 				return nil
 			}
 			return context.NewError("equal.mustEqualValue", testValue)
 		case int64:
 			parsedTestValue, err := strconv.ParseInt(testValue, 10, 64)
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
+			if err == nil && typedValue == parsedTestValue {
+				return nil
+			}
+
+			return context.NewError("equal.mustEqualValue", testValue)
+		case uint64:
+			parsedTestValue, err := strconv.ParseUint(testValue, 10, 64)
+
+			if err == nil && typedValue == parsedTestValue {
 				return nil
 			}
 
@@ -28,7 +36,7 @@ func EqualValidator(context core.ValidatorContext, args []interface{}) error {
 		case float64:
 			parsedTestValue, err := strconv.ParseFloat(testValue, 64)
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
+			if err == nil && typedValue == parsedTestValue {
 				return nil
 			}
 
@@ -36,7 +44,7 @@ func EqualValidator(context core.ValidatorContext, args []interface{}) error {
 		case bool:
 			parsedTestValue, err := strconv.ParseBool(testValue)
 
-			if err == nil && !context.IsNil() && typedValue == parsedTestValue {
+			if err == nil && typedValue == parsedTestValue {
 				return nil
 			}
 
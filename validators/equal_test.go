@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/typerandom/validator/core"
 	. "github.com/typerandom/validator/validators"
+	"math"
 	"testing"
 )
 
@@ -62,8 +63,6 @@ func TestThatEqualValidatorSucceedsForEqualStringValue(t *testing.T) {
 }
 
 func TestThatEqualValidatorFailsForNonEqualStringValue(t *testing.T) {
-	var dummy *string
-	testThatEqualValidatorFailsForNonEqualValue(t, "", dummy)
 	testThatEqualValidatorFailsForNonEqualValue(t, "test", "test1")
 }
 
@@ -73,8 +72,6 @@ func TestThatEqualValidatorSucceedsForEqualIntValue(t *testing.T) {
 }
 
 func TestThatEqualValidatorFailsForNonEqualIntValue(t *testing.T) {
-	var dummy *int64
-	testThatEqualValidatorFailsForNonEqualValue(t, 0, dummy)
 	testThatEqualValidatorFailsForNonEqualValue(t, 1234, 12345)
 }
 
@@ -84,8 +81,6 @@ func TestThatEqualValidatorSucceedsForEqualFloatValue(t *testing.T) {
 }
 
 func TestThatEqualValidatorFailsForNonEqualFloatValue(t *testing.T) {
-	var dummy *float64
-	testThatEqualValidatorFailsForNonEqualValue(t, 0.0, dummy)
 	testThatEqualValidatorFailsForNonEqualValue(t, 1.234, 1.2345)
 }
 
@@ -95,11 +90,22 @@ func TestThatEqualValidatorSucceedsForEqualBoolValue(t *testing.T) {
 }
 
 func TestThatEqualValidatorFailsForNonEqualBoolValue(t *testing.T) {
-	var dummy *bool
-	testThatEqualValidatorFailsForNonEqualValue(t, false, dummy)
 	testThatEqualValidatorFailsForNonEqualValue(t, false, true)
 }
 
+func TestThatEqualValidatorValidatesNilAsZeroValue(t *testing.T) {
+	var stringDummy *string
+	var intDummy *int64
+	var floatDummy *float64
+	var boolDummy *bool
+
+	testThatEqualValidatorSucceedsForEqualValue(t, "", stringDummy)
+	testThatEqualValidatorSucceedsForEqualValue(t, 0, intDummy)
+	testThatEqualValidatorSucceedsForEqualValue(t, 0.0, floatDummy)
+	testThatEqualValidatorSucceedsForEqualValue(t, false, boolDummy)
+	testThatEqualValidatorFailsForNonEqualValue(t, "test", stringDummy)
+}
+
 func TestThatEqualValidatorFailsForUnsupportedValueType(t *testing.T) {
 	type Dummy struct{}
 
@@ -110,3 +116,11 @@ func TestThatEqualValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatEqualValidatorSucceedsForUInt64AboveInt64Range(t *testing.T) {
+	ctx := core.NewTestContext(uint64(math.MaxUint64))
+
+	if err := EqualValidator(ctx, []interface{}{"18446744073709551615"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
@@ -0,0 +1,137 @@
+package validators
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core"
+	"time"
+)
+
+// compareValues compares two normalized values. Returns -1, 0 or 1 if a is less than, equal to or greater than b.
+// Booleans are ordered with false before true. If the values cannot be compared, then false is returned.
+func compareValues(a interface{}, b interface{}) (int, bool) {
+	if isNumber(a) {
+		if result, ok := compareNumbers(a, b); ok {
+			return result, true
+		}
+
+		// NaN is neither less nor greater than other numbers.
+		if isNumber(b) {
+			return 0, true
+		}
+
+		return 0, false
+	}
+
+	switch typedA := a.(type) {
+	case string:
+		if typedB, ok := b.(string); ok {
+			switch {
+			case typedA < typedB:
+				return -1, true
+			case typedA > typedB:
+				return 1, true
+			}
+			return 0, true
+		}
+	case bool:
+		if typedB, ok := b.(bool); ok {
+			switch {
+			case !typedA && typedB:
+				return -1, true
+			case typedA && !typedB:
+				return 1, true
+			}
+			return 0, true
+		}
+	case time.Time:
+		if typedB, ok := b.(time.Time); ok {
+			switch {
+			case typedA.Before(typedB):
+				return -1, true
+			case typedA.After(typedB):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+func compareFloats(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareField compares the value with the value of the sibling field named by the single argument.
+// The comparison result is passed to isValid, and if that returns false the localeKey error is returned.
+func compareField(context core.ValidatorContext, args []interface{}, localeKey string, isValid func(int) bool) error {
+	if len(args) != 1 {
+		return context.NewError("arguments.singleRequired")
+	}
+
+	fieldName, ok := args[0].(string)
+
+	if !ok {
+		return context.NewError("arguments.invalidType", 1, "string")
+	}
+
+	sibling, err := context.SiblingValue(fieldName)
+
+	if err != nil {
+		return errors.New("Unable to compare field '{field}' with '" + fieldName + "'. " + err.Error())
+	}
+
+	if sibling.IsNil {
+		return context.NewError(localeKey, fieldName)
+	}
+
+	if result, ok := compareValues(context.Value(), sibling.Value); ok {
+		if !isValid(result) {
+			return context.NewError(localeKey, fieldName)
+		}
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+func EqualFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "eqField.mustEqualField", func(result int) bool {
+		return result == 0
+	})
+}
+
+func NotEqualFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "neField.cannotEqualField", func(result int) bool {
+		return result != 0
+	})
+}
+
+func GreaterThanFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "gtField.mustBeGreaterThanField", func(result int) bool {
+		return result > 0
+	})
+}
+
+func GreaterThanOrEqualFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "gteField.mustBeGreaterThanOrEqualField", func(result int) bool {
+		return result >= 0
+	})
+}
+
+func LessThanFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "ltField.mustBeLessThanField", func(result int) bool {
+		return result < 0
+	})
+}
+
+func LessThanOrEqualFieldValidator(context core.ValidatorContext, args []interface{}) error {
+	return compareField(context, args, "lteField.mustBeLessThanOrEqualField", func(result int) bool {
+		return result <= 0
+	})
+}
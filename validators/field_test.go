@@ -0,0 +1,133 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+	"time"
+)
+
+type fieldDummy struct {
+	Password        string
+	PasswordConfirm string
+	StartDate       time.Time
+	EndDate         time.Time
+	Count           int
+	Limit           float64
+	Enabled         *bool
+}
+
+func newFieldTestContext(source interface{}, value interface{}) core.ValidatorContext {
+	ctx := core.NewTestContext(value)
+	ctx.SetSource(source)
+	return ctx
+}
+
+func TestThatFieldValidatorsFailForInvalidOptions(t *testing.T) {
+	ctx := newFieldTestContext(&fieldDummy{}, "test")
+
+	err := EqualFieldValidator(ctx, []interface{}{})
+
+	if err == nil || err.Error() != "arguments.singleRequired" {
+		t.Fatalf("Expected single argument required error, got %v.", err)
+	}
+
+	err = EqualFieldValidator(ctx, []interface{}{float64(1)})
+
+	if err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatFieldValidatorsFailForMissingField(t *testing.T) {
+	ctx := newFieldTestContext(&fieldDummy{}, "test")
+
+	err := EqualFieldValidator(ctx, []interface{}{"Missing"})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err.Error() != "Unable to compare field '{field}' with 'Missing'. Field 'Missing' does not exist." {
+		t.Fatalf("Expected missing field error, got %s.", err)
+	}
+}
+
+func TestThatEqualFieldValidatorComparesFields(t *testing.T) {
+	dummy := &fieldDummy{Password: "secret", PasswordConfirm: "secret"}
+
+	if err := EqualFieldValidator(newFieldTestContext(dummy, "secret"), []interface{}{"PasswordConfirm"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err := EqualFieldValidator(newFieldTestContext(dummy, "other"), []interface{}{"PasswordConfirm"})
+
+	if err == nil || err.Error() != "eqField.mustEqualField" {
+		t.Fatalf("Expected must equal field error, got %v.", err)
+	}
+
+	if err := NotEqualFieldValidator(newFieldTestContext(dummy, "other"), []interface{}{"PasswordConfirm"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatEqualFieldValidatorFailsForNilField(t *testing.T) {
+	enabled := true
+
+	err := EqualFieldValidator(newFieldTestContext(&fieldDummy{}, &enabled), []interface{}{"Enabled"})
+
+	if err == nil || err.Error() != "eqField.mustEqualField" {
+		t.Fatalf("Expected must equal field error, got %v.", err)
+	}
+
+	if err := EqualFieldValidator(newFieldTestContext(&fieldDummy{Enabled: &enabled}, true), []interface{}{"Enabled"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatOrderedFieldValidatorsCompareTimes(t *testing.T) {
+	now := time.Now()
+	dummy := &fieldDummy{StartDate: now}
+
+	if err := GreaterThanFieldValidator(newFieldTestContext(dummy, now.Add(time.Hour)), []interface{}{"StartDate"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err := GreaterThanFieldValidator(newFieldTestContext(dummy, now), []interface{}{"StartDate"})
+
+	if err == nil || err.Error() != "gtField.mustBeGreaterThanField" {
+		t.Fatalf("Expected must be greater than field error, got %v.", err)
+	}
+
+	if err := GreaterThanOrEqualFieldValidator(newFieldTestContext(dummy, now), []interface{}{"StartDate"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatOrderedFieldValidatorsCompareNumbers(t *testing.T) {
+	dummy := &fieldDummy{Count: 5, Limit: 10.5}
+
+	if err := LessThanOrEqualFieldValidator(newFieldTestContext(dummy, 10), []interface{}{"Limit"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err := LessThanFieldValidator(newFieldTestContext(dummy, 11), []interface{}{"Limit"})
+
+	if err == nil || err.Error() != "ltField.mustBeLessThanField" {
+		t.Fatalf("Expected must be less than field error, got %v.", err)
+	}
+
+	err = LessThanOrEqualFieldValidator(newFieldTestContext(dummy, 6), []interface{}{"Count"})
+
+	if err == nil || err.Error() != "lteField.mustBeLessThanOrEqualField" {
+		t.Fatalf("Expected must be less than or equal field error, got %v.", err)
+	}
+}
+
+func TestThatFieldValidatorsFailForIncomparableTypes(t *testing.T) {
+	err := GreaterThanFieldValidator(newFieldTestContext(&fieldDummy{}, "test"), []interface{}{"Count"})
+
+	if err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
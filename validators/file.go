@@ -0,0 +1,110 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"os"
+	"runtime"
+	"strings"
+)
+
+var windowsReservedNames = map[string]bool{
+	"CON": true, "PRN": true, "AUX": true, "NUL": true,
+	"COM1": true, "COM2": true, "COM3": true, "COM4": true, "COM5": true, "COM6": true, "COM7": true, "COM8": true, "COM9": true,
+	"LPT1": true, "LPT2": true, "LPT3": true, "LPT4": true, "LPT5": true, "LPT6": true, "LPT7": true, "LPT8": true, "LPT9": true,
+}
+
+// isValidFilePath checks the syntax of a path for an operating system, without accessing the filesystem.
+func isValidFilePath(path string, goos string) bool {
+	if len(path) == 0 || strings.ContainsRune(path, 0) {
+		return false
+	}
+
+	if goos != "windows" {
+		return true
+	}
+
+	// Allow a drive letter, i.e. `C:`, which is the only place where a colon is valid.
+	if len(path) >= 2 && path[1] == ':' && (path[0]|0x20) >= 'a' && (path[0]|0x20) <= 'z' {
+		path = path[2:]
+	}
+
+	for _, char := range path {
+		if char < 32 || strings.ContainsRune(`<>:"|?*`, char) {
+			return false
+		}
+	}
+
+	for _, name := range strings.FieldsFunc(path, func(char rune) bool { return char == '\\' || char == '/' }) {
+		// Names cannot end with a dot or space, except for the relative names `.` and `..`.
+		if name != "." && name != ".." && (strings.HasSuffix(name, ".") || strings.HasSuffix(name, " ")) {
+			return false
+		}
+
+		if index := strings.Index(name, "."); index != -1 {
+			name = name[:index]
+		}
+
+		if windowsReservedNames[strings.ToUpper(name)] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FilePathValidator validates the syntax of file paths for the current operating system. The filesystem is not accessed.
+func FilePathValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if !isValidFilePath(typedValue, runtime.GOOS) {
+			return context.NewError("filePath.mustBeValidFilePath")
+		}
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// validateFileInfo validates a path by the file info of the filesystem. Requires the AllowFilesystem option.
+func validateFileInfo(context core.ValidatorContext, args []interface{}, isValid func(os.FileInfo) bool, localeKey string) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	if !core.HasFilesystemAccess(context.Context()) {
+		return context.NewError("filesystem.accessRequired")
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if len(typedValue) == 0 {
+			return context.NewError(localeKey)
+		}
+
+		if info, err := os.Stat(typedValue); err != nil || !isValid(info) {
+			return context.NewError(localeKey)
+		}
+
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// FileExistsValidator validates that a path is an existing file (not a directory).
+func FileExistsValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateFileInfo(context, args, func(info os.FileInfo) bool {
+		return !info.IsDir()
+	}, "fileExists.mustExist")
+}
+
+// DirExistsValidator validates that a path is an existing directory.
+func DirExistsValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateFileInfo(context, args, func(info os.FileInfo) bool {
+		return info.IsDir()
+	}, "dirExists.mustExist")
+}
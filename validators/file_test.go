@@ -0,0 +1,78 @@
+package validators_test
+
+import (
+	"context"
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestThatFilePathValidatorValidatesPaths(t *testing.T) {
+	if err := FilePathValidator(core.NewTestContext("dir/file.txt"), []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	invalidPaths := []string{"", "file\x00.txt"}
+
+	if runtime.GOOS == "windows" {
+		invalidPaths = append(invalidPaths, "file?.txt", `C:\dir\CON`, "dir.\\file")
+	}
+
+	for _, value := range invalidPaths {
+		if err := FilePathValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "filePath.mustBeValidFilePath" {
+			t.Fatalf("Expected file path error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatFileExistsValidatorRequiresFilesystemAccess(t *testing.T) {
+	if err := FileExistsValidator(core.NewTestContext(os.Args[0]), []interface{}{}); err == nil || err.Error() != "filesystem.accessRequired" {
+		t.Fatalf("Expected filesystem access required error, got %v.", err)
+	}
+}
+
+func TestThatFileExistsValidatorValidatesFiles(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+
+	if err := os.WriteFile(file, []byte("test"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := core.NewTestContext(file)
+	ctx.SetContext(core.WithFilesystemAccess(context.Background()))
+
+	if err := FileExistsValidator(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	for _, value := range []string{dir, filepath.Join(dir, "missing.txt")} {
+		ctx := core.NewTestContext(value)
+		ctx.SetContext(core.WithFilesystemAccess(context.Background()))
+
+		if err := FileExistsValidator(ctx, []interface{}{}); err == nil || err.Error() != "fileExists.mustExist" {
+			t.Fatalf("Expected file exists error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatDirExistsValidatorValidatesDirectories(t *testing.T) {
+	dir := t.TempDir()
+
+	ctx := core.NewTestContext(dir)
+	ctx.SetContext(core.WithFilesystemAccess(context.Background()))
+
+	if err := DirExistsValidator(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	ctx = core.NewTestContext(filepath.Join(dir, "missing"))
+	ctx.SetContext(core.WithFilesystemAccess(context.Background()))
+
+	if err := DirExistsValidator(ctx, []interface{}{}); err == nil || err.Error() != "dirExists.mustExist" {
+		t.Fatalf("Expected dir exists error, got %v.", err)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/typerandom/validator/core"
 )
 
+// FuncValidator calls a method of the struct being validated, named by the first argument or `Validate` followed by
+// the name of the field, i.e. `func(CheckName)`. The method receives the context and the remaining arguments, or only
+// the value of the field, and returns an error.
 func FuncValidator(context core.ValidatorContext, args []interface{}) error {
 	var funcName string
 	var funcArgs []interface{}
@@ -24,9 +27,18 @@ func FuncValidator(context core.ValidatorContext, args []interface{}) error {
 
 	returnValues, err := core.CallDynamicMethod(context.Source(), funcName, context, funcArgs)
 
+	// Methods can receive the value of the field instead, unless arguments are passed to them,
+	// i.e. `func (u *User) CheckName(name string) error`.
+	if errors.Is(err, core.InputParameterMismatchError) && len(funcArgs) == 0 {
+		returnValues, err = core.CallDynamicMethod(context.Source(), funcName, fieldValue(context))
+	}
+
 	if err != nil {
-		if err == core.InvalidMethodError {
-			return errors.New("Validation method '" + context.Field().Parent.FullName(funcName) + "' on field '{field}' does not exist.")
+		if errors.Is(err, core.InvalidMethodError) {
+			return errors.New("Validation method '" + methodName(context, funcName) + "' on field '{field}' does not exist.")
+		}
+		if errors.Is(err, core.InputParameterMismatchError) {
+			return errors.New("Invalid parameters of validation method '" + methodName(context, funcName) + "'. Parameters must be of types 'core.ValidatorContext' and '[]interface{}', or of the type of field '{field}'.")
 		}
 		return err
 	}
@@ -39,5 +51,25 @@ func FuncValidator(context core.ValidatorContext, args []interface{}) error {
 		}
 	}
 
-	return errors.New("Invalid return value(s) of validation method '" + context.Field().Parent.FullName(funcName) + "'. Return value must be of type 'error'.")
+	return errors.New("Invalid return value(s) of validation method '" + methodName(context, funcName) + "'. Return value must be of type 'error'.")
+}
+
+// methodName returns the name of a method of the struct being validated, prefixed by the path of the struct, i.e.
+// `Address.ValidateCity`.
+func methodName(context core.ValidatorContext, funcName string) string {
+	if field := context.Field(); field != nil && field.Parent != nil {
+		return field.Parent.FullName(funcName)
+	}
+	return funcName
+}
+
+// fieldValue returns the value of the field as declared by the struct, or the normalized value if the value isn't a
+// field of the source, i.e. an item of a slice.
+func fieldValue(context core.ValidatorContext) interface{} {
+	if context.Field() != nil {
+		if field, err := core.GetSiblingField(context.Source(), context.Field().Name); err == nil {
+			return field.Interface()
+		}
+	}
+	return context.Value()
 }
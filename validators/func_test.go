@@ -190,3 +190,109 @@ func TestThatFuncValidatorSucceedsForExistingExplicitWithArgumentsMethod(t *test
 		t.Fatalf("Didn't expect error, got %s.", err)
 	}
 }
+
+type valueFuncDummy struct {
+	TestValue string
+	Nickname  *string
+}
+
+func (f *valueFuncDummy) ValidateTestValue(value string) error {
+	if value != f.TestValue {
+		return errors.New("Expected the value of the field.")
+	}
+	if value == "admin" {
+		return errors.New("{field} is reserved.")
+	}
+	return nil
+}
+
+func (f *valueFuncDummy) CheckNickname(nickname *string) error {
+	if nickname == nil {
+		return errors.New("{field} is missing.")
+	}
+	return nil
+}
+
+func TestThatFuncValidatorPassesValueOfFieldToMethod(t *testing.T) {
+	dummy := &valueFuncDummy{TestValue: "john"}
+
+	if err := FuncValidator(newFuncTestContext(dummy, "TestValue"), []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	dummy.TestValue = "admin"
+
+	if err := FuncValidator(newFuncTestContext(dummy, "TestValue"), []interface{}{}); err == nil || err.Error() != "{field} is reserved." {
+		t.Fatalf("Expected reserved error, got %v.", err)
+	}
+
+	if err := FuncValidator(newFuncTestContext(dummy, "Nickname"), []interface{}{"CheckNickname"}); err == nil || err.Error() != "{field} is missing." {
+		t.Fatalf("Expected missing error, got %v.", err)
+	}
+}
+
+func TestThatFuncValidatorFailsForMethodWithValueOfOtherType(t *testing.T) {
+	dummy := &valueFuncDummy{}
+
+	err := FuncValidator(newFuncTestContext(dummy, "TestValue"), []interface{}{"CheckNickname"})
+
+	if err == nil || err.Error() != "Invalid parameters of validation method 'CheckNickname'. Parameters must be of types 'core.ValidatorContext' and '[]interface{}', or of the type of field '{field}'." {
+		t.Fatalf("Expected invalid parameters error, got %v.", err)
+	}
+}
+
+type variadicFuncDummy struct {
+	Count int
+}
+
+func (f *variadicFuncDummy) CheckCount(context core.ValidatorContext, args ...interface{}) error {
+	if len(args) != 2 || args[0] != "min" || args[1] != float64(1) {
+		return errors.New("Expected arguments to be passed as variadic arguments.")
+	}
+	return nil
+}
+
+func (f *variadicFuncDummy) ValidateCount(count int64) error {
+	if count < 1 {
+		return errors.New("{field} is too small.")
+	}
+	return nil
+}
+
+func TestThatFuncValidatorPassesArgumentsToVariadicMethod(t *testing.T) {
+	dummy := &variadicFuncDummy{}
+
+	if err := FuncValidator(newFuncTestContext(dummy, "Count"), []interface{}{"CheckCount", "min", float64(1)}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+}
+
+func TestThatFuncValidatorConvertsValueOfFieldToParameterOfMethod(t *testing.T) {
+	dummy := &variadicFuncDummy{}
+
+	if err := FuncValidator(newFuncTestContext(dummy, "Count"), []interface{}{}); err == nil || err.Error() != "{field} is too small." {
+		t.Fatalf("Expected too small error, got %v.", err)
+	}
+}
+
+type topLevelFuncDummy struct {
+	Name string
+}
+
+func (this *topLevelFuncDummy) CheckName(first, second int) error {
+	return nil
+}
+
+func TestThatFuncValidatorFailsForMethodsOfTopLevelFields(t *testing.T) {
+	ctx := core.NewTestContext(nil)
+	ctx.SetSource(&topLevelFuncDummy{})
+	ctx.SetField(&core.ReflectedField{Name: "Name"})
+
+	if err := FuncValidator(ctx, []interface{}{}); err == nil || err.Error() != "Validation method 'ValidateName' on field '{field}' does not exist." {
+		t.Fatalf("Expected missing method error, got %v.", err)
+	}
+
+	if err := FuncValidator(ctx, []interface{}{"CheckName"}); err == nil || err.Error() != "Invalid parameters of validation method 'CheckName'. Parameters must be of types 'core.ValidatorContext' and '[]interface{}', or of the type of field '{field}'." {
+		t.Fatalf("Expected invalid parameters error, got %v.", err)
+	}
+}
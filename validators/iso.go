@@ -0,0 +1,259 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"strings"
+	"sync"
+	"time"
+	_ "time/tzdata"
+)
+
+// countryAlpha3Codes are the ISO 3166-1 alpha-3 codes of countries.
+var countryAlpha3Codes = func() map[string]bool {
+	codes := make(map[string]bool, len(countryCodes))
+
+	for _, code := range countryCodes {
+		codes[code] = true
+	}
+
+	return codes
+}()
+
+// CountryCodeValidator validates that a string is an ISO 3166-1 country code, in upper case. Alpha-2 codes are
+// validated by default, alpha-3 codes with `iso3166(alpha3)` and either with `iso3166(alpha2,alpha3)`.
+func CountryCodeValidator(context core.ValidatorContext, args []interface{}) error {
+	alpha2, alpha3 := len(args) == 0, false
+
+	for i, arg := range args {
+		switch arg {
+		case "alpha2":
+			alpha2 = true
+		case "alpha3":
+			alpha3 = true
+		default:
+			return context.NewError("arguments.invalidType", i+1, "alpha2 or alpha3")
+		}
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if alpha2 && len(value) == 2 {
+		if _, ok := countryCodes[value]; ok {
+			return nil
+		}
+	}
+
+	if alpha3 && len(value) == 3 && countryAlpha3Codes[value] {
+		return nil
+	}
+
+	return context.NewError("iso3166.mustBeCountryCode")
+}
+
+// CurrencyCodeValidator validates that a string is an ISO 4217 code of an active currency, in upper case.
+func CurrencyCodeValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if !currencyCodes[value] {
+		return context.NewError("iso4217.mustBeCurrencyCode")
+	}
+
+	return nil
+}
+
+// irregularLanguageTags are the grandfathered BCP 47 language tags that don't match the syntax of language tags.
+var irregularLanguageTags = toSet("en-gb-oed", "i-ami", "i-bnn", "i-default", "i-enochian", "i-hak", "i-klingon",
+	"i-lux", "i-mingo", "i-navajo", "i-pwn", "i-tao", "i-tay", "i-tsu", "sgn-be-fr", "sgn-be-nl", "sgn-ch-de")
+
+// LanguageTagValidator validates that a string is a well-formed BCP 47 language tag, i.e. `en`, `sv-SE` or
+// `zh-Hant-TW`, regardless of case. Two letter language and region subtags must be ISO 639-1 and ISO 3166-1 codes.
+func LanguageTagValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if !isLanguageTag(strings.ToLower(value)) {
+		return context.NewError("bcp47.mustBeLanguageTag")
+	}
+
+	return nil
+}
+
+// isLanguageTag checks whether a lower case string is a language tag as defined by RFC 5646.
+func isLanguageTag(tag string) bool {
+	if irregularLanguageTags[tag] {
+		return true
+	}
+
+	subtags := strings.Split(tag, "-")
+
+	if subtags[0] == "x" {
+		return isPrivateUse(subtags[1:])
+	}
+
+	language := subtags[0]
+
+	if !isAlpha(language) || len(language) < 2 || len(language) > 8 {
+		return false
+	}
+
+	if len(language) == 2 && !languageCodes[language] {
+		return false
+	}
+
+	i := 1
+
+	// Up to three extended language subtags may follow a language of two or three letters, i.e. `zh-yue`.
+	if len(language) <= 3 {
+		for extlangs := 0; extlangs < 3 && i < len(subtags) && len(subtags[i]) == 3 && isAlpha(subtags[i]); extlangs++ {
+			i++
+		}
+	}
+
+	if i < len(subtags) && len(subtags[i]) == 4 && isAlpha(subtags[i]) {
+		i++
+	}
+
+	if i < len(subtags) && ((len(subtags[i]) == 2 && isAlpha(subtags[i])) || (len(subtags[i]) == 3 && isDigits(subtags[i]))) {
+		if len(subtags[i]) == 2 {
+			if _, ok := countryCodes[strings.ToUpper(subtags[i])]; !ok {
+				return false
+			}
+		}
+		i++
+	}
+
+	variants := map[string]bool{}
+
+	for ; i < len(subtags) && isVariant(subtags[i]); i++ {
+		if variants[subtags[i]] {
+			return false
+		}
+		variants[subtags[i]] = true
+	}
+
+	singletons := map[string]bool{}
+
+	for i < len(subtags) && len(subtags[i]) == 1 && subtags[i] != "x" {
+		if singletons[subtags[i]] || !isAlphanumeric(subtags[i]) {
+			return false
+		}
+		singletons[subtags[i]] = true
+		i++
+
+		start := i
+
+		for i < len(subtags) && len(subtags[i]) >= 2 && len(subtags[i]) <= 8 && isAlphanumeric(subtags[i]) {
+			i++
+		}
+
+		if i == start {
+			return false
+		}
+	}
+
+	if i < len(subtags) && subtags[i] == "x" {
+		return isPrivateUse(subtags[i+1:])
+	}
+
+	return i == len(subtags)
+}
+
+// isPrivateUse checks whether the subtags following `x` are valid private use subtags.
+func isPrivateUse(subtags []string) bool {
+	if len(subtags) == 0 {
+		return false
+	}
+
+	for _, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphanumeric(subtag) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isVariant checks whether a subtag is a variant, i.e. `1901` or `rozaj`.
+func isVariant(subtag string) bool {
+	if !isAlphanumeric(subtag) {
+		return false
+	}
+	return (len(subtag) >= 5 && len(subtag) <= 8) || (len(subtag) == 4 && subtag[0] >= '0' && subtag[0] <= '9')
+}
+
+func isAlpha(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < 'a' || value[i] > 'z' {
+			return false
+		}
+	}
+	return len(value) > 0
+}
+
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return len(value) > 0
+}
+
+func isAlphanumeric(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if (value[i] < 'a' || value[i] > 'z') && (value[i] < '0' || value[i] > '9') {
+			return false
+		}
+	}
+	return len(value) > 0
+}
+
+// timezones caches the names of IANA time zones that were loaded, as loading them reads the zone database. Names that
+// failed to load aren't cached, so that the cache is bounded by the zone database regardless of the input.
+var timezones sync.Map
+
+// TimezoneValidator validates that a string is the name of an IANA time zone, i.e. `Europe/Stockholm` or `UTC`, by
+// loading it with time.LoadLocation. The zone database embedded by time/tzdata is used if the system has none.
+func TimezoneValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if _, ok := timezones.Load(value); ok {
+		return nil
+	}
+
+	// LoadLocation returns UTC for an empty name and the local time zone for `Local`, neither are zone names.
+	if _, err := time.LoadLocation(value); err != nil || value == "" || value == "Local" {
+		return context.NewError("timezone.mustBeTimezone")
+	}
+
+	timezones.Store(value, true)
+
+	return nil
+}
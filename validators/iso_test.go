@@ -0,0 +1,92 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+type codeValidatorTest struct {
+	value string
+	args  []interface{}
+	valid bool
+}
+
+func testCodeValidator(t *testing.T, validator core.ValidatorFn, tests []codeValidatorTest) {
+	for _, test := range tests {
+		err := validator(core.NewTestContext(test.value), test.args)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for '%s' %v, but got one (%s).", test.value, test.args, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for '%s' %v, didn't get any.", test.value, test.args)
+		}
+	}
+}
+
+func TestThatCountryCodeValidatorValidatesCodes(t *testing.T) {
+	testCodeValidator(t, CountryCodeValidator, []codeValidatorTest{
+		{"SE", nil, true},
+		{"se", nil, false},
+		{"XX", nil, false},
+		{"SWE", nil, false},
+		{"SWE", []interface{}{"alpha3"}, true},
+		{"SE", []interface{}{"alpha3"}, false},
+		{"USA", []interface{}{"alpha2", "alpha3"}, true},
+		{"US", []interface{}{"alpha2", "alpha3"}, true},
+	})
+
+	if err := CountryCodeValidator(core.NewTestContext("SE"), []interface{}{"numeric"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatCurrencyCodeValidatorValidatesCodes(t *testing.T) {
+	testCodeValidator(t, CurrencyCodeValidator, []codeValidatorTest{
+		{"SEK", nil, true},
+		{"EUR", nil, true},
+		{"usd", nil, false},
+		{"ABC", nil, false},
+		{"HRK", nil, false},
+	})
+}
+
+func TestThatLanguageTagValidatorValidatesTags(t *testing.T) {
+	testCodeValidator(t, LanguageTagValidator, []codeValidatorTest{
+		{"en", nil, true},
+		{"sv-SE", nil, true},
+		{"zh-Hant-TW", nil, true},
+		{"zh-yue-HK", nil, true},
+		{"es-419", nil, true},
+		{"sl-rozaj-biske", nil, true},
+		{"de-CH-1901", nil, true},
+		{"en-US-u-ca-gregory-x-private", nil, true},
+		{"x-whatever", nil, true},
+		{"i-klingon", nil, true},
+		{"yue", nil, true},
+		{"", nil, false},
+		{"e", nil, false},
+		{"qq", nil, false},
+		{"en-XX", nil, false},
+		{"en_US", nil, false},
+		{"en-", nil, false},
+		{"de-1901-1901", nil, false},
+		{"en-a-bbb-a-ccc", nil, false},
+		{"en-a", nil, false},
+		{"en-x", nil, false},
+		{"abcdefghi", nil, false},
+	})
+}
+
+func TestThatTimezoneValidatorValidatesZoneNames(t *testing.T) {
+	testCodeValidator(t, TimezoneValidator, []codeValidatorTest{
+		{"Europe/Stockholm", nil, true},
+		{"UTC", nil, true},
+		{"Europe/Stockholm", nil, true},
+		{"Mars/Olympus_Mons", nil, false},
+		{"Local", nil, false},
+		{"", nil, false},
+	})
+}
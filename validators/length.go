@@ -0,0 +1,62 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+)
+
+// LengthArguments is the argument schema of LengthValidator.
+var LengthArguments = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentInt, core.ArgumentInt}, Required: 1}
+
+// LengthValidator validates the exact number of characters of a string, or items of a collection, i.e. `length(8)`.
+// With two arguments the length must be within the inclusive range, i.e. `length(8,64)`.
+func LengthValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) != 1 && len(args) != 2 {
+		return context.NewError("arguments.oneOrTwoRequired")
+	}
+
+	bounds := make([]int, len(args))
+
+	for i, arg := range args {
+		var bound float64
+
+		switch typedArg := arg.(type) {
+		case int64:
+			bound = float64(typedArg)
+		case float64:
+			bound = typedArg
+		default:
+			return context.NewError("arguments.invalidType", i+1, "positive integer")
+		}
+
+		if bound < 0 || bound != float64(int(bound)) {
+			return context.NewError("arguments.invalidType", i+1, "positive integer")
+		}
+
+		bounds[i] = int(bound)
+	}
+
+	var length int
+	var exactKey, rangeKey string
+
+	if typedValue, ok := context.Value().(string); ok {
+		length = core.StringLength(context.Context(), typedValue)
+		exactKey, rangeKey = "length.mustHaveLength", "length.mustHaveLengthBetween"
+	} else if length, ok = core.Length(context.Value()); ok {
+		exactKey, rangeKey = "length.mustContainItems", "length.mustContainItemsBetween"
+	} else {
+		return context.NewError("type.unsupported")
+	}
+
+	if len(bounds) == 1 {
+		if length != bounds[0] {
+			return context.NewError(exactKey, bounds[0])
+		}
+		return nil
+	}
+
+	if length < bounds[0] || length > bounds[1] {
+		return context.NewError(rangeKey, bounds[0], bounds[1])
+	}
+
+	return nil
+}
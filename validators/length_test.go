@@ -0,0 +1,61 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatLengthValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("abc")
+
+	if err := LengthValidator(ctx, []interface{}{}); err == nil || err.Error() != "arguments.oneOrTwoRequired" {
+		t.Fatalf("Expected one or two arguments required error, got %v.", err)
+	}
+
+	if err := LengthValidator(ctx, []interface{}{float64(1.5)}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatLengthValidatorValidatesExactLength(t *testing.T) {
+	if err := LengthValidator(core.NewTestContext("åäö"), []interface{}{float64(6)}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := LengthValidator(core.NewTestContext("abcd"), []interface{}{float64(3)}); err == nil || err.Error() != "length.mustHaveLength" {
+		t.Fatalf("Expected length error, got %v.", err)
+	}
+}
+
+func TestThatLengthValidatorValidatesLengthRange(t *testing.T) {
+	args := []interface{}{float64(2), float64(4)}
+
+	for _, value := range []string{"ab", "abc", "abcd"} {
+		if err := LengthValidator(core.NewTestContext(value), args); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"a", "abcde"} {
+		if err := LengthValidator(core.NewTestContext(value), args); err == nil || err.Error() != "length.mustHaveLengthBetween" {
+			t.Fatalf("Expected length error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatLengthValidatorValidatesCollections(t *testing.T) {
+	if err := LengthValidator(core.NewTestContext([]int{1, 2}), []interface{}{float64(2)}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := LengthValidator(core.NewTestContext(map[string]int{"a": 1}), []interface{}{float64(2), float64(3)}); err == nil || err.Error() != "length.mustContainItemsBetween" {
+		t.Fatalf("Expected length error, got %v.", err)
+	}
+}
+
+func TestThatLengthValidatorFailsForUnsupportedType(t *testing.T) {
+	if err := LengthValidator(core.NewTestContext(123), []interface{}{float64(3)}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
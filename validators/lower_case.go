@@ -12,7 +12,7 @@ func LowerCaseValidator(context core.ValidatorContext, args []interface{}) error
 
 	switch typedValue := context.Value().(type) {
 	case string:
-		if context.IsNil() || len(typedValue) == 0 {
+		if len(typedValue) == 0 {
 			return nil
 		}
 
@@ -3,6 +3,7 @@ package validators
 import (
 	"github.com/typerandom/validator/core"
 	"reflect"
+	"time"
 )
 
 func MaxValidator(context core.ValidatorContext, args []interface{}) error {
@@ -10,40 +11,60 @@ func MaxValidator(context core.ValidatorContext, args []interface{}) error {
 		return context.NewError("arguments.singleRequired")
 	}
 
-	if maxValue, ok := args[0].(float64); ok {
-		switch typedValue := context.Value().(type) {
-		case string:
-			if !context.IsNil() && len(typedValue) > int(maxValue) {
-				return context.NewError("max.cannotBeLongerThan", maxValue)
-			}
-			return nil
-		case int64:
-			if !context.IsNil() && typedValue > int64(maxValue) {
-				return context.NewError("max.cannotBeGreaterThan", maxValue)
-			}
-			return nil
-		case float64:
-			if !context.IsNil() && typedValue > maxValue {
-				return context.NewError("max.cannotBeGreaterThan", maxValue)
-			}
-			return nil
+	if compare, ok := core.ComparerOf(context.Context(), context.Value()); ok {
+		result, err := compareWithComparer(context, compare, args[0], 1)
+
+		if err != nil {
+			return err
 		}
 
-		switch context.OriginalKind() {
-		case reflect.Array, reflect.Slice:
-			if reflect.ValueOf(context.Value()).Len() > int(maxValue) {
-				return context.NewError("max.cannotContainMoreItemsThan", maxValue)
-			}
-			return nil
-		case reflect.Map:
-			if len(reflect.ValueOf(context.Value()).MapKeys()) > int(maxValue) {
-				return context.NewError("max.cannotContainMoreKeysThan", maxValue)
-			}
-			return nil
+		if result > 0 {
+			return context.NewError("max.cannotBeGreaterThan", args[0])
+		}
+
+		return nil
+	}
+
+	if typedValue, ok := context.Value().(time.Time); ok {
+		maxValue, ok := parseTimeArgument(args[0])
+
+		if !ok {
+			return context.NewError("arguments.invalidType", 1, "time")
+		}
+
+		if typedValue.After(maxValue) {
+			return context.NewError("max.cannotBeAfter", args[0])
 		}
-	} else {
+
+		return nil
+	}
+
+	if !isNumber(args[0]) {
 		return context.NewError("arguments.invalidType", 1, "number")
 	}
 
+	switch typedValue := context.Value().(type) {
+	case string:
+		if result, _ := compareNumbers(int64(core.StringLength(context.Context(), typedValue)), args[0]); result > 0 {
+			return context.NewError("max.cannotBeLongerThan", args[0])
+		}
+		return nil
+	case int64, uint64, float64:
+		if result, _ := compareNumbers(typedValue, args[0]); result > 0 {
+			return context.NewError("max.cannotBeGreaterThan", args[0])
+		}
+		return nil
+	}
+
+	if length, ok := core.Length(context.Value()); ok {
+		if result, _ := compareNumbers(int64(length), args[0]); result > 0 {
+			if context.OriginalKind() == reflect.Map {
+				return context.NewError("max.cannotContainMoreKeysThan", args[0])
+			}
+			return context.NewError("max.cannotContainMoreItemsThan", args[0])
+		}
+		return nil
+	}
+
 	return context.NewError("type.unsupported")
 }
@@ -4,7 +4,9 @@ import (
 	"errors"
 	"github.com/typerandom/validator/core"
 	. "github.com/typerandom/validator/validators"
+	"math/big"
 	"testing"
+	"time"
 )
 
 func TestThatMaxValidatorFailsForInvalidOptions(t *testing.T) {
@@ -139,3 +141,51 @@ func TestThatMaxValidatorFailsForUnsupportedType(t *testing.T) {
 	type Dummy struct{}
 	testThatMaxValidatorFailsForValueOverLimit(t, 5, &Dummy{}, "type.unsupported")
 }
+
+func TestThatMaxValidatorComparesTimeValues(t *testing.T) {
+	limit := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := MaxValidator(core.NewTestContext(limit), []interface{}{"2015-01-01"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := MaxValidator(core.NewTestContext(limit), []interface{}{"now"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err := MaxValidator(core.NewTestContext(limit.Add(time.Second)), []interface{}{"2015-01-01T00:00:00Z"})
+
+	if err == nil || err.Error() != "max.cannotBeAfter" {
+		t.Fatalf("Expected cannot be after error, got %v.", err)
+	}
+}
+
+func TestThatMaxValidatorComparesLargeIntegersExactly(t *testing.T) {
+	limit, _ := new(big.Int).SetString("9999999999999999999", 10)
+
+	if err := MaxValidator(core.NewTestContext(uint64(9999999999999999999)), []interface{}{limit}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err := MaxValidator(core.NewTestContext(uint64(10000000000000000000)), []interface{}{limit})
+
+	if err == nil || err.Error() != "max.cannotBeGreaterThan" {
+		t.Fatalf("Expected cannot be greater than error, got %v.", err)
+	}
+
+	err = MaxValidator(core.NewTestContext(int64(9007199254740993)), []interface{}{float64(9007199254740992)})
+
+	if err == nil || err.Error() != "max.cannotBeGreaterThan" {
+		t.Fatalf("Expected cannot be greater than error, got %v.", err)
+	}
+}
+
+func TestThatMaxValidatorComparesWithRegisteredComparer(t *testing.T) {
+	if err := MaxValidator(newMoneyContext(testMoney{100}), []interface{}{float64(100)}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := MaxValidator(newMoneyContext(testMoney{150}), []interface{}{float64(100)}); err == nil || err.Error() != "max.cannotBeGreaterThan" {
+		t.Fatalf("Expected greater than error, got %v.", err)
+	}
+}
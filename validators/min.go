@@ -3,6 +3,7 @@ package validators
 import (
 	"github.com/typerandom/validator/core"
 	"reflect"
+	"time"
 )
 
 func MinValidator(context core.ValidatorContext, args []interface{}) error {
@@ -10,40 +11,60 @@ func MinValidator(context core.ValidatorContext, args []interface{}) error {
 		return context.NewError("arguments.singleRequired")
 	}
 
-	if minValue, ok := args[0].(float64); ok {
-		switch typedValue := context.Value().(type) {
-		case string:
-			if context.IsNil() || len(typedValue) < int(minValue) {
-				return context.NewError("min.cannotBeShorterThan", minValue)
-			}
-			return nil
-		case int64:
-			if context.IsNil() || typedValue < int64(minValue) {
-				return context.NewError("min.cannotBeLessThan", minValue)
-			}
-			return nil
-		case float64:
-			if context.IsNil() || typedValue < minValue {
-				return context.NewError("min.cannotBeLessThan", minValue)
-			}
-			return nil
+	if compare, ok := core.ComparerOf(context.Context(), context.Value()); ok {
+		result, err := compareWithComparer(context, compare, args[0], 1)
+
+		if err != nil {
+			return err
 		}
 
-		switch context.OriginalKind() {
-		case reflect.Array, reflect.Slice:
-			if reflect.ValueOf(context.Value()).Len() < int(minValue) {
-				return context.NewError("min.cannotContainLessItemsThan", minValue)
-			}
-			return nil
-		case reflect.Map:
-			if len(reflect.ValueOf(context.Value()).MapKeys()) < int(minValue) {
-				return context.NewError("min.cannotContainLessKeysThan", minValue)
-			}
-			return nil
+		if result < 0 {
+			return context.NewError("min.cannotBeLessThan", args[0])
+		}
+
+		return nil
+	}
+
+	if typedValue, ok := context.Value().(time.Time); ok {
+		minValue, ok := parseTimeArgument(args[0])
+
+		if !ok {
+			return context.NewError("arguments.invalidType", 1, "time")
+		}
+
+		if typedValue.Before(minValue) {
+			return context.NewError("min.cannotBeBefore", args[0])
 		}
-	} else {
+
+		return nil
+	}
+
+	if !isNumber(args[0]) {
 		return context.NewError("arguments.invalidType", 1, "number")
 	}
 
+	switch typedValue := context.Value().(type) {
+	case string:
+		if result, _ := compareNumbers(int64(core.StringLength(context.Context(), typedValue)), args[0]); result < 0 {
+			return context.NewError("min.cannotBeShorterThan", args[0])
+		}
+		return nil
+	case int64, uint64, float64:
+		if result, _ := compareNumbers(typedValue, args[0]); result < 0 {
+			return context.NewError("min.cannotBeLessThan", args[0])
+		}
+		return nil
+	}
+
+	if length, ok := core.Length(context.Value()); ok {
+		if result, _ := compareNumbers(int64(length), args[0]); result < 0 {
+			if context.OriginalKind() == reflect.Map {
+				return context.NewError("min.cannotContainLessKeysThan", args[0])
+			}
+			return context.NewError("min.cannotContainLessItemsThan", args[0])
+		}
+		return nil
+	}
+
 	return context.NewError("type.unsupported")
 }
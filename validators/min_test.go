@@ -1,10 +1,13 @@
 package validators_test
 
 import (
+	"context"
 	"errors"
 	"github.com/typerandom/validator/core"
 	. "github.com/typerandom/validator/validators"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestThatMinValidatorFailsForInvalidOptions(t *testing.T) {
@@ -139,3 +142,110 @@ func TestThatMinValidatorFailsForUnsupportedType(t *testing.T) {
 	type Dummy struct{}
 	testThatMinValidatorFailsForValueUnderLimit(t, 5, &Dummy{}, "type.unsupported")
 }
+
+func TestThatMinValidatorComparesTimeValues(t *testing.T) {
+	limit := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testThatMinValidatorSucceedsForTimeValue(t, limit.Add(time.Hour), "2015-01-01")
+	testThatMinValidatorSucceedsForTimeValue(t, limit, "2015-01-01T00:00:00Z")
+	testThatMinValidatorFailsForTimeValue(t, limit.Add(-time.Second), "2015-01-01", "min.cannotBeBefore")
+	testThatMinValidatorFailsForTimeValue(t, limit, "now", "min.cannotBeBefore")
+	testThatMinValidatorFailsForTimeValue(t, limit, "abc", "arguments.invalidType")
+
+	var nilTime *time.Time
+	testThatMinValidatorFailsForTimeValue(t, nilTime, "2015-01-01", "min.cannotBeBefore")
+}
+
+func testThatMinValidatorSucceedsForTimeValue(t *testing.T, value interface{}, limit string) {
+	ctx := core.NewTestContext(value)
+
+	if err := MinValidator(ctx, []interface{}{limit}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func testThatMinValidatorFailsForTimeValue(t *testing.T, value interface{}, limit string, expectedErr string) {
+	ctx := core.NewTestContext(value)
+	err := MinValidator(ctx, []interface{}{limit})
+
+	if err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+
+	if err.Error() != expectedErr {
+		t.Fatalf("Expected '%s' error, got %s.", expectedErr, err)
+	}
+}
+
+func TestThatMinValidatorComparesFractionalLimitsExactly(t *testing.T) {
+	err := MinValidator(core.NewTestContext(0), []interface{}{0.5})
+
+	if err == nil || err.Error() != "min.cannotBeLessThan" {
+		t.Fatalf("Expected cannot be less than error, got %v.", err)
+	}
+
+	if err := MinValidator(core.NewTestContext(1), []interface{}{0.5}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := MinValidator(core.NewTestContext(0.5), []interface{}{0.5}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	err = MinValidator(core.NewTestContext("a"), []interface{}{1.5})
+
+	if err == nil || err.Error() != "min.cannotBeShorterThan" {
+		t.Fatalf("Expected cannot be shorter than error, got %v.", err)
+	}
+}
+
+type testMoney struct {
+	Cents int64
+}
+
+// newMoneyContext returns a test context of value, in which testMoney values are compared by their cents with
+// number arguments.
+func newMoneyContext(value interface{}) core.ValidatorContext {
+	registry := core.NewValidatorRegistry()
+
+	registry.RegisterComparer(reflect.TypeOf(testMoney{}), func(value interface{}, argument interface{}) (int, bool) {
+		bound, ok := argument.(float64)
+
+		if !ok {
+			return 0, false
+		}
+
+		cents := float64(value.(testMoney).Cents)
+
+		if cents < bound {
+			return -1, true
+		} else if cents > bound {
+			return 1, true
+		}
+
+		return 0, true
+	})
+
+	ctx := core.NewTestContext(value)
+	ctx.SetContext(core.WithComparers(context.Background(), registry))
+
+	return ctx
+}
+
+func TestThatMinValidatorComparesWithRegisteredComparer(t *testing.T) {
+	if err := MinValidator(newMoneyContext(testMoney{500}), []interface{}{float64(100)}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := MinValidator(newMoneyContext(testMoney{50}), []interface{}{float64(100)}); err == nil || err.Error() != "min.cannotBeLessThan" {
+		t.Fatalf("Expected less than error, got %v.", err)
+	}
+
+	if err := MinValidator(newMoneyContext(testMoney{50}), []interface{}{"abc"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+
+	if err := MinValidator(core.NewTestContext(testMoney{500}), []interface{}{float64(100)}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error without comparer, got %v.", err)
+	}
+}
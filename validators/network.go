@@ -0,0 +1,98 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"net"
+	"strings"
+)
+
+// validateNetworkString validates that the value is a string accepted by isValid, failing with localeKey if it's not.
+func validateNetworkString(context core.ValidatorContext, args []interface{}, localeKey string, isValid func(string) bool) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if !isValid(typedValue) {
+			return context.NewError(localeKey)
+		}
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+func IpValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "ip.mustBeValidIp", func(value string) bool {
+		return net.ParseIP(value) != nil
+	})
+}
+
+func Ipv4Validator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "ipv4.mustBeValidIpv4", func(value string) bool {
+		ip := net.ParseIP(value)
+		return ip != nil && ip.To4() != nil && !strings.Contains(value, ":")
+	})
+}
+
+func Ipv6Validator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "ipv6.mustBeValidIpv6", func(value string) bool {
+		return net.ParseIP(value) != nil && strings.Contains(value, ":")
+	})
+}
+
+func CidrValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "cidr.mustBeValidCidr", func(value string) bool {
+		_, _, err := net.ParseCIDR(value)
+		return err == nil
+	})
+}
+
+func MacValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "mac.mustBeValidMac", func(value string) bool {
+		_, err := net.ParseMAC(value)
+		return err == nil
+	})
+}
+
+// HostnameValidator validates that a string is a host name as defined by RFC 1123, i.e. `db-1.internal`. Labels
+// consist of letters, digits and hyphens, and can't start or end with a hyphen.
+func HostnameValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "hostname.mustBeValidHostname", func(value string) bool {
+		return isHostname(value)
+	})
+}
+
+// FqdnValidator validates that a string is a fully qualified domain name, i.e. `example.com` or `example.com.`. It's
+// a host name with at least two labels, of which the last is not numeric.
+func FqdnValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateNetworkString(context, args, "fqdn.mustBeValidFqdn", func(value string) bool {
+		value = strings.TrimSuffix(value, ".")
+		lastDot := strings.LastIndexByte(value, '.')
+		return lastDot > 0 && isHostname(value) && !isDigits(value[lastDot+1:])
+	})
+}
+
+// isHostname checks whether a string is a host name as defined by RFC 1123.
+func isHostname(value string) bool {
+	if len(value) == 0 || len(value) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+
+		for i := 0; i < len(label); i++ {
+			char := label[i]
+
+			if (char < 'a' || char > 'z') && (char < 'A' || char > 'Z') && (char < '0' || char > '9') && char != '-' {
+				return false
+			}
+		}
+	}
+
+	return true
+}
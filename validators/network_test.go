@@ -0,0 +1,97 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"strings"
+	"testing"
+)
+
+func testThatNetworkValidatorSucceedsForValues(t *testing.T, validator core.ValidatorFn, values ...string) {
+	for _, value := range values {
+		ctx := core.NewTestContext(value)
+
+		if err := validator(ctx, []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+}
+
+func testThatNetworkValidatorFailsForValues(t *testing.T, validator core.ValidatorFn, expectedErr string, values ...string) {
+	for _, value := range values {
+		ctx := core.NewTestContext(value)
+
+		err := validator(ctx, []interface{}{})
+
+		if err == nil {
+			t.Fatalf("Expected error for '%s', didn't get any.", value)
+		}
+
+		if err.Error() != expectedErr {
+			t.Fatalf("Expected '%s' error for '%s', got %s.", expectedErr, value, err)
+		}
+	}
+}
+
+func TestThatNetworkValidatorsFailForInvalidOptions(t *testing.T) {
+	for _, validator := range []core.ValidatorFn{IpValidator, Ipv4Validator, Ipv6Validator, CidrValidator, MacValidator} {
+		ctx := core.NewTestContext("127.0.0.1")
+		err := validator(ctx, []interface{}{"abc"})
+
+		if err == nil {
+			t.Fatalf("Expected error, didn't get any.")
+		}
+
+		if err.Error() != "arguments.noneSupported" {
+			t.Fatalf("Expected none arguments supported error, got %s.", err)
+		}
+	}
+}
+
+func TestThatNetworkValidatorsFailForUnsupportedValueType(t *testing.T) {
+	for _, validator := range []core.ValidatorFn{IpValidator, Ipv4Validator, Ipv6Validator, CidrValidator, MacValidator} {
+		ctx := core.NewTestContext(123)
+		err := validator(ctx, []interface{}{})
+
+		if err.Error() != "type.unsupported" {
+			t.Fatalf("Expected unsupported type error, got %s.", err)
+		}
+	}
+}
+
+func TestThatIpValidatorValidatesIpAddresses(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, IpValidator, "127.0.0.1", "::1", "2001:db8::68")
+	testThatNetworkValidatorFailsForValues(t, IpValidator, "ip.mustBeValidIp", "", "localhost", "256.0.0.1", "127.0.0.1/8")
+}
+
+func TestThatIpv4ValidatorValidatesIpv4Addresses(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, Ipv4Validator, "127.0.0.1", "192.168.0.255")
+	testThatNetworkValidatorFailsForValues(t, Ipv4Validator, "ipv4.mustBeValidIpv4", "", "::1", "::ffff:127.0.0.1", "256.0.0.1")
+}
+
+func TestThatIpv6ValidatorValidatesIpv6Addresses(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, Ipv6Validator, "::1", "2001:db8::68", "::ffff:127.0.0.1")
+	testThatNetworkValidatorFailsForValues(t, Ipv6Validator, "ipv6.mustBeValidIpv6", "", "127.0.0.1", "2001:db8:::68")
+}
+
+func TestThatCidrValidatorValidatesCidrNotations(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, CidrValidator, "192.168.0.0/16", "2001:db8::/32")
+	testThatNetworkValidatorFailsForValues(t, CidrValidator, "cidr.mustBeValidCidr", "", "192.168.0.0", "192.168.0.0/33")
+}
+
+func TestThatMacValidatorValidatesMacAddresses(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, MacValidator, "00:00:5e:00:53:01", "00-00-5E-00-53-01", "0000.5e00.5301")
+	testThatNetworkValidatorFailsForValues(t, MacValidator, "mac.mustBeValidMac", "", "00:00:5e:00:53", "00:00:5e:00:53:zz")
+}
+
+func TestThatHostnameValidatorValidatesHostNames(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, HostnameValidator, "localhost", "db-1.internal", "3com.com", "EXAMPLE.com")
+	testThatNetworkValidatorFailsForValues(t, HostnameValidator, "hostname.mustBeValidHostname", "", "-db", "db-", "a..b",
+		"example.com.", "under_score", strings.Repeat("a", 64))
+}
+
+func TestThatFqdnValidatorValidatesDomainNames(t *testing.T) {
+	testThatNetworkValidatorSucceedsForValues(t, FqdnValidator, "example.com", "example.com.", "db-1.eu.example.org")
+	testThatNetworkValidatorFailsForValues(t, FqdnValidator, "fqdn.mustBeValidFqdn", "", "localhost", "192.168.0.1", ".com",
+		"example..com")
+}
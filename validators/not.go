@@ -32,6 +32,13 @@ func NotValidator(context core.ValidatorContext, args []interface{}) error {
 			}
 			return nil
 		}
+	case uint64:
+		if typedArgument, ok := argument.(float64); ok {
+			if float64(typedValue) == typedArgument {
+				return context.NewError("not.cannotBeValue", typedValue)
+			}
+			return nil
+		}
 	case float64:
 		if typedArgument, ok := argument.(float64); ok {
 			if typedValue == typedArgument {
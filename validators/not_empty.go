@@ -2,7 +2,7 @@ package validators
 
 import (
 	"github.com/typerandom/validator/core"
-	"reflect"
+	"time"
 )
 
 func NotEmptyValidator(context core.ValidatorContext, args []interface{}) error {
@@ -31,19 +31,15 @@ func NotEmptyValidator(context core.ValidatorContext, args []interface{}) error
 		if typedValue == 0 {
 			return cannotBeEmptyError()
 		}
-	}
-
-	switch context.OriginalKind() {
-	case reflect.Array, reflect.Slice:
-		if reflect.ValueOf(context.Value()).Len() == 0 {
-			return cannotBeEmptyError()
-		}
-		return nil
-	case reflect.Map:
-		if len(reflect.ValueOf(context.Value()).MapKeys()) == 0 {
+	case time.Time:
+		if typedValue.IsZero() {
 			return cannotBeEmptyError()
 		}
 	}
 
+	if length, ok := core.Length(context.Value()); ok && length == 0 {
+		return cannotBeEmptyError()
+	}
+
 	return nil
 }
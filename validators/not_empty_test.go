@@ -5,6 +5,7 @@ import (
 	"github.com/typerandom/validator/core"
 	. "github.com/typerandom/validator/validators"
 	"testing"
+	"time"
 )
 
 func TestThatNotEmptyValidatorSucceedsForInvalidOptions(t *testing.T) {
@@ -122,3 +123,16 @@ func TestThatNotEmptyValidatorSucceedsForUnhandledType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatNotEmptyValidatorFailsForZeroTime(t *testing.T) {
+	ctx := core.NewTestContext(time.Time{})
+	err := NotEmptyValidator(ctx, []interface{}{})
+
+	if err == nil || err.Error() != "notEmpty.cannotBeEmpty" {
+		t.Fatalf("Expected cannot be empty error, got %v.", err)
+	}
+
+	if err := NotEmptyValidator(core.NewTestContext(time.Now()), []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
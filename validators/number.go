@@ -0,0 +1,90 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core/parser"
+	"math"
+	"math/big"
+)
+
+// isNumber checks whether value is a normalized number or a number argument. Integer arguments that float64 can't
+// represent exactly are parsed as *big.Int.
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case int64, uint64, float64, *big.Int:
+		return true
+	}
+	return false
+}
+
+// compareNumbers compares two numbers exactly, without converting them to a type that loses precision, so that i.e.
+// `max(9007199254740992)` fails for 9007199254740993 and `min(0.5)` fails for 0. Returns false if either value is
+// not a number.
+func compareNumbers(a interface{}, b interface{}) (int, bool) {
+	if intA, ok := a.(int64); ok {
+		if intB, ok := b.(int64); ok {
+			switch {
+			case intA < intB:
+				return -1, true
+			case intA > intB:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+
+	floatA, exactA := exactFloat(a)
+	floatB, exactB := exactFloat(b)
+
+	if exactA && exactB {
+		return compareFloats(floatA, floatB), true
+	}
+
+	bigA, ok := toBigFloat(a)
+
+	if !ok {
+		return 0, false
+	}
+
+	bigB, ok := toBigFloat(b)
+
+	if !ok {
+		return 0, false
+	}
+
+	return bigA.Cmp(bigB), true
+}
+
+// exactFloat converts a number to float64 if float64 can represent it exactly.
+func exactFloat(value interface{}) (float64, bool) {
+	switch typedValue := value.(type) {
+	case float64:
+		return typedValue, true
+	case int64:
+		if typedValue >= -parser.MaxExactInteger && typedValue <= parser.MaxExactInteger {
+			return float64(typedValue), true
+		}
+	case uint64:
+		if typedValue <= parser.MaxExactInteger {
+			return float64(typedValue), true
+		}
+	}
+	return 0, false
+}
+
+// toBigFloat converts a number to *big.Float without losing precision. NaN can't be converted.
+func toBigFloat(value interface{}) (*big.Float, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return new(big.Float).SetInt64(typedValue), true
+	case uint64:
+		return new(big.Float).SetUint64(typedValue), true
+	case float64:
+		if math.IsNaN(typedValue) {
+			return nil, false
+		}
+		return big.NewFloat(typedValue), true
+	case *big.Int:
+		return new(big.Float).SetInt(typedValue), true
+	}
+	return nil, false
+}
@@ -12,7 +12,7 @@ func NumericValidator(context core.ValidatorContext, args []interface{}) error {
 
 	switch typedValue := context.Value().(type) {
 	case string:
-		if context.IsNil() || len(typedValue) == 0 {
+		if len(typedValue) == 0 {
 			return context.NewError("numeric.mustBeNumeric")
 		}
 
@@ -27,7 +27,7 @@ func NumericValidator(context core.ValidatorContext, args []interface{}) error {
 		}
 
 		return nil
-	case int64:
+	case int64, uint64:
 		return nil
 	case float64:
 		return nil
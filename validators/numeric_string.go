@@ -0,0 +1,171 @@
+package validators
+
+import (
+	"encoding/base64"
+	"github.com/typerandom/validator/core"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	integerPattern = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	decimalPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)$`)
+	hexPattern     = regexp.MustCompile(`^(0[xX])?[0-9a-fA-F]+$`)
+)
+
+// parseArgument checks the arguments of validators that support the `parse` argument, i.e. `integer(parse)`.
+// Parsing replaces the string value with the parsed value, so that it can be validated by range validators.
+func parseArgument(context core.ValidatorContext, args []interface{}) (bool, error) {
+	if len(args) == 0 {
+		return false, nil
+	}
+
+	if len(args) > 1 || args[0] != "parse" {
+		return false, context.NewError("arguments.invalid")
+	}
+
+	return true, nil
+}
+
+// setParsedUint sets an unsigned value as int64, or as uint64 if it doesn't fit into an int64.
+func setParsedUint(context core.ValidatorContext, value uint64) error {
+	if value > math.MaxInt64 {
+		return context.SetValue(value)
+	}
+	return context.SetValue(int64(value))
+}
+
+// IntegerValidator validates that a string is an integer, i.e. `-123`. Numbers without fractions are valid as well.
+func IntegerValidator(context core.ValidatorContext, args []interface{}) error {
+	parse, err := parseArgument(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if !integerPattern.MatchString(typedValue) {
+			return context.NewError("integer.mustBeInteger")
+		}
+
+		if !parse {
+			return nil
+		}
+
+		if value, err := strconv.ParseInt(typedValue, 10, 64); err == nil {
+			return context.SetValue(value)
+		}
+
+		if value, err := strconv.ParseUint(strings.TrimPrefix(typedValue, "+"), 10, 64); err == nil {
+			return setParsedUint(context, value)
+		}
+
+		return context.NewError("integer.mustBeInteger")
+	case int64, uint64:
+		return nil
+	case float64:
+		if typedValue != math.Trunc(typedValue) {
+			return context.NewError("integer.mustBeInteger")
+		}
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// DecimalValidator validates that a string is a decimal number, i.e. `-12.50`.
+func DecimalValidator(context core.ValidatorContext, args []interface{}) error {
+	parse, err := parseArgument(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if !decimalPattern.MatchString(typedValue) {
+			return context.NewError("decimal.mustBeDecimal")
+		}
+
+		if !parse {
+			return nil
+		}
+
+		value, err := strconv.ParseFloat(typedValue, 64)
+
+		if err != nil {
+			return context.NewError("decimal.mustBeDecimal")
+		}
+
+		return context.SetValue(value)
+	case int64, uint64, float64:
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// HexValidator validates that a string is a hexadecimal number, with or without a `0x` prefix.
+func HexValidator(context core.ValidatorContext, args []interface{}) error {
+	parse, err := parseArgument(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if !hexPattern.MatchString(typedValue) {
+			return context.NewError("hex.mustBeHex")
+		}
+
+		if !parse {
+			return nil
+		}
+
+		digits := strings.TrimPrefix(strings.TrimPrefix(typedValue, "0x"), "0X")
+
+		value, err := strconv.ParseUint(digits, 16, 64)
+
+		if err != nil {
+			return context.NewError("hex.mustBeHex")
+		}
+
+		return setParsedUint(context, value)
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+// Base64Validator validates that a string is standard base64 encoded. Parsing replaces the value with the decoded string.
+func Base64Validator(context core.ValidatorContext, args []interface{}) error {
+	parse, err := parseArgument(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if len(typedValue) == 0 {
+			return context.NewError("base64.mustBeBase64")
+		}
+
+		value, err := base64.StdEncoding.DecodeString(typedValue)
+
+		if err != nil {
+			return context.NewError("base64.mustBeBase64")
+		}
+
+		if parse {
+			return context.SetValue(string(value))
+		}
+
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
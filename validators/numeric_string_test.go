@@ -0,0 +1,127 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatNumericStringValidatorsFailForInvalidOptions(t *testing.T) {
+	for _, validate := range []core.ValidatorFn{IntegerValidator, DecimalValidator, HexValidator, Base64Validator} {
+		if err := validate(core.NewTestContext("1"), []interface{}{"abc"}); err == nil || err.Error() != "arguments.invalid" {
+			t.Fatalf("Expected invalid arguments error, got %v.", err)
+		}
+	}
+}
+
+func TestThatIntegerValidatorValidatesIntegers(t *testing.T) {
+	for _, value := range []interface{}{"123", "-5", "+7", 12, 3.0} {
+		if err := IntegerValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []interface{}{"", "1.5", "abc", "1e3", 1.5} {
+		if err := IntegerValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "integer.mustBeInteger" {
+			t.Fatalf("Expected integer error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatIntegerValidatorParsesValue(t *testing.T) {
+	ctx := core.NewTestContext("-42")
+
+	if err := IntegerValidator(ctx, []interface{}{"parse"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != int64(-42) {
+		t.Fatalf("Expected int64(-42), got %#v.", ctx.Value())
+	}
+
+	ctx = core.NewTestContext("18446744073709551615")
+
+	if err := IntegerValidator(ctx, []interface{}{"parse"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != uint64(18446744073709551615) {
+		t.Fatalf("Expected uint64 max, got %#v.", ctx.Value())
+	}
+}
+
+func TestThatDecimalValidatorValidatesDecimals(t *testing.T) {
+	for _, value := range []interface{}{"1", "-1.5", "12.", ".5", 1.5, 3} {
+		if err := DecimalValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []interface{}{"", ".", "1e3", "1,5", "abc"} {
+		if err := DecimalValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "decimal.mustBeDecimal" {
+			t.Fatalf("Expected decimal error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatDecimalValidatorParsesValue(t *testing.T) {
+	ctx := core.NewTestContext("12.5")
+
+	if err := DecimalValidator(ctx, []interface{}{"parse"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != 12.5 {
+		t.Fatalf("Expected 12.5, got %#v.", ctx.Value())
+	}
+}
+
+func TestThatHexValidatorValidatesHex(t *testing.T) {
+	for _, value := range []string{"ff", "0xFF", "0X1a2B", "123"} {
+		if err := HexValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "0x", "xyz", "ff "} {
+		if err := HexValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "hex.mustBeHex" {
+			t.Fatalf("Expected hex error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatHexValidatorParsesValue(t *testing.T) {
+	ctx := core.NewTestContext("0xff")
+
+	if err := HexValidator(ctx, []interface{}{"parse"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != int64(255) {
+		t.Fatalf("Expected int64(255), got %#v.", ctx.Value())
+	}
+}
+
+func TestThatBase64ValidatorValidatesBase64(t *testing.T) {
+	if err := Base64Validator(core.NewTestContext("dGVzdA=="), []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	for _, value := range []string{"", "dGVzdA", "not base64!"} {
+		if err := Base64Validator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "base64.mustBeBase64" {
+			t.Fatalf("Expected base64 error for %v, got %v.", value, err)
+		}
+	}
+}
+
+func TestThatBase64ValidatorParsesValue(t *testing.T) {
+	ctx := core.NewTestContext("dGVzdA==")
+
+	if err := Base64Validator(ctx, []interface{}{"parse"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != "test" {
+		t.Fatalf("Expected 'test', got %#v.", ctx.Value())
+	}
+}
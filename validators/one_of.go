@@ -0,0 +1,41 @@
+package validators
+
+import (
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"strings"
+)
+
+// OneOfValidator validates that a value equals one of the arguments, i.e. `one_of(red,green,blue)`.
+func OneOfValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) == 0 {
+		return context.NewError("arguments.oneOrMoreRequired")
+	}
+
+	value := context.Value()
+
+	switch value.(type) {
+	case string, int64, uint64, float64, bool:
+	default:
+		return context.NewError("type.unsupported")
+	}
+
+	values := make([]string, len(args))
+
+	for i, arg := range args {
+		values[i] = fmt.Sprint(arg)
+	}
+
+	for i, arg := range args {
+		if result, ok := compareValues(value, arg); ok && result == 0 {
+			return nil
+		}
+
+		// Arguments such as `one_of(1,2,3)` are parsed as numbers, so compare strings by their text as well.
+		if typedValue, ok := value.(string); ok && typedValue == values[i] {
+			return nil
+		}
+	}
+
+	return context.NewError("oneOf.mustBeOneOf", strings.Join(values, ", "))
+}
@@ -0,0 +1,55 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatOneOfValidatorFailsForInvalidOptions(t *testing.T) {
+	if err := OneOfValidator(core.NewTestContext("red"), []interface{}{}); err == nil || err.Error() != "arguments.oneOrMoreRequired" {
+		t.Fatalf("Expected one or more arguments required error, got %v.", err)
+	}
+}
+
+func TestThatOneOfValidatorSucceedsForValueInSet(t *testing.T) {
+	args := []interface{}{"red", "green", "blue"}
+
+	if err := OneOfValidator(core.NewTestContext("green"), args); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatOneOfValidatorFailsForValueNotInSet(t *testing.T) {
+	args := []interface{}{"red", "green", "blue"}
+
+	if err := OneOfValidator(core.NewTestContext("yellow"), args); err == nil || err.Error() != "oneOf.mustBeOneOf" {
+		t.Fatalf("Expected one of error, got %v.", err)
+	}
+}
+
+func TestThatOneOfValidatorSupportsNumbers(t *testing.T) {
+	args := []interface{}{float64(1), float64(2), float64(3)}
+
+	for _, value := range []interface{}{2, "2", uint8(3), 1.0} {
+		if err := OneOfValidator(core.NewTestContext(value), args); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+
+	if err := OneOfValidator(core.NewTestContext(4), args); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
+
+func TestThatOneOfValidatorValidatesNilAsZeroValue(t *testing.T) {
+	var dummy *string
+
+	if err := OneOfValidator(core.NewTestContext(dummy), []interface{}{""}); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if err := OneOfValidator(core.NewTestContext(dummy), []interface{}{"a"}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
@@ -0,0 +1,187 @@
+package validators
+
+import (
+	"context"
+	"github.com/typerandom/validator/core"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// DenyListFn reports whether a password is denied, i.e. because it's one of the most common passwords or has been
+// leaked in a breach.
+type DenyListFn func(ctx context.Context, password string) (bool, error)
+
+// DenyList returns a DenyListFn that denies the passwords, regardless of case.
+func DenyList(passwords ...string) DenyListFn {
+	denied := make(map[string]bool, len(passwords))
+
+	for _, password := range passwords {
+		denied[strings.ToLower(password)] = true
+	}
+
+	return func(ctx context.Context, password string) (bool, error) {
+		return denied[strings.ToLower(password)], nil
+	}
+}
+
+// passwordPolicy is the policy declared by the named arguments of `password`.
+type passwordPolicy struct {
+	classes int
+	upper   int
+	lower   int
+	digit   int
+	symbol  int
+	entropy float64
+}
+
+// parsePasswordPolicy parses the named arguments of `password`, i.e. `password(classes=4,entropy=60)`. At least
+// 3 classes of characters are required unless the argument is set.
+func parsePasswordPolicy(named map[string]interface{}) (*passwordPolicy, bool) {
+	policy := &passwordPolicy{classes: 3}
+
+	for name, value := range named {
+		number, ok := value.(float64)
+
+		if !ok || number < 0 {
+			return nil, false
+		}
+
+		switch name {
+		case "classes":
+			policy.classes = int(number)
+		case "upper":
+			policy.upper = int(number)
+		case "lower":
+			policy.lower = int(number)
+		case "digit":
+			policy.digit = int(number)
+		case "symbol":
+			policy.symbol = int(number)
+		case "entropy":
+			policy.entropy = number
+		default:
+			return nil, false
+		}
+	}
+
+	return policy, true
+}
+
+// passwordPoolSizes are the number of characters of each class, where other characters, i.e. letters without case,
+// are estimated.
+var passwordPoolSizes = map[string]int{"upper": 26, "lower": 26, "digit": 10, "symbol": 33, "other": 100}
+
+// passwordEntropy estimates the entropy of a password in bits as its length times the binary logarithm of the size
+// of the pool of characters it's drawn from, i.e. 26 for lower case letters only.
+func passwordEntropy(length int, counts map[string]int) float64 {
+	pool := 0
+
+	for class, size := range passwordPoolSizes {
+		if counts[class] > 0 {
+			pool += size
+		}
+	}
+
+	if pool == 0 {
+		return 0
+	}
+
+	return float64(length) * math.Log2(float64(pool))
+}
+
+// PasswordValidator validates the strength of passwords, without a deny list. See PasswordValidatorWith.
+func PasswordValidator(context core.ValidatorContext, args []interface{}) error {
+	return PasswordValidatorWith(nil)(context, args)
+}
+
+// PasswordValidatorWith returns a validator that validates the strength of passwords by named arguments:
+// `classes` is the number of classes of characters (upper case letters, lower case letters, digits and symbols) that
+// must be used, 3 by default; `upper`, `lower`, `digit` and `symbol` are the minimum number of characters of each
+// class; and `entropy` is the minimum estimated entropy in bits. I.e. `password(classes=2,digit=1,entropy=50)`.
+// Passwords that isDenied reports are denied, register it to replace `password`, i.e.
+// `Register("password", PasswordValidatorWith(DenyList("password", "123456")))`.
+func PasswordValidatorWith(isDenied DenyListFn) core.ValidatorFn {
+	return func(context core.ValidatorContext, args []interface{}) error {
+		if len(args) > 0 {
+			return context.NewError("arguments.noneSupported")
+		}
+
+		policy, ok := parsePasswordPolicy(context.NamedArguments())
+
+		if !ok {
+			return context.NewError("arguments.invalid")
+		}
+
+		value, ok := stringValue(context)
+
+		if !ok {
+			return context.NewError("type.unsupported")
+		}
+
+		counts := map[string]int{}
+		length := 0
+
+		for _, char := range value {
+			switch {
+			case unicode.IsUpper(char):
+				counts["upper"]++
+			case unicode.IsLower(char):
+				counts["lower"]++
+			case unicode.IsDigit(char):
+				counts["digit"]++
+			case char < unicode.MaxASCII && unicode.IsPrint(char):
+				counts["symbol"]++
+			default:
+				counts["other"]++
+			}
+			length++
+		}
+
+		classes := 0
+
+		for _, class := range []string{"upper", "lower", "digit", "symbol"} {
+			if counts[class] > 0 {
+				classes++
+			}
+		}
+
+		if classes < policy.classes {
+			return context.NewError("password.mustContainClasses", policy.classes)
+		}
+
+		if counts["upper"] < policy.upper {
+			return context.NewError("password.mustContainUpper", policy.upper)
+		}
+
+		if counts["lower"] < policy.lower {
+			return context.NewError("password.mustContainLower", policy.lower)
+		}
+
+		if counts["digit"] < policy.digit {
+			return context.NewError("password.mustContainDigits", policy.digit)
+		}
+
+		if counts["symbol"] < policy.symbol {
+			return context.NewError("password.mustContainSymbols", policy.symbol)
+		}
+
+		if passwordEntropy(length, counts) < policy.entropy {
+			return context.NewError("password.isTooWeak")
+		}
+
+		if isDenied != nil {
+			denied, err := isDenied(context.Context(), value)
+
+			if err != nil {
+				return err
+			}
+
+			if denied {
+				return context.NewError("password.isTooCommon")
+			}
+		}
+
+		return nil
+	}
+}
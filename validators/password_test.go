@@ -0,0 +1,106 @@
+package validators_test
+
+import (
+	"context"
+	"errors"
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func testPassword(password string, named map[string]interface{}) error {
+	ctx := core.NewTestContext(password)
+	ctx.SetNamedArguments(named)
+	return PasswordValidator(ctx, nil)
+}
+
+func TestThatPasswordValidatorRequiresThreeClassesByDefault(t *testing.T) {
+	if err := testPassword("Password1", nil); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := testPassword("password1", nil); err == nil || err.Error() != "password.mustContainClasses" {
+		t.Fatalf("Expected must contain classes error, got %v.", err)
+	}
+
+	if err := testPassword("password1", map[string]interface{}{"classes": float64(2)}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatPasswordValidatorValidatesMinimumCharactersOfClasses(t *testing.T) {
+	tests := []struct {
+		name      string
+		password  string
+		localeKey string
+	}{
+		{"upper", "PAssword1!", "password.mustContainUpper"},
+		{"lower", "PASSWOrd1!", "password.mustContainLower"},
+		{"digit", "Password12", "password.mustContainDigits"},
+		{"symbol", "Password!?", "password.mustContainSymbols"},
+	}
+
+	for _, test := range tests {
+		named := map[string]interface{}{test.name: float64(3)}
+
+		if err := testPassword(test.password, named); err == nil || err.Error() != test.localeKey {
+			t.Fatalf("Expected %s error for '%s', got %v.", test.localeKey, test.password, err)
+		}
+
+		named[test.name] = float64(2)
+
+		if err := testPassword(test.password, named); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", test.password, err)
+		}
+	}
+}
+
+func TestThatPasswordValidatorValidatesEntropy(t *testing.T) {
+	named := map[string]interface{}{"classes": float64(0), "entropy": float64(60)}
+
+	if err := testPassword("Abc123!", named); err == nil || err.Error() != "password.isTooWeak" {
+		t.Fatalf("Expected too weak error, got %v.", err)
+	}
+
+	if err := testPassword("correct horse battery staple", named); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatPasswordValidatorFailsForInvalidOptions(t *testing.T) {
+	if err := testPassword("Password1", map[string]interface{}{"length": float64(8)}); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+
+	if err := testPassword("Password1", map[string]interface{}{"classes": "all"}); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+
+	if err := PasswordValidator(core.NewTestContext("Password1"), []interface{}{float64(3)}); err == nil || err.Error() != "arguments.noneSupported" {
+		t.Fatalf("Expected no arguments supported error, got %v.", err)
+	}
+
+	if err := PasswordValidator(core.NewTestContext(123), nil); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
+
+func TestThatPasswordValidatorDeniesPasswordsOfDenyList(t *testing.T) {
+	validate := PasswordValidatorWith(DenyList("Password1"))
+
+	if err := validate(core.NewTestContext("PASSWORD1a"), nil); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := validate(core.NewTestContext("passworD1"), nil); err == nil || err.Error() != "password.isTooCommon" {
+		t.Fatalf("Expected too common error, got %v.", err)
+	}
+
+	failing := PasswordValidatorWith(func(ctx context.Context, password string) (bool, error) {
+		return false, errors.New("Service unavailable.")
+	})
+
+	if err := failing(core.NewTestContext("Password1"), nil); err == nil || err.Error() != "Service unavailable." {
+		t.Fatalf("Expected deny list error, got %v.", err)
+	}
+}
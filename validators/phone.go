@@ -0,0 +1,144 @@
+package validators
+
+import (
+	"errors"
+	"github.com/typerandom/validator/core"
+	"regexp"
+	"strings"
+)
+
+// PhoneBackend validates phone numbers, so that the default implementation can be replaced, i.e. by one based on
+// libphonenumber. Register the replacement with `Register("phone", NewPhoneValidator(backend))`.
+type PhoneBackend interface {
+	// IsValidPhoneNumber checks whether number is valid. The region is an ISO 3166-1 alpha-2 code in upper case that
+	// also allows national formats of the region, or empty if only E.164 numbers are allowed. Returns an error if the
+	// region isn't supported.
+	IsValidPhoneNumber(number string, region string) (bool, error)
+}
+
+var e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// phoneFormattingReplacer removes the characters that are commonly used to format national numbers.
+var phoneFormattingReplacer = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+
+type phoneRegion struct {
+	callingCode   string
+	trunkPrefix   string
+	minLength     int
+	maxLength     int
+	trunkOptional bool
+}
+
+// phoneRegions holds the calling codes, trunk prefixes and lengths of national significant numbers of regions. The
+// trunk prefix of the North American Numbering Plan is optional, i.e. `(415) 555-2671` and `1 415 555 2671`.
+var phoneRegions = map[string]phoneRegion{
+	"AU": {"61", "0", 9, 9, false},
+	"BR": {"55", "0", 10, 11, false},
+	"CA": {"1", "1", 10, 10, true},
+	"CN": {"86", "0", 10, 11, false},
+	"DE": {"49", "0", 6, 11, false},
+	"DK": {"45", "", 8, 8, false},
+	"ES": {"34", "", 9, 9, false},
+	"FI": {"358", "0", 5, 12, false},
+	"FR": {"33", "0", 9, 9, false},
+	"GB": {"44", "0", 9, 10, false},
+	"IN": {"91", "0", 10, 10, false},
+	"IT": {"39", "", 6, 11, false},
+	"JP": {"81", "0", 9, 10, false},
+	"NL": {"31", "0", 9, 9, false},
+	"NO": {"47", "", 8, 8, false},
+	"SE": {"46", "0", 7, 9, false},
+	"US": {"1", "1", 10, 10, true},
+}
+
+var digitsPattern = regexp.MustCompile(`^[0-9]+$`)
+
+type defaultPhoneBackend struct{}
+
+// DefaultPhoneBackend validates E.164 numbers, and national numbers of a limited set of regions by their length.
+var DefaultPhoneBackend PhoneBackend = defaultPhoneBackend{}
+
+func (defaultPhoneBackend) IsValidPhoneNumber(number string, region string) (bool, error) {
+	if len(region) == 0 {
+		return e164Pattern.MatchString(number), nil
+	}
+
+	regionInfo, ok := phoneRegions[region]
+
+	if !ok {
+		return false, errors.New("Phone region '" + region + "' is not supported.")
+	}
+
+	var nationalNumber string
+
+	if e164Pattern.MatchString(number) {
+		if !strings.HasPrefix(number, "+"+regionInfo.callingCode) {
+			return false, nil
+		}
+		nationalNumber = number[len(regionInfo.callingCode)+1:]
+	} else {
+		number = phoneFormattingReplacer.Replace(number)
+
+		if !digitsPattern.MatchString(number) {
+			return false, nil
+		}
+
+		if len(regionInfo.trunkPrefix) > 0 {
+			if strings.HasPrefix(number, regionInfo.trunkPrefix) && (!regionInfo.trunkOptional || len(number) > regionInfo.maxLength) {
+				number = number[len(regionInfo.trunkPrefix):]
+			} else if !regionInfo.trunkOptional {
+				return false, nil
+			}
+		}
+
+		nationalNumber = number
+	}
+
+	return len(nationalNumber) >= regionInfo.minLength && len(nationalNumber) <= regionInfo.maxLength, nil
+}
+
+// NewPhoneValidator creates a phone validator that validates numbers with backend.
+func NewPhoneValidator(backend PhoneBackend) core.ValidatorFn {
+	return func(context core.ValidatorContext, args []interface{}) error {
+		var region string
+
+		if len(args) > 1 {
+			return context.NewError("arguments.invalid")
+		} else if len(args) == 1 {
+			if typedArg, ok := args[0].(string); ok {
+				region = strings.ToUpper(typedArg)
+			} else {
+				return context.NewError("arguments.invalidType", 1, "string")
+			}
+		}
+
+		switch typedValue := context.Value().(type) {
+		case string:
+			if len(typedValue) == 0 {
+				return context.NewError("phone.mustBeValidPhone")
+			}
+
+			valid, err := backend.IsValidPhoneNumber(typedValue, region)
+
+			if err != nil {
+				return context.NewError("arguments.invalid")
+			}
+
+			if !valid {
+				return context.NewError("phone.mustBeValidPhone")
+			}
+
+			return nil
+		}
+
+		return context.NewError("type.unsupported")
+	}
+}
+
+// PhoneValidator validates E.164 phone numbers, i.e. `+46701234567`. A region argument also allows national
+// numbers of the region, i.e. `phone(SE)` allows `070-123 45 67`.
+func PhoneValidator(context core.ValidatorContext, args []interface{}) error {
+	return defaultPhoneValidator(context, args)
+}
+
+var defaultPhoneValidator = NewPhoneValidator(DefaultPhoneBackend)
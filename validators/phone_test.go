@@ -0,0 +1,79 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatPhoneValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("+46701234567")
+
+	if err := PhoneValidator(ctx, []interface{}{"XX"}); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+
+	if err := PhoneValidator(ctx, []interface{}{float64(46)}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatPhoneValidatorValidatesE164Numbers(t *testing.T) {
+	for _, value := range []string{"+46701234567", "+14155552671", "+442071838750"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "46701234567", "+0701234567", "+4670123456789012", "070-123 45 67"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "phone.mustBeValidPhone" {
+			t.Fatalf("Expected phone error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatPhoneValidatorValidatesNationalNumbersOfRegion(t *testing.T) {
+	for _, value := range []string{"+46701234567", "070-123 45 67", "0701234567"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{"se"}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"+14155552671", "701234567", "07012", "070-abc"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{"SE"}); err == nil || err.Error() != "phone.mustBeValidPhone" {
+			t.Fatalf("Expected phone error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatPhoneValidatorValidatesNationalNumbersWithOptionalTrunkPrefix(t *testing.T) {
+	for _, value := range []string{"(415) 555-2671", "415-555-2671", "4155552671", "1 415 555 2671", "+14155552671"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{"US"}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"555-2671", "2 415 555 2671", "11 415 555 2671"} {
+		if err := PhoneValidator(core.NewTestContext(value), []interface{}{"US"}); err == nil || err.Error() != "phone.mustBeValidPhone" {
+			t.Fatalf("Expected phone error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+type dummyPhoneBackend struct{}
+
+func (dummyPhoneBackend) IsValidPhoneNumber(number string, region string) (bool, error) {
+	return number == "12345", nil
+}
+
+func TestThatPhoneValidatorBackendCanBeReplaced(t *testing.T) {
+	validate := NewPhoneValidator(dummyPhoneBackend{})
+
+	if err := validate(core.NewTestContext("12345"), []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := validate(core.NewTestContext("+46701234567"), []interface{}{}); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
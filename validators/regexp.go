@@ -4,47 +4,68 @@ import (
 	"errors"
 	"github.com/typerandom/validator/core"
 	"regexp"
+	"sync"
 )
 
 var (
-	regexpCache map[string]*regexp.Regexp = map[string]*regexp.Regexp{}
+	regexpCache     map[string]*regexp.Regexp = map[string]*regexp.Regexp{}
+	regexpCacheLock sync.RWMutex
 )
 
-func RegexpValidator(context core.ValidatorContext, args []interface{}) error {
-	if len(args) != 1 {
-		return context.NewError("arguments.singleRequired")
+// compileRegexp compiles the pattern, or returns the cached expression if the pattern has been compiled before.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	regexpCacheLock.RLock()
+	expr, ok := regexpCache[pattern]
+	regexpCacheLock.RUnlock()
+
+	if ok {
+		return expr, nil
 	}
 
-	if pattern, ok := args[0].(string); ok {
-		if testValue, ok := context.Value().(string); ok {
-			if context.IsNil() {
-				return context.NewError("regexp.mustMatchPattern", pattern)
-			}
+	expr, err := regexp.Compile(pattern)
 
-			var expr *regexp.Regexp
+	if err != nil {
+		return nil, err
+	}
+
+	regexpCacheLock.Lock()
+	regexpCache[pattern] = expr
+	regexpCacheLock.Unlock()
+
+	return expr, nil
+}
 
-			if cachedExpr, ok := regexpCache[pattern]; ok {
-				expr = cachedExpr
-			} else {
-				newExpr, err := regexp.Compile(pattern)
+// RegexpArguments is the argument schema of RegexpValidator, which compiles the pattern when the tag is parsed.
+// Patterns can contain `=`, i.e. `match(a=b)`, so named arguments are passed as text.
+var RegexpArguments = &core.ArgumentSchema{Types: []core.ArgumentType{core.ArgumentRegexp}, Required: 1, NamedAsText: true}
 
-				if err != nil {
-					return errors.New("Unexpected regexp error for validator field '{field}': " + err.Error())
-				}
+func RegexpValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) != 1 {
+		return context.NewError("arguments.singleRequired")
+	}
 
-				expr = newExpr
-				regexpCache[pattern] = newExpr
-			}
+	var expr *regexp.Regexp
 
-			if !expr.MatchString(testValue) {
-				return context.NewError("regexp.mustMatchPattern", pattern)
-			}
+	switch typedArg := args[0].(type) {
+	case *regexp.Regexp:
+		expr = typedArg
+	case string:
+		var err error
 
-			return nil
+		if expr, err = compileRegexp(typedArg); err != nil {
+			return errors.New("Unexpected regexp error for validator field '{field}': " + err.Error())
 		}
-	} else {
+	default:
 		return context.NewError("arguments.invalidType", 1, "string")
 	}
 
+	if testValue, ok := context.Value().(string); ok {
+		if !expr.MatchString(testValue) {
+			return context.NewError("regexp.mustMatchPattern", expr.String())
+		}
+
+		return nil
+	}
+
 	return context.NewError("type.unsupported")
 }
@@ -86,3 +86,12 @@ func TestThatRegexpValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatRegexpValidatorFailsForInvalidPattern(t *testing.T) {
+	ctx := core.NewTestContext("test")
+	err := RegexpValidator(ctx, []interface{}{"^(test$"})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+}
@@ -0,0 +1,113 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"reflect"
+)
+
+// siblingsMatch checks whether the sibling fields match the values of the field/value argument pairs.
+// If all is true every pair must match, otherwise a single matching pair is enough.
+func siblingsMatch(context core.ValidatorContext, args []interface{}, all bool) (bool, error) {
+	for i := 0; i < len(args); i += 2 {
+		fieldName, ok := args[i].(string)
+
+		if !ok {
+			return false, context.NewError("arguments.invalidType", i+1, "string")
+		}
+
+		sibling, err := context.SiblingValue(fieldName)
+
+		if err != nil {
+			return false, errors.New("Unable to check field '" + fieldName + "' of validator '{validator}' on field '{field}'. " + err.Error())
+		}
+
+		var isMatch bool
+
+		if args[i+1] == nil {
+			isMatch = sibling.IsNil
+		} else {
+			isMatch = !sibling.IsNil && fmt.Sprintf("%v", sibling.Value) == fmt.Sprintf("%v", args[i+1])
+		}
+
+		if isMatch != all {
+			return isMatch, nil
+		}
+	}
+
+	return all, nil
+}
+
+func validateFieldValuePairs(context core.ValidatorContext, args []interface{}) error {
+	if len(args) == 0 || len(args)%2 != 0 {
+		return context.NewError("arguments.fieldValuePairsRequired")
+	}
+	return nil
+}
+
+// RequiredValidator requires the value to be present, that is not a nil pointer, interface, slice or map. Unlike
+// `not_empty`, zero values such as a pointer to an empty string are allowed, i.e. for partial updates.
+func RequiredValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	if context.IsNil() || isNilCollection(context.Value()) {
+		return context.NewError("required.isRequired")
+	}
+
+	return nil
+}
+
+// isNilCollection checks whether value is a nil slice or map, which are not normalized to nil values.
+func isNilCollection(value interface{}) bool {
+	reflectedValue := reflect.ValueOf(value)
+
+	switch reflectedValue.Kind() {
+	case reflect.Slice, reflect.Map:
+		return reflectedValue.IsNil()
+	}
+
+	return false
+}
+
+// RequiredIfValidator requires the value to be non empty if all of the sibling fields has the given values.
+// I.e. `required_if(Type,company)`.
+func RequiredIfValidator(context core.ValidatorContext, args []interface{}) error {
+	if err := validateFieldValuePairs(context, args); err != nil {
+		return err
+	}
+
+	isRequired, err := siblingsMatch(context, args, true)
+
+	if err != nil {
+		return err
+	}
+
+	if isRequired {
+		return NotEmptyValidator(context, nil)
+	}
+
+	return nil
+}
+
+// RequiredUnlessValidator requires the value to be non empty unless any of the sibling fields has the given value.
+// I.e. `required_unless(Type,person)`.
+func RequiredUnlessValidator(context core.ValidatorContext, args []interface{}) error {
+	if err := validateFieldValuePairs(context, args); err != nil {
+		return err
+	}
+
+	isExempt, err := siblingsMatch(context, args, false)
+
+	if err != nil {
+		return err
+	}
+
+	if !isExempt {
+		return NotEmptyValidator(context, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+type requiredDummy struct {
+	Type    string
+	Country *string
+	Age     int
+}
+
+func TestThatRequiredValidatorsFailForInvalidOptions(t *testing.T) {
+	for _, validator := range []core.ValidatorFn{RequiredIfValidator, RequiredUnlessValidator} {
+		ctx := newFieldTestContext(&requiredDummy{}, "")
+
+		for _, opts := range [][]interface{}{{}, {"Type"}, {"Type", "company", "Age"}} {
+			err := validator(ctx, opts)
+
+			if err == nil || err.Error() != "arguments.fieldValuePairsRequired" {
+				t.Fatalf("Expected field value pairs required error for %v, got %v.", opts, err)
+			}
+		}
+
+		err := validator(ctx, []interface{}{float64(1), "company"})
+
+		if err == nil || err.Error() != "arguments.invalidType" {
+			t.Fatalf("Expected invalid type error, got %v.", err)
+		}
+	}
+}
+
+func TestThatRequiredIfValidatorRequiresValueWhenFieldsMatch(t *testing.T) {
+	dummy := &requiredDummy{Type: "company", Age: 18}
+
+	err := RequiredIfValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "company"})
+
+	if err == nil || err.Error() != "notEmpty.cannotBeEmpty" {
+		t.Fatalf("Expected cannot be empty error, got %v.", err)
+	}
+
+	err = RequiredIfValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "company", "Age", float64(18)})
+
+	if err == nil || err.Error() != "notEmpty.cannotBeEmpty" {
+		t.Fatalf("Expected cannot be empty error, got %v.", err)
+	}
+
+	if err := RequiredIfValidator(newFieldTestContext(dummy, "ACME"), []interface{}{"Type", "company"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatRequiredIfValidatorDoesNotRequireValueWhenFieldsDoNotMatch(t *testing.T) {
+	dummy := &requiredDummy{Type: "person", Age: 18}
+
+	if err := RequiredIfValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "company"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if err := RequiredIfValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "person", "Age", float64(20)}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatRequiredIfValidatorCanMatchNilField(t *testing.T) {
+	err := RequiredIfValidator(newFieldTestContext(&requiredDummy{}, ""), []interface{}{"Country", nil})
+
+	if err == nil || err.Error() != "notEmpty.cannotBeEmpty" {
+		t.Fatalf("Expected cannot be empty error, got %v.", err)
+	}
+}
+
+func TestThatRequiredUnlessValidatorRequiresValueUnlessFieldMatches(t *testing.T) {
+	dummy := &requiredDummy{Type: "company"}
+
+	err := RequiredUnlessValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "person"})
+
+	if err == nil || err.Error() != "notEmpty.cannotBeEmpty" {
+		t.Fatalf("Expected cannot be empty error, got %v.", err)
+	}
+
+	if err := RequiredUnlessValidator(newFieldTestContext(dummy, ""), []interface{}{"Type", "person", "Type", "company"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+}
+
+func TestThatRequiredValidatorFailsForInvalidOptions(t *testing.T) {
+	if err := RequiredValidator(core.NewTestContext("abc"), []interface{}{"abc"}); err == nil || err.Error() != "arguments.noneSupported" {
+		t.Fatalf("Expected none supported error, got %v.", err)
+	}
+}
+
+func TestThatRequiredValidatorAllowsZeroValues(t *testing.T) {
+	emptyString := ""
+	zero := 0
+
+	for _, value := range []interface{}{&emptyString, &zero, "", 0, false, []string{}, map[string]int{}} {
+		if err := RequiredValidator(core.NewTestContext(value), []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for %v, but got one (%s).", value, err)
+		}
+	}
+}
+
+func TestThatRequiredValidatorFailsForNilValues(t *testing.T) {
+	var nilString *string
+	var nilSlice []string
+	var nilMap map[string]int
+
+	for _, value := range []interface{}{nilString, nilSlice, nilMap, nil} {
+		if err := RequiredValidator(core.NewTestContext(value), []interface{}{}); err == nil || err.Error() != "required.isRequired" {
+			t.Fatalf("Expected required error for %v, got %v.", value, err)
+		}
+	}
+}
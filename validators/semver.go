@@ -0,0 +1,100 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"strings"
+)
+
+// SemverValidator validates that a string is a semantic version as defined by semver.org, i.e. `1.2.3-rc.1+build.5`.
+// Pre-release versions and build metadata are allowed, unless forbidden by the named arguments, i.e.
+// `semver(prerelease=false,build=false)`.
+func SemverValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	allowPrerelease, allowBuild := true, true
+
+	for name, value := range context.NamedArguments() {
+		allowed, ok := value.(bool)
+
+		if !ok {
+			return context.NewError("arguments.invalid")
+		}
+
+		switch name {
+		case "prerelease":
+			allowPrerelease = allowed
+		case "build":
+			allowBuild = allowed
+		default:
+			return context.NewError("arguments.invalid")
+		}
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	version, build, hasBuild := strings.Cut(value, "+")
+	version, prerelease, hasPrerelease := strings.Cut(version, "-")
+
+	if !isSemverCore(version) || (hasPrerelease && !isSemverIdentifiers(prerelease, true)) ||
+		(hasBuild && !isSemverIdentifiers(build, false)) {
+		return context.NewError("semver.mustBeValidSemver")
+	}
+
+	if hasPrerelease && !allowPrerelease {
+		return context.NewError("semver.cannotHavePrerelease")
+	}
+
+	if hasBuild && !allowBuild {
+		return context.NewError("semver.cannotHaveBuild")
+	}
+
+	return nil
+}
+
+// isSemverCore checks whether a version is three numbers separated by dots, without leading zeros.
+func isSemverCore(version string) bool {
+	numbers := strings.Split(version, ".")
+
+	if len(numbers) != 3 {
+		return false
+	}
+
+	for _, number := range numbers {
+		if !isDigits(number) || (len(number) > 1 && number[0] == '0') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSemverIdentifiers checks whether the dot separated identifiers of a pre-release version or build metadata are
+// non-empty and only contain alphanumerics and hyphens. Numeric identifiers of pre-release versions can't have
+// leading zeros.
+func isSemverIdentifiers(identifiers string, prerelease bool) bool {
+	for _, identifier := range strings.Split(identifiers, ".") {
+		if len(identifier) == 0 {
+			return false
+		}
+
+		for i := 0; i < len(identifier); i++ {
+			char := identifier[i]
+
+			if (char < '0' || char > '9') && (char < 'a' || char > 'z') && (char < 'A' || char > 'Z') && char != '-' {
+				return false
+			}
+		}
+
+		if prerelease && len(identifier) > 1 && identifier[0] == '0' && isDigits(identifier) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,50 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatSemverValidatorValidatesVersions(t *testing.T) {
+	for _, value := range []string{"0.0.0", "1.2.3", "10.20.30", "1.0.0-alpha", "1.0.0-rc.1+build.5", "1.0.0+0017", "1.0.0-x-y.0a"} {
+		if err := SemverValidator(core.NewTestContext(value), nil); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+	}
+
+	for _, value := range []string{"", "1", "1.2", "1.2.3.4", "v1.2.3", "01.2.3", "1.2.3-01", "1.2.3-", "1.2.3+", "1.2.3-a..b", "1.2.3-ä"} {
+		if err := SemverValidator(core.NewTestContext(value), nil); err == nil || err.Error() != "semver.mustBeValidSemver" {
+			t.Fatalf("Expected must be valid semver error for '%s', got %v.", value, err)
+		}
+	}
+}
+
+func TestThatSemverValidatorCanForbidPrereleaseAndBuild(t *testing.T) {
+	ctx := core.NewTestContext("1.0.0-rc.1")
+	ctx.SetNamedArguments(map[string]interface{}{"prerelease": false})
+
+	if err := SemverValidator(ctx, nil); err == nil || err.Error() != "semver.cannotHavePrerelease" {
+		t.Fatalf("Expected cannot have pre-release error, got %v.", err)
+	}
+
+	ctx = core.NewTestContext("1.0.0+build.5")
+	ctx.SetNamedArguments(map[string]interface{}{"prerelease": false, "build": false})
+
+	if err := SemverValidator(ctx, nil); err == nil || err.Error() != "semver.cannotHaveBuild" {
+		t.Fatalf("Expected cannot have build error, got %v.", err)
+	}
+
+	ctx = core.NewTestContext("1.0.0")
+	ctx.SetNamedArguments(map[string]interface{}{"prerelease": false, "build": false})
+
+	if err := SemverValidator(ctx, nil); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	ctx.SetNamedArguments(map[string]interface{}{"metadata": false})
+
+	if err := SemverValidator(ctx, nil); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+}
@@ -0,0 +1,160 @@
+package validators
+
+import (
+	"fmt"
+	"github.com/typerandom/validator/core"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// stringValue returns the value of the context as a string. Strings and byte slices are supported.
+func stringValue(context core.ValidatorContext) (string, bool) {
+	switch typedValue := context.Value().(type) {
+	case string:
+		return typedValue, true
+	case []byte:
+		return string(typedValue), true
+	}
+	return "", false
+}
+
+// AsStringValidator converts values that implement encoding.TextMarshaler or fmt.Stringer to their textual form, so
+// that the following validators of the group validate the text, i.e. `as_string,min(36)` of a uuid.UUID.
+func AsStringValidator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	if _, ok := context.Value().(string); ok {
+		return nil
+	}
+
+	text, ok := core.TextOf(context.Value())
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	return context.SetValue(text)
+}
+
+// stringArguments returns the arguments as strings. Numbers are supported as well, since i.e. `starts_with(1)` is
+// parsed as a number. Empty strings are not supported.
+func stringArguments(context core.ValidatorContext, args []interface{}) ([]string, error) {
+	if len(args) == 0 {
+		return nil, context.NewError("arguments.oneOrMoreRequired")
+	}
+
+	values := make([]string, len(args))
+
+	for i, arg := range args {
+		if typedArg, ok := arg.(string); ok {
+			if len(typedArg) == 0 {
+				return nil, context.NewError("arguments.invalid")
+			}
+			values[i] = typedArg
+		} else if isNumber(arg) {
+			values[i] = fmt.Sprint(arg)
+		} else {
+			return nil, context.NewError("arguments.invalidType", i+1, "string")
+		}
+	}
+
+	return values, nil
+}
+
+// validateRunes validates that every character of a string satisfies isValid. Empty strings are valid.
+func validateRunes(context core.ValidatorContext, args []interface{}, localeKey string, isValid func(rune) bool) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	if !utf8.ValidString(value) {
+		return context.NewError(localeKey)
+	}
+
+	for _, char := range value {
+		if !isValid(char) {
+			return context.NewError(localeKey)
+		}
+	}
+
+	return nil
+}
+
+// validateSubstrings validates that a string matches any of the arguments, or none of them if exclude is set.
+func validateSubstrings(context core.ValidatorContext, args []interface{}, localeKey string, exclude bool, matches func(string, string) bool) error {
+	values, err := stringArguments(context, args)
+
+	if err != nil {
+		return err
+	}
+
+	value, ok := stringValue(context)
+
+	if !ok {
+		return context.NewError("type.unsupported")
+	}
+
+	for _, testValue := range values {
+		if matches(value, testValue) {
+			if exclude {
+				return context.NewError(localeKey, strings.Join(values, "', '"))
+			}
+			return nil
+		}
+	}
+
+	if exclude {
+		return nil
+	}
+
+	return context.NewError(localeKey, strings.Join(values, "', '"))
+}
+
+// AlphaValidator validates that a string only contains letters, of any script.
+func AlphaValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateRunes(context, args, "alpha.mustBeAlpha", unicode.IsLetter)
+}
+
+// AlphanumericValidator validates that a string only contains letters and digits, of any script.
+func AlphanumericValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateRunes(context, args, "alphanum.mustBeAlphanumeric", func(char rune) bool {
+		return unicode.IsLetter(char) || unicode.IsDigit(char)
+	})
+}
+
+// AsciiValidator validates that a string only contains ASCII characters.
+func AsciiValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateRunes(context, args, "ascii.mustBeAscii", func(char rune) bool {
+		return char < utf8.RuneSelf
+	})
+}
+
+// PrintableValidator validates that a string only contains printable characters, which include spaces but not
+// tabs, line breaks or other control characters.
+func PrintableValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateRunes(context, args, "printable.mustBePrintable", unicode.IsPrint)
+}
+
+// StartsWithValidator validates that a string starts with any of the arguments, i.e. `starts_with(´https://´)`.
+func StartsWithValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateSubstrings(context, args, "startsWith.mustStartWith", false, strings.HasPrefix)
+}
+
+// EndsWithValidator validates that a string ends with any of the arguments, i.e. `ends_with(.com,.org)`.
+func EndsWithValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateSubstrings(context, args, "endsWith.mustEndWith", false, strings.HasSuffix)
+}
+
+// ExcludesValidator validates that a string contains none of the arguments, i.e. `excludes(<,>)`.
+func ExcludesValidator(context core.ValidatorContext, args []interface{}) error {
+	return validateSubstrings(context, args, "excludes.cannotContain", true, strings.Contains)
+}
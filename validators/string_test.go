@@ -0,0 +1,117 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"net"
+	"testing"
+)
+
+func TestThatAsStringValidatorConvertsValuesToText(t *testing.T) {
+	context := core.NewTestContext(net.IPv4(10, 0, 0, 1))
+
+	if err := AsStringValidator(context, nil); err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if context.Value() != "10.0.0.1" {
+		t.Fatalf("Expected '10.0.0.1', got %v.", context.Value())
+	}
+
+	if err := AsStringValidator(core.NewTestContext(123), nil); err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+}
+
+func TestThatCharacterValidatorsValidateEveryCharacter(t *testing.T) {
+	tests := []struct {
+		validator core.ValidatorFn
+		value     interface{}
+		valid     bool
+	}{
+		{AlphaValidator, "abcÅäö", true},
+		{AlphaValidator, "", true},
+		{AlphaValidator, "abc1", false},
+		{AlphaValidator, "a b", false},
+		{AlphanumericValidator, "abc123", true},
+		{AlphanumericValidator, []byte("abc123"), true},
+		{AlphanumericValidator, "abc-123", false},
+		{AsciiValidator, "Hello, world!\n", true},
+		{AsciiValidator, "héllo", false},
+		{AsciiValidator, "\xff", false},
+		{PrintableValidator, "Hello, wörld!", true},
+		{PrintableValidator, "Hello\tworld", false},
+		{PrintableValidator, "\xff", false},
+	}
+
+	for _, test := range tests {
+		err := test.validator(core.NewTestContext(test.value), nil)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for %q, but got one (%s).", test.value, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for %q, didn't get any.", test.value)
+		}
+	}
+}
+
+func TestThatCharacterValidatorsFailForInvalidOptionsAndTypes(t *testing.T) {
+	if err := AlphaValidator(core.NewTestContext("abc"), []interface{}{"x"}); err == nil || err.Error() != "arguments.noneSupported" {
+		t.Fatalf("Expected no arguments supported error, got %v.", err)
+	}
+
+	if err := AsciiValidator(core.NewTestContext(5), nil); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
+
+func TestThatSubstringValidatorsMatchAnyArgument(t *testing.T) {
+	tests := []struct {
+		validator core.ValidatorFn
+		value     string
+		args      []interface{}
+		valid     bool
+	}{
+		{StartsWithValidator, "https://example.com", []interface{}{"http://", "https://"}, true},
+		{StartsWithValidator, "ftp://example.com", []interface{}{"http://", "https://"}, false},
+		{StartsWithValidator, "1234", []interface{}{float64(1)}, true},
+		{EndsWithValidator, "example.org", []interface{}{".com", ".org"}, true},
+		{EndsWithValidator, "example.net", []interface{}{".com", ".org"}, false},
+		{ExcludesValidator, "hello", []interface{}{"<", ">"}, true},
+		{ExcludesValidator, "<b>hello</b>", []interface{}{"<", ">"}, false},
+	}
+
+	for _, test := range tests {
+		err := test.validator(core.NewTestContext(test.value), test.args)
+
+		if test.valid && err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", test.value, err)
+		}
+
+		if !test.valid && err == nil {
+			t.Fatalf("Expected error for '%s', didn't get any.", test.value)
+		}
+	}
+}
+
+func TestThatSubstringValidatorsFailForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("abc")
+
+	if err := EndsWithValidator(ctx, nil); err == nil || err.Error() != "arguments.oneOrMoreRequired" {
+		t.Fatalf("Expected one or more arguments required error, got %v.", err)
+	}
+
+	if err := StartsWithValidator(ctx, []interface{}{""}); err == nil || err.Error() != "arguments.invalid" {
+		t.Fatalf("Expected invalid arguments error, got %v.", err)
+	}
+
+	if err := EndsWithValidator(ctx, []interface{}{true}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+
+	if err := ExcludesValidator(core.NewTestContext(5), []interface{}{"a"}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
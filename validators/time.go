@@ -5,6 +5,50 @@ import (
 	"time"
 )
 
+// Named layouts that can be used in place of a Go time layout, i.e. `time(RFC3339)`.
+var timeLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"UnixDate":    time.UnixDate,
+	"RubyDate":    time.RubyDate,
+	"RFC822":      time.RFC822,
+	"RFC822Z":     time.RFC822Z,
+	"RFC850":      time.RFC850,
+	"RFC1123":     time.RFC1123,
+	"RFC1123Z":    time.RFC1123Z,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    "2006-01-02 15:04:05",
+	"DateOnly":    "2006-01-02",
+	"TimeOnly":    "15:04:05",
+}
+
+// Layouts of the ISO 8601 formats that are accepted by the iso8601 validator.
+var iso8601Layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseTimeArgument parses a time argument of a validator. It can be `now`, an RFC 3339 time or a date, i.e. `2006-01-02`.
+func parseTimeArgument(arg interface{}) (time.Time, bool) {
+	if value, ok := arg.(string); ok {
+		if value == "now" {
+			return time.Now(), true
+		}
+
+		for _, layout := range []string{time.RFC3339Nano, timeLayouts["DateOnly"]} {
+			if parsedValue, err := time.Parse(layout, value); err == nil {
+				return parsedValue, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
 func TimeValidator(context core.ValidatorContext, args []interface{}) error {
 	switch typedValue := context.Value().(type) {
 	case string:
@@ -13,6 +57,10 @@ func TimeValidator(context core.ValidatorContext, args []interface{}) error {
 		}
 
 		if layout, ok := args[0].(string); ok {
+			if namedLayout, ok := timeLayouts[layout]; ok {
+				layout = namedLayout
+			}
+
 			value, err := time.Parse(layout, typedValue)
 
 			if err != nil {
@@ -33,3 +81,27 @@ func TimeValidator(context core.ValidatorContext, args []interface{}) error {
 
 	return context.NewError("type.unsupported")
 }
+
+func Iso8601Validator(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		for _, layout := range iso8601Layouts {
+			if value, err := time.Parse(layout, typedValue); err == nil {
+				if err := context.SetValue(value); err != nil {
+					return err
+				}
+				return nil
+			}
+		}
+
+		return context.NewError("iso8601.mustBeValid")
+	case time.Time:
+		return nil
+	}
+
+	return context.NewError("type.unsupported")
+}
@@ -98,3 +98,69 @@ func TestThatTimeValidatorFailsForUnsupportedValueType(t *testing.T) {
 		t.Fatalf("Expected unsupported type error, got %s.", err)
 	}
 }
+
+func TestThatTimeValidatorSucceedsForNamedLayout(t *testing.T) {
+	ctx := core.NewTestContext("2013-06-05T14:10:43Z")
+
+	if err := TimeValidator(ctx, []interface{}{"RFC3339"}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if _, ok := ctx.Value().(time.Time); !ok {
+		t.Fatalf("Expected value to be normalized to time, got %T.", ctx.Value())
+	}
+}
+
+func TestThatIso8601ValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("2013-06-05")
+	err := Iso8601Validator(ctx, []interface{}{"abc"})
+
+	if err == nil {
+		t.Fatalf("Expected error, didn't get any.")
+	}
+
+	if err.Error() != "arguments.noneSupported" {
+		t.Fatalf("Expected none arguments supported error, got %s.", err)
+	}
+}
+
+func TestThatIso8601ValidatorSucceedsForValidStringTimeValue(t *testing.T) {
+	for _, value := range []string{"2013-06-05T14:10:43.678Z", "2013-06-05T14:10:43+02:00", "2013-06-05T14:10:43+0200", "2013-06-05T14:10:43", "2013-06-05T14:10", "2013-06-05"} {
+		ctx := core.NewTestContext(value)
+
+		if err := Iso8601Validator(ctx, []interface{}{}); err != nil {
+			t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+		}
+
+		if _, ok := ctx.Value().(time.Time); !ok {
+			t.Fatalf("Expected value '%s' to be normalized to time, got %T.", value, ctx.Value())
+		}
+	}
+}
+
+func TestThatIso8601ValidatorFailsForInvalidStringTimeValue(t *testing.T) {
+	var nilValue *string
+
+	for _, value := range []interface{}{"", "2013-06-05 14:10:43", "05/06/2013", "2013-13-05", nilValue} {
+		ctx := core.NewTestContext(value)
+
+		err := Iso8601Validator(ctx, []interface{}{})
+
+		if err == nil {
+			t.Fatalf("Expected error for '%v', didn't get any.", value)
+		}
+
+		if err.Error() != "iso8601.mustBeValid" {
+			t.Fatalf("Expected must be valid error for '%v', got %s.", value, err)
+		}
+	}
+}
+
+func TestThatIso8601ValidatorFailsForUnsupportedValueType(t *testing.T) {
+	ctx := core.NewTestContext(123)
+	err := Iso8601Validator(ctx, []interface{}{})
+
+	if err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %s.", err)
+	}
+}
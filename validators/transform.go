@@ -0,0 +1,61 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"strings"
+)
+
+// transformString applies transform to string values. Nil values are not transformed.
+func transformString(context core.ValidatorContext, transform func(string) string) error {
+	switch typedValue := context.Value().(type) {
+	case string:
+		if context.IsNil() {
+			return nil
+		}
+
+		return context.SetValue(transform(typedValue))
+	}
+
+	return context.NewError("type.unsupported")
+}
+
+func TrimTransformer(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return transformString(context, strings.TrimSpace)
+}
+
+func LowerTransformer(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return transformString(context, strings.ToLower)
+}
+
+func UpperTransformer(context core.ValidatorContext, args []interface{}) error {
+	if len(args) > 0 {
+		return context.NewError("arguments.noneSupported")
+	}
+
+	return transformString(context, strings.ToUpper)
+}
+
+// TruncateTransformer truncates strings to a maximum number of characters, i.e. `truncate(100)`.
+func TruncateTransformer(context core.ValidatorContext, args []interface{}) error {
+	if len(args) != 1 {
+		return context.NewError("arguments.singleRequired")
+	}
+
+	length, ok := args[0].(float64)
+
+	if !ok || length < 0 || length != float64(int(length)) {
+		return context.NewError("arguments.invalidType", 1, "positive integer")
+	}
+
+	return transformString(context, func(value string) string {
+		return core.TruncateString(context.Context(), value, int(length))
+	})
+}
@@ -0,0 +1,71 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatTrimTransformerTrimsSpaces(t *testing.T) {
+	ctx := core.NewTestContext("  test ")
+
+	if err := TrimTransformer(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != "test" {
+		t.Fatalf("Expected 'test', got '%v'.", ctx.Value())
+	}
+}
+
+func TestThatLowerTransformerLowersString(t *testing.T) {
+	ctx := core.NewTestContext("TeSt")
+
+	if err := LowerTransformer(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != "test" {
+		t.Fatalf("Expected 'test', got '%v'.", ctx.Value())
+	}
+}
+
+func TestThatUpperTransformerUppersString(t *testing.T) {
+	ctx := core.NewTestContext("TeSt")
+
+	if err := UpperTransformer(ctx, []interface{}{}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != "TEST" {
+		t.Fatalf("Expected 'TEST', got '%v'.", ctx.Value())
+	}
+}
+
+func TestThatTruncateTransformerTruncatesBytesWithoutCuttingCharacters(t *testing.T) {
+	ctx := core.NewTestContext("åäöabc")
+
+	if err := TruncateTransformer(ctx, []interface{}{float64(5)}); err != nil {
+		t.Fatalf("Didn't expect error, but got one (%s).", err)
+	}
+
+	if ctx.Value() != "åä" {
+		t.Fatalf("Expected 'åä', got '%v'.", ctx.Value())
+	}
+}
+
+func TestThatTruncateTransformerFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("test")
+
+	if err := TruncateTransformer(ctx, []interface{}{"abc"}); err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func TestThatTransformersFailForUnsupportedTypes(t *testing.T) {
+	ctx := core.NewTestContext(123)
+
+	if err := TrimTransformer(ctx, []interface{}{}); err == nil || err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %v.", err)
+	}
+}
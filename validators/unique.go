@@ -0,0 +1,46 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"github.com/typerandom/validator/core"
+	"reflect"
+	"strconv"
+)
+
+// ExistsFn reports for each value whether it already exists, i.e. by a single database query for all values.
+type ExistsFn func(ctx context.Context, values []interface{}, args []interface{}) ([]bool, error)
+
+// UniqueValidator returns a batch validator that checks that values are unique among themselves, and that exists
+// doesn't report them as existing. Register it with RegisterBatch, i.e. `RegisterBatch("unique", UniqueValidator(fn))`.
+func UniqueValidator(exists ExistsFn) core.BatchValidatorFn {
+	return func(ctx context.Context, values []interface{}, args []interface{}) ([]error, error) {
+		existing, err := exists(ctx, values, args)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(existing) != len(values) {
+			return nil, errors.New("Unique validator received " + strconv.Itoa(len(existing)) + " results for " + strconv.Itoa(len(values)) + " values.")
+		}
+
+		errs := make([]error, len(values))
+		seen := make(map[interface{}]bool, len(values))
+
+		for i, value := range values {
+			duplicate := false
+
+			if value != nil && reflect.TypeOf(value).Comparable() {
+				duplicate = seen[value]
+				seen[value] = true
+			}
+
+			if duplicate || existing[i] {
+				errs[i] = core.NewBatchError("unique.mustBeUnique")
+			}
+		}
+
+		return errs, nil
+	}
+}
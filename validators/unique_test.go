@@ -0,0 +1,61 @@
+package validators_test
+
+import (
+	"context"
+	"errors"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatUniqueValidatorFailsForDuplicatesAndExistingValues(t *testing.T) {
+	calls := 0
+
+	validate := UniqueValidator(func(ctx context.Context, values []interface{}, args []interface{}) ([]bool, error) {
+		calls++
+		existing := make([]bool, len(values))
+		for i, value := range values {
+			existing[i] = value == "taken"
+		}
+		return existing, nil
+	})
+
+	errs, err := validate(context.Background(), []interface{}{"a", "b", "a", "taken"}, nil)
+
+	if err != nil {
+		t.Fatalf("Didn't expect error, got %s.", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected 1 call, got %d.", calls)
+	}
+
+	for i, expected := range []bool{false, false, true, true} {
+		if failed := errs[i] != nil; failed != expected {
+			t.Fatalf("Expected error of value %d to be %t, got %t.", i, expected, failed)
+		}
+	}
+
+	if errs[2].Error() != "unique.mustBeUnique" {
+		t.Fatalf("Expected unique error, got %s.", errs[2])
+	}
+}
+
+func TestThatUniqueValidatorFailsWhenLookupFails(t *testing.T) {
+	validate := UniqueValidator(func(ctx context.Context, values []interface{}, args []interface{}) ([]bool, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	if _, err := validate(context.Background(), []interface{}{"a"}, nil); err == nil || err.Error() != "connection refused" {
+		t.Fatalf("Expected lookup error, got %v.", err)
+	}
+}
+
+func TestThatUniqueValidatorFailsForMismatchedResults(t *testing.T) {
+	validate := UniqueValidator(func(ctx context.Context, values []interface{}, args []interface{}) ([]bool, error) {
+		return []bool{false}, nil
+	})
+
+	if _, err := validate(context.Background(), []interface{}{"a", "b"}, nil); err == nil {
+		t.Fatal("Expected error, didn't get any.")
+	}
+}
@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"net/url"
+	"strings"
+)
+
+func UrlValidator(context core.ValidatorContext, args []interface{}) error {
+	schemes := make([]string, len(args))
+
+	for i, arg := range args {
+		if scheme, ok := arg.(string); ok {
+			schemes[i] = strings.ToLower(scheme)
+		} else {
+			return context.NewError("arguments.invalidType", i+1, "string")
+		}
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if len(typedValue) == 0 {
+			return context.NewError("url.mustBeValidUrl")
+		}
+
+		parsedUrl, err := url.Parse(typedValue)
+
+		if err != nil || len(parsedUrl.Scheme) == 0 || len(parsedUrl.Host) == 0 {
+			return context.NewError("url.mustBeValidUrl")
+		}
+
+		if len(schemes) == 0 {
+			return nil
+		}
+
+		scheme := strings.ToLower(parsedUrl.Scheme)
+
+		for _, allowedScheme := range schemes {
+			if scheme == allowedScheme {
+				return nil
+			}
+		}
+
+		return context.NewError("url.mustHaveScheme", strings.Join(schemes, "', '"))
+	}
+
+	return context.NewError("type.unsupported")
+}
@@ -0,0 +1,69 @@
+package validators
+
+import (
+	"github.com/typerandom/validator/core"
+	"regexp"
+	"strings"
+)
+
+var uuidPattern = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-([0-9a-f])[0-9a-f]{3}-[0-9a-f]{4}-[0-9a-f]{12}$")
+
+// UuidValidator validates canonical UUID strings, i.e. `6ba7b810-9dad-11d1-80b4-00c04fd430c8`.
+// Numeric arguments restrict the accepted versions. The `uppercase` and `braced` arguments allow
+// upper case hex digits and UUIDs enclosed in curly braces.
+func UuidValidator(context core.ValidatorContext, args []interface{}) error {
+	var versions []string
+	var allowUpperCase, allowBraced bool
+
+	for i, arg := range args {
+		switch typedArg := arg.(type) {
+		case float64:
+			if typedArg < 1 || typedArg > 8 || typedArg != float64(int(typedArg)) {
+				return context.NewError("arguments.invalid")
+			}
+			versions = append(versions, string(rune('0'+int(typedArg))))
+		case string:
+			switch typedArg {
+			case "uppercase":
+				allowUpperCase = true
+			case "braced":
+				allowBraced = true
+			default:
+				return context.NewError("arguments.invalid")
+			}
+		default:
+			return context.NewError("arguments.invalidType", i+1, "number or string")
+		}
+	}
+
+	switch typedValue := context.Value().(type) {
+	case string:
+		if allowBraced && strings.HasPrefix(typedValue, "{") && strings.HasSuffix(typedValue, "}") {
+			typedValue = typedValue[1 : len(typedValue)-1]
+		}
+
+		if allowUpperCase {
+			typedValue = strings.ToLower(typedValue)
+		}
+
+		matches := uuidPattern.FindStringSubmatch(typedValue)
+
+		if matches == nil {
+			return context.NewError("uuid.mustBeValidUuid")
+		}
+
+		if len(versions) == 0 {
+			return nil
+		}
+
+		for _, version := range versions {
+			if matches[1] == version {
+				return nil
+			}
+		}
+
+		return context.NewError("uuid.mustBeVersion", strings.Join(versions, "', '"))
+	}
+
+	return context.NewError("type.unsupported")
+}
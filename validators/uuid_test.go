@@ -0,0 +1,89 @@
+package validators_test
+
+import (
+	"github.com/typerandom/validator/core"
+	. "github.com/typerandom/validator/validators"
+	"testing"
+)
+
+func TestThatUuidValidatorFailsForInvalidOptions(t *testing.T) {
+	ctx := core.NewTestContext("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	for _, opts := range [][]interface{}{{float64(0)}, {float64(9)}, {float64(1.5)}, {"abc"}} {
+		err := UuidValidator(ctx, opts)
+
+		if err == nil {
+			t.Fatalf("Expected error for %v, didn't get any.", opts)
+		}
+
+		if err.Error() != "arguments.invalid" {
+			t.Fatalf("Expected invalid arguments error for %v, got %s.", opts, err)
+		}
+	}
+
+	err := UuidValidator(ctx, []interface{}{true})
+
+	if err == nil || err.Error() != "arguments.invalidType" {
+		t.Fatalf("Expected invalid type error, got %v.", err)
+	}
+}
+
+func testThatUuidValidatorSucceedsForValue(t *testing.T, value string, opts ...interface{}) {
+	ctx := core.NewTestContext(value)
+
+	if err := UuidValidator(ctx, opts); err != nil {
+		t.Fatalf("Didn't expect error for '%s', but got one (%s).", value, err)
+	}
+}
+
+func testThatUuidValidatorFailsForValue(t *testing.T, value string, expectedErr string, opts ...interface{}) {
+	ctx := core.NewTestContext(value)
+
+	err := UuidValidator(ctx, opts)
+
+	if err == nil {
+		t.Fatalf("Expected error for '%s', didn't get any.", value)
+	}
+
+	if err.Error() != expectedErr {
+		t.Fatalf("Expected '%s' error for '%s', got %s.", expectedErr, value, err)
+	}
+}
+
+func TestThatUuidValidatorSucceedsForValidUuid(t *testing.T) {
+	testThatUuidValidatorSucceedsForValue(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	testThatUuidValidatorSucceedsForValue(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+}
+
+func TestThatUuidValidatorFailsForInvalidUuid(t *testing.T) {
+	testThatUuidValidatorFailsForValue(t, "", "uuid.mustBeValidUuid")
+	testThatUuidValidatorFailsForValue(t, "f47ac10b58cc4372a5670e02b2c3d479", "uuid.mustBeValidUuid")
+	testThatUuidValidatorFailsForValue(t, "f47ac10b-58cc-4372-a567-0e02b2c3d47", "uuid.mustBeValidUuid")
+	testThatUuidValidatorFailsForValue(t, "g47ac10b-58cc-4372-a567-0e02b2c3d479", "uuid.mustBeValidUuid")
+}
+
+func TestThatUuidValidatorSucceedsForAllowedVersion(t *testing.T) {
+	testThatUuidValidatorSucceedsForValue(t, "f47ac10b-58cc-4372-a567-0e02b2c3d479", float64(4))
+	testThatUuidValidatorSucceedsForValue(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", float64(1), float64(4))
+}
+
+func TestThatUuidValidatorFailsForDisallowedVersion(t *testing.T) {
+	testThatUuidValidatorFailsForValue(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "uuid.mustBeVersion", float64(4))
+}
+
+func TestThatUuidValidatorHandlesUpperCaseAndBracedUuid(t *testing.T) {
+	testThatUuidValidatorFailsForValue(t, "F47AC10B-58CC-4372-A567-0E02B2C3D479", "uuid.mustBeValidUuid")
+	testThatUuidValidatorFailsForValue(t, "{f47ac10b-58cc-4372-a567-0e02b2c3d479}", "uuid.mustBeValidUuid")
+	testThatUuidValidatorSucceedsForValue(t, "F47AC10B-58CC-4372-A567-0E02B2C3D479", "uppercase")
+	testThatUuidValidatorSucceedsForValue(t, "{f47ac10b-58cc-4372-a567-0e02b2c3d479}", "braced")
+	testThatUuidValidatorSucceedsForValue(t, "{F47AC10B-58CC-4372-A567-0E02B2C3D479}", float64(4), "braced", "uppercase")
+}
+
+func TestThatUuidValidatorFailsForUnsupportedValueType(t *testing.T) {
+	ctx := core.NewTestContext(123)
+	err := UuidValidator(ctx, []interface{}{})
+
+	if err.Error() != "type.unsupported" {
+		t.Fatalf("Expected unsupported type error, got %s.", err)
+	}
+}
@@ -6,45 +6,224 @@ import (
 
 func RegisterDefaultLocale(lc *core.Locale) {
 	lc.Set("type.unsupported", "Validator '{validator}' does not support the type of field '{field}'.")
+	lc.Set("type.invalid", "{field} must be of type %s.")
 	lc.Set("arguments.invalid", "Unable to parse '{validator}' validator options for field '{field}'.")
 	lc.Set("arguments.invalidType", "Validator '{validator}' on field '{field}' requires parameter %v to be of type %s.")
 	lc.Set("arguments.noneSupported", "Validator '{validator}' on field '{field}' does not support any arguments.")
 	lc.Set("arguments.singleRequired", "Validator '{validator}' on field '{field}' requires a single argument.")
 	lc.Set("arguments.oneOrMoreRequired", "Validator '{validator}' on field '{field}' requires at least one argument.")
+	lc.Set("arguments.twoRequired", "Validator '{validator}' on field '{field}' requires two arguments.")
+	lc.Set("arguments.oneOrTwoRequired", "Validator '{validator}' on field '{field}' requires one or two arguments.")
+	lc.Set("arguments.fieldValuePairsRequired", "Validator '{validator}' on field '{field}' requires one or more pairs of field names and values.")
 	lc.Set("not.cannotBeValue", "{field} cannot be %v.")
 	lc.Set("nil.isNotNil", "{field} is not nil.")
+	lc.Set("nil.cannotBeNil", "{field} cannot be nil.")
+	lc.Set("required.isRequired", "{field} is required.")
 	lc.Set("empty.isNotEmpty", "{field} is not empty.")
 	lc.Set("notEmpty.cannotBeEmpty", "{field} cannot be empty.")
 	lc.Set("min.cannotBeShorterThan", "{field} cannot be shorter than %v characters.")
 	lc.Set("min.cannotBeLessThan", "{field} cannot be less than %v.")
 	lc.Set("min.cannotContainLessItemsThan", "{field} cannot contain less than %v items.")
 	lc.Set("min.cannotContainLessKeysThan", "{field} cannot contain less than %v keys.")
+	lc.Set("min.cannotBeBefore", "{field} cannot be before %v.")
 	lc.Set("max.cannotBeLongerThan", "{field} cannot be longer than %v characters.")
 	lc.Set("max.cannotBeGreaterThan", "{field} cannot be greater than %v.")
 	lc.Set("max.cannotContainMoreItemsThan", "{field} cannot contain more than %v items.")
 	lc.Set("max.cannotContainMoreKeysThan", "{field} cannot contain more than %v keys.")
+	lc.Set("max.cannotBeAfter", "{field} cannot be after %v.")
 	lc.Set("lowerCase.mustBeLowerCase", "{field} must be in lower case.")
 	lc.Set("upperCase.mustBeUpperCase", "{field} must be in upper case.")
 	lc.Set("contain.mustContainValue", "{field} must contain one of the following values '%s'.")
+	lc.Set("alpha.mustBeAlpha", "{field} can only contain letters.")
+	lc.Set("alphanum.mustBeAlphanumeric", "{field} can only contain letters and digits.")
+	lc.Set("ascii.mustBeAscii", "{field} can only contain ASCII characters.")
+	lc.Set("printable.mustBePrintable", "{field} can only contain printable characters.")
+	lc.Set("startsWith.mustStartWith", "{field} must start with one of the following values '%s'.")
+	lc.Set("endsWith.mustEndWith", "{field} must end with one of the following values '%s'.")
+	lc.Set("excludes.cannotContain", "{field} cannot contain any of the following values '%s'.")
 	lc.Set("equal.mustEqualValue", "{field} must equal one of the following values '%s'.")
 	lc.Set("regexp.mustMatchPattern", "{field} must match pattern '%s'.")
 	lc.Set("numeric.mustBeNumeric", "{field} must be numeric.")
+	lc.Set("integer.mustBeInteger", "{field} must be an integer.")
+	lc.Set("decimal.mustBeDecimal", "{field} must be a decimal number.")
+	lc.Set("hex.mustBeHex", "{field} must be a hexadecimal number.")
+	lc.Set("base64.mustBeBase64", "{field} must be base64 encoded.")
+	lc.Set("json.mustBeValidJson", "{field} must be valid JSON.")
+	lc.Set("json.mustBeType", "{field} must be a JSON %s.")
+	lc.Set("yaml.mustBeValidYaml", "{field} must be valid YAML.")
+	lc.Set("yaml.mustBeType", "{field} must be a YAML %s.")
+	lc.Set("xml.mustBeValidXml", "{field} must be well-formed XML.")
+	lc.Set("xml.mustHaveRoot", "{field} must be an XML document with root element '%s'.")
 	lc.Set("time.mustBeValid", "{field} must be a valid time.")
+	lc.Set("toInt.mustBeInteger", "{field} must be an integer.")
+	lc.Set("toFloat.mustBeNumber", "{field} must be a number.")
+	lc.Set("toBool.mustBeBoolean", "{field} must be true or false.")
+	lc.Set("toTime.mustBeTime", "{field} must be a valid time.")
+	lc.Set("iso8601.mustBeValid", "{field} must be a valid ISO 8601 time.")
+	lc.Set("eqField.mustEqualField", "{field} must equal %s.")
+	lc.Set("neField.cannotEqualField", "{field} cannot equal %s.")
+	lc.Set("gtField.mustBeGreaterThanField", "{field} must be greater than %s.")
+	lc.Set("gteField.mustBeGreaterThanOrEqualField", "{field} must be greater than or equal to %s.")
+	lc.Set("ltField.mustBeLessThanField", "{field} must be less than %s.")
+	lc.Set("lteField.mustBeLessThanOrEqualField", "{field} must be less than or equal to %s.")
+	lc.Set("email.mustBeValidEmail", "{field} must be a valid email address.")
+	lc.Set("email.mustHaveMailServer", "{field} must have a domain that accepts email, '%s' does not.")
+	lc.Set("url.mustBeValidUrl", "{field} must be a valid URL.")
+	lc.Set("url.mustHaveScheme", "{field} must be a URL with one of the following schemes '%s'.")
+	lc.Set("uuid.mustBeValidUuid", "{field} must be a valid UUID.")
+	lc.Set("uuid.mustBeVersion", "{field} must be a UUID of one of the following versions '%s'.")
+	lc.Set("ip.mustBeValidIp", "{field} must be a valid IP address.")
+	lc.Set("ipv4.mustBeValidIpv4", "{field} must be a valid IPv4 address.")
+	lc.Set("ipv6.mustBeValidIpv6", "{field} must be a valid IPv6 address.")
+	lc.Set("cidr.mustBeValidCidr", "{field} must be a valid CIDR notation.")
+	lc.Set("mac.mustBeValidMac", "{field} must be a valid MAC address.")
+	lc.Set("hostname.mustBeValidHostname", "{field} must be a valid host name.")
+	lc.Set("fqdn.mustBeValidFqdn", "{field} must be a fully qualified domain name.")
+	lc.Set("semver.mustBeValidSemver", "{field} must be a valid semantic version.")
+	lc.Set("semver.cannotHavePrerelease", "{field} cannot be a pre-release version.")
+	lc.Set("semver.cannotHaveBuild", "{field} cannot have build metadata.")
+	lc.Set("phone.mustBeValidPhone", "{field} must be a valid phone number.")
+	lc.Set("luhn.mustHaveValidChecksum", "{field} must have a valid checksum.")
+	lc.Set("creditCard.mustBeValidCreditCard", "{field} must be a valid credit card number.")
+	lc.Set("creditCard.mustBeBrand", "{field} must be a credit card of one of the following brands '%s'.")
+	lc.Set("iban.mustBeValidIban", "{field} must be a valid IBAN.")
+	lc.Set("iso3166.mustBeCountryCode", "{field} must be a valid ISO 3166 country code.")
+	lc.Set("iso4217.mustBeCurrencyCode", "{field} must be a valid ISO 4217 currency code.")
+	lc.Set("bcp47.mustBeLanguageTag", "{field} must be a valid BCP 47 language tag.")
+	lc.Set("timezone.mustBeTimezone", "{field} must be a valid IANA time zone.")
+	lc.Set("filesystem.accessRequired", "Validator '{validator}' on field '{field}' requires filesystem access to be allowed.")
+	lc.Set("filePath.mustBeValidFilePath", "{field} must be a valid file path.")
+	lc.Set("fileExists.mustExist", "{field} must be an existing file.")
+	lc.Set("dirExists.mustExist", "{field} must be an existing directory.")
+	lc.Set("unique.mustBeUnique", "{field} must be unique.")
+	lc.Set("password.mustContainClasses", "{field} must contain at least %v of the following: upper case letters, lower case letters, digits and symbols.")
+	lc.Set("password.mustContainUpper", "{field} must contain at least %v upper case letters.")
+	lc.Set("password.mustContainLower", "{field} must contain at least %v lower case letters.")
+	lc.Set("password.mustContainDigits", "{field} must contain at least %v digits.")
+	lc.Set("password.mustContainSymbols", "{field} must contain at least %v symbols.")
+	lc.Set("password.isTooWeak", "{field} is too weak.")
+	lc.Set("password.isTooCommon", "{field} is too common.")
+	lc.Set("between.mustBeBetween", "{field} must be between %v and %v.")
+	lc.Set("gt.mustBeGreaterThan", "{field} must be greater than %v.")
+	lc.Set("gte.mustBeGreaterThanOrEqual", "{field} must be greater than or equal to %v.")
+	lc.Set("lt.mustBeLessThan", "{field} must be less than %v.")
+	lc.Set("lte.mustBeLessThanOrEqual", "{field} must be less than or equal to %v.")
+	lc.Set("positive.mustBePositive", "{field} must be positive.")
+	lc.Set("negative.mustBeNegative", "{field} must be negative.")
+	lc.Set("nonNegative.cannotBeNegative", "{field} cannot be negative.")
+	lc.Set("length.mustHaveLength", "{field} must be %v characters long.")
+	lc.Set("length.mustHaveLengthBetween", "{field} must be between %v and %v characters long.")
+	lc.Set("length.mustContainItems", "{field} must contain %v items.")
+	lc.Set("length.mustContainItemsBetween", "{field} must contain between %v and %v items.")
+	lc.Set("oneOf.mustBeOneOf", "{field} must be one of the following values '%s'.")
+	lc.Set("negated.mustNotPass", "{field} must not satisfy '{validator}'.")
+	lc.Set("negated.nil", "{field} cannot be nil.")
+	lc.Set("negated.empty", "{field} cannot be empty.")
+	lc.Set("negated.lowercase", "{field} cannot be in lower case.")
+	lc.Set("negated.uppercase", "{field} cannot be in upper case.")
+	lc.Set("negated.alpha", "{field} cannot only contain letters.")
+	lc.Set("negated.alphanum", "{field} cannot only contain letters and digits.")
+	lc.Set("negated.contain", "{field} cannot contain any of the following values '%s'.")
+	lc.Set("negated.starts_with", "{field} cannot start with any of the following values '%s'.")
+	lc.Set("negated.ends_with", "{field} cannot end with any of the following values '%s'.")
+	lc.Set("negated.equal", "{field} cannot equal any of the following values '%s'.")
+	lc.Set("negated.one_of", "{field} cannot be one of the following values '%s'.")
+	lc.Set("negated.regexp", "{field} cannot match pattern '%s'.")
+	lc.Set("negated.match", "{field} cannot match pattern '%s'.")
+	lc.Set("negated.numeric", "{field} cannot be numeric.")
+	lc.Set("negated.integer", "{field} cannot be an integer.")
+	lc.Set("negated.email", "{field} cannot be an email address.")
+	lc.Set("negated.url", "{field} cannot be a URL.")
+	lc.Set("negated.uuid", "{field} cannot be a UUID.")
+	lc.Set("negated.ip", "{field} cannot be an IP address.")
+	lc.Set("negated.positive", "{field} cannot be positive.")
+	lc.Set("negated.negative", "{field} cannot be negative.")
 }
 
-func RegisterDefaultValidators(r core.ValidatorRegistry) {
-	r.Register("not", NotValidator)
-	r.Register("nil", NilValidator)
-	r.Register("empty", EmptyValidator)
-	r.Register("not_empty", NotEmptyValidator)
+func RegisterDefaultValidators(r *core.ValidatorRegistry) {
+	r.RegisterNilHandler("not", NotValidator)
+	r.RegisterNilHandler("nil", NilValidator)
+	r.RegisterNilHandler("empty", EmptyValidator)
+	r.RegisterNilHandler("not_empty", NotEmptyValidator)
+	r.RegisterNilHandler("omitempty", OmitEmptyValidator)
 	r.Register("min", MinValidator)
 	r.Register("max", MaxValidator)
 	r.Register("lowercase", LowerCaseValidator)
 	r.Register("uppercase", UpperCaseValidator)
 	r.Register("contain", ContainValidator)
+	r.RegisterAlias("contains", "contain")
+	r.Register("starts_with", StartsWithValidator)
+	r.Register("ends_with", EndsWithValidator)
+	r.Register("excludes", ExcludesValidator)
+	r.Register("alpha", AlphaValidator)
+	r.Register("alphanum", AlphanumericValidator)
+	r.Register("ascii", AsciiValidator)
+	r.Register("printable", PrintableValidator)
 	r.Register("equal", EqualValidator)
-	r.Register("regexp", RegexpValidator)
+	r.RegisterWithSchema("regexp", RegexpValidator, RegexpArguments)
+	r.RegisterWithSchema("match", RegexpValidator, RegexpArguments)
 	r.Register("numeric", NumericValidator)
+	r.Register("integer", IntegerValidator)
+	r.Register("decimal", DecimalValidator)
+	r.Register("hex", HexValidator)
+	r.Register("base64", Base64Validator)
+	r.Register("json", JsonValidator)
+	r.Register("xml", XmlValidator)
 	r.Register("time", TimeValidator)
-	r.Register("func", FuncValidator)
+	r.Register("iso8601", Iso8601Validator)
+	r.RegisterNilHandler("func", FuncValidator)
+	r.Register("email", EmailValidator)
+	r.Register("url", UrlValidator)
+	r.Register("uuid", UuidValidator)
+	r.Register("ip", IpValidator)
+	r.Register("ipv4", Ipv4Validator)
+	r.Register("ipv6", Ipv6Validator)
+	r.Register("cidr", CidrValidator)
+	r.Register("mac", MacValidator)
+	r.Register("hostname", HostnameValidator)
+	r.Register("fqdn", FqdnValidator)
+	r.Register("semver", SemverValidator)
+	r.Register("eqfield", EqualFieldValidator)
+	r.Register("nefield", NotEqualFieldValidator)
+	r.Register("gtfield", GreaterThanFieldValidator)
+	r.Register("gtefield", GreaterThanOrEqualFieldValidator)
+	r.Register("ltfield", LessThanFieldValidator)
+	r.Register("ltefield", LessThanOrEqualFieldValidator)
+	r.RegisterNilHandler("required", RequiredValidator)
+	r.RegisterNilHandler("required_if", RequiredIfValidator)
+	r.RegisterNilHandler("required_unless", RequiredUnlessValidator)
+	r.Register("phone", PhoneValidator)
+	r.Register("luhn", LuhnValidator)
+	r.Register("creditcard", CreditCardValidator)
+	r.Register("iban", IbanValidator)
+	r.Register("iso3166", CountryCodeValidator)
+	r.Register("iso4217", CurrencyCodeValidator)
+	r.Register("bcp47", LanguageTagValidator)
+	r.Register("timezone", TimezoneValidator)
+	r.Register("password", PasswordValidator)
+	r.Register("filepath", FilePathValidator)
+	r.Register("file_exists", FileExistsValidator)
+	r.Register("dir_exists", DirExistsValidator)
+	r.Register("between", BetweenValidator)
+	r.Register("gt", GreaterThanValidator)
+	r.Register("gte", GreaterThanOrEqualValidator)
+	r.Register("lt", LessThanValidator)
+	r.Register("lte", LessThanOrEqualValidator)
+	r.Register("positive", PositiveValidator)
+	r.Register("negative", NegativeValidator)
+	r.Register("non_negative", NonNegativeValidator)
+	r.RegisterWithSchema("length", LengthValidator, LengthArguments)
+	r.Register("one_of", OneOfValidator)
+	r.Register("as_string", AsStringValidator)
+	r.RegisterTransformer("trim", TrimTransformer)
+	r.RegisterTransformer("lower", LowerTransformer)
+	r.RegisterTransformer("upper", UpperTransformer)
+	r.RegisterTransformer("truncate", TruncateTransformer)
+	r.RegisterConverter("to_int", ToIntConverter)
+	r.RegisterConverter("to_float", ToFloatConverter)
+	r.RegisterConverter("to_bool", ToBoolConverter)
+	r.RegisterConverter("to_time", ToTimeConverter)
+
+	RegisterDefaultSchemas(r)
+	RegisterDefaultDescriptions(r)
 }
@@ -2,8 +2,15 @@ package validator
 
 import (
 	"errors"
+	"fmt"
 	"github.com/typerandom/validator/core"
+	"github.com/typerandom/validator/core/parser"
 	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unsafe"
 )
 
 func canWalk(value reflect.Kind) bool {
@@ -15,110 +22,841 @@ func canWalk(value reflect.Kind) bool {
 	}
 }
 
-func walkValidateArray(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
-	valueType := reflect.ValueOf(normalized.Value)
-	for i := 0; i < valueType.Len(); i++ {
-		value := valueType.Index(i)
+// unwrapInterface returns the concrete value of an interface value, i.e. an item of `[]interface{}` or `[]Shape`,
+// so that it's validated against the tags of its concrete type. Nil interfaces are returned as is.
+func unwrapInterface(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	return value
+}
+
+// sourceValue returns the reflected value that normalized was created from, so that fields can be modified (i.e. by
+// `default`) if the value is addressable. If normalized was created from another value (i.e. by a core.ValueProvider),
+// then a reflected value of normalized is returned instead.
+func sourceValue(reflected reflect.Value, normalized core.NormalizedValue) reflect.Value {
+	for reflected.IsValid() && (reflected.Kind() == reflect.Ptr || reflected.Kind() == reflect.Interface) && !reflected.IsNil() {
+		reflected = reflected.Elem()
+	}
+
+	if reflected.IsValid() && normalized.Value != nil && reflected.Type() == reflect.TypeOf(normalized.Value) {
+		return reflected
+	}
+
+	return reflect.ValueOf(normalized.Value)
+}
+
+// itemDisplayName is the display name of items of collections, which is empty so that error messages name the fields
+// of items as if they were validated on their own, i.e. `Name cannot be empty.`.
+var itemDisplayName = ""
+
+// itemField returns the parent field of an item of a collection. Items of the collection passed to Validate are named
+// by their index or key, i.e. `[0].Name` or `[alice].Name`. Items of collections of fields are named by the field.
+func itemField(parentField *core.ReflectedField, key string) *core.ReflectedField {
+	if parentField != nil {
+		return parentField
+	}
+	return &core.ReflectedField{Name: "[" + key + "]", DisplayName: &itemDisplayName}
+}
+
+func walkValidateArray(context *context, normalized core.NormalizedValue, reflected reflect.Value, parentField *core.ReflectedField) {
+	valueType := sourceValue(reflected, normalized)
+
+	if !context.enter(valueType, parentField) {
+		return
+	}
+
+	defer context.leave(valueType)
+
+	for i := 0; i < valueType.Len() && !context.isDone(); i++ {
+		// Items are only named when visits are limited.
+		if context.limits.maxVisits > 0 && !context.visit(itemField(parentField, strconv.Itoa(i))) {
+			return
+		}
+
+		value := unwrapInterface(valueType.Index(i))
 		if canWalk(value.Kind()) {
-			walkValidate(context, value.Interface(), parentField)
+			walkValidateReflected(context, value, itemField(parentField, strconv.Itoa(i)))
 		}
 	}
 }
 
-func walkValidateMap(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
-	valueType := reflect.ValueOf(normalized.Value)
-	for _, key := range valueType.MapKeys() {
-		value := valueType.MapIndex(key)
+// sortedMapKeys returns the keys of a map in a stable order, so that errors of its values are reported in the same
+// order on every validation. Keys of the same kind are ordered by value, other keys by kind and then formatted value.
+func sortedMapKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return lessMapKey(unwrapInterface(keys[i]), unwrapInterface(keys[j]))
+	})
+
+	return keys
+}
+
+func lessMapKey(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return a.Kind() < b.Kind()
+	}
+
+	switch a.Kind() {
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	default:
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	}
+}
+
+func walkValidateMap(context *context, normalized core.NormalizedValue, reflected reflect.Value, parentField *core.ReflectedField) {
+	valueType := sourceValue(reflected, normalized)
+
+	if !context.enter(valueType, parentField) {
+		return
+	}
+
+	defer context.leave(valueType)
+
+	// Keys are sorted before their values are visited, so maps with more keys than visits left exceed the limit without
+	// sorting them.
+	if context.limits.maxVisits > 0 && context.visits+valueType.Len() > context.limits.maxVisits {
+		context.visits = context.limits.maxVisits
+		context.visit(parentField)
+		return
+	}
+
+	for _, key := range sortedMapKeys(valueType) {
+		if context.isDone() {
+			return
+		}
+
+		if context.limits.maxVisits > 0 && !context.visit(itemField(parentField, fmt.Sprint(key))) {
+			return
+		}
+
+		value := unwrapInterface(valueType.MapIndex(key))
 		if canWalk(value.Kind()) {
-			walkValidate(context, value.Interface(), parentField)
+			walkValidateReflected(context, value, itemField(parentField, fmt.Sprint(key)))
 		}
 	}
 }
 
-func walkValidateStruct(context *context, normalized *core.NormalizedValue, parentField *core.ReflectedField) {
-	fields, err := core.GetStructFields(normalized.Value, "validate", context.validator.displayNameTag)
+func walkValidateStruct(context *context, normalized core.NormalizedValue, reflected reflect.Value, parentField *core.ReflectedField) {
+	sourceStruct := sourceValue(reflected, normalized)
+
+	if !context.enter(sourceStruct, parentField) {
+		return
+	}
+
+	defer context.leave(sourceStruct)
+
+	var structPath string
+
+	if parentField != nil {
+		structPath = parentField.FullName()
+	}
+
+	context.structStart(structPath, sourceStruct.Type())
+
+	walkValidateFields(context, &structSource{value: sourceStruct}, sourceStruct, parentField)
+
+	if !context.isDone() && !context.aborting && context.selection.includes(structPath) {
+		walkValidateStructHook(context, normalized, sourceStruct, parentField)
+	}
+
+	context.aborting = false
+	context.structDone()
+}
+
+// structSource is the struct that fields are referenced from. It's boxed once per struct, unless a default value or
+// transformer changes the struct.
+type structSource struct {
+	value reflect.Value
+	boxed interface{}
+}
+
+func (this *structSource) get() interface{} {
+	if this.boxed == nil && this.value.CanInterface() {
+		this.boxed = this.value.Interface()
+	}
+	return this.boxed
+}
+
+func (this *structSource) reset() {
+	this.boxed = nil
+}
+
+// walkValidateFields validates the fields of a struct. The fields of embedded structs are validated as if they were
+// fields of source, the struct that embeds them.
+func walkValidateFields(context *context, source *structSource, sourceStruct reflect.Value, parentField *core.ReflectedField) {
+	fields, err := context.fieldCache.GetTypeFields(sourceStruct.Type())
 
 	if err != nil {
 		context.errors.AddPlain(err)
 		return
 	}
 
-	sourceStruct := reflect.Indirect(reflect.ValueOf(normalized.Value))
+	if context.fieldWorkers > 1 {
+		context.validateFieldsConcurrently(source, sourceStruct, parentField, fields)
+		return
+	}
+
+	for _, cachedField := range fields {
+		if !walkValidateStructField(context, source, sourceStruct, parentField, cachedField) {
+			return
+		}
+	}
+}
+
+// walkValidateStructField validates a field of a struct. Returns false if the remaining fields of the struct shouldn't
+// be validated, i.e. because the field aborted the struct.
+func walkValidateStructField(context *context, source *structSource, sourceStruct reflect.Value, parentField *core.ReflectedField, cachedField *core.ReflectedField) bool {
+	// The fields of embedded structs are fields of source, so AbortStruct of one of them aborts source.
+	if context.isDone() || context.aborting {
+		return false
+	}
+
+	if cachedField.Ignored || parentField != nil && parentField.IsHidden(cachedField.Name) {
+		return true
+	}
+
+	field := context.fieldOf(cachedField, parentField)
+
+	fieldValue := sourceStruct.Field(field.Index)
+	included := true
+
+	if context.selection != nil {
+		fieldPath := field.FullName()
+
+		// Promoted fields are selected by their own paths, so the embedded struct is always traversed.
+		if !context.selection.traverses(fieldPath) && !(field.Embedded && context.flattenEmbedded) {
+			return true
+		}
 
-	for _, field := range fields {
-		fieldValue := field.GetValue(sourceStruct)
+		included = context.selection.includes(fieldPath)
+	}
 
-		normalizedFieldValue, err := core.Normalize(fieldValue)
+	if !context.visit(field) {
+		return false
+	}
+
+	if field.Unexported {
+		if context.unexportedPolicy != core.ValidateUnexported {
+			if included {
+				walkUnexportedField(context, field)
+			}
+			return true
+		}
+
+		fieldValue = readUnexported(sourceStruct, field.Index)
+	}
+
+	// The values of unexported embedded structs can't be accessed, only their exported fields.
+	if field.Embedded && !fieldValue.CanInterface() {
+		walkValidateEmbedded(context, source, field, fieldValue)
+		return true
+	}
+
+	if included {
+		applied, err := walkApplyDefault(context, field, fieldValue)
 
 		if err != nil {
-			context.errors.AddPlain(err)
-			continue
+			context.errors.Add(err)
+			return true
+		}
+
+		if applied {
+			source.reset()
+		}
+	}
+
+	normalizedFieldValue, err := context.validator.registry.Normalize(fieldValue)
+
+	if err != nil {
+		context.errors.AddPlain(err)
+		return true
+	}
+
+	// Channels, functions and unsafe pointers can't be validated, nor walked.
+	if core.IsUnsupportedKind(normalizedFieldValue.OriginalKind) {
+		if included {
+			walkUnsupportedField(context, field, normalizedFieldValue.OriginalKind)
+		}
+		return true
+	}
+
+	if included && len(field.MethodGroups) > 0 {
+		if walkValidateField(context, field, source.get(), &normalizedFieldValue, fieldValue, sourceStruct) {
+			source.reset()
+		}
+
+		if context.aborting {
+			return false
+		}
+	}
+
+	if field.Embedded {
+		walkValidateEmbedded(context, source, field, fieldValue)
+	} else if canWalk(normalizedFieldValue.OriginalKind) {
+		walkValidateNormalized(context, normalizedFieldValue, fieldValue, field)
+	}
+
+	return true
+}
+
+// walkUnsupportedField fails a field of an unsupported kind with an error for its first validator, if the unsupported
+// policy of the context is core.FailUnsupported. Otherwise, the field is skipped.
+func walkUnsupportedField(context *context, field *core.ReflectedField, kind reflect.Kind) {
+	if context.unsupportedPolicy != core.FailUnsupported {
+		return
+	}
+
+	for _, methods := range activeMethodGroups(context, field.MethodGroups) {
+		for _, method := range methods {
+			if !isDirective(method.Name) {
+				context.errors.Add(core.NewError(field, method, errors.New("Unable to validate field '"+field.FullName()+"' of unsupported kind '"+kind.String()+"'.")))
+				return
+			}
+		}
+	}
+}
+
+// walkUnexportedField fails an unexported field with an error for its first validator, if the unexported policy of
+// the context is core.FailUnexported. Otherwise, the field is skipped.
+func walkUnexportedField(context *context, field *core.ReflectedField) {
+	if context.unexportedPolicy != core.FailUnexported {
+		return
+	}
+
+	for _, methods := range activeMethodGroups(context, field.MethodGroups) {
+		for _, method := range methods {
+			if !isDirective(method.Name) {
+				context.errors.Add(core.NewError(field, method, errors.New("Unable to validate unexported field '"+field.FullName()+"'.")))
+				return
+			}
+		}
+	}
+}
+
+// readUnexported returns the value of an unexported field of a struct, which can be read but not set. Structs that
+// aren't addressable are copied, so that the field can be read through its address.
+func readUnexported(sourceStruct reflect.Value, index int) reflect.Value {
+	if !sourceStruct.CanAddr() {
+		addressableStruct := reflect.New(sourceStruct.Type()).Elem()
+		addressableStruct.Set(sourceStruct)
+		sourceStruct = addressableStruct
+	}
+
+	fieldValue := sourceStruct.Field(index)
+	readableValue := reflect.NewAt(fieldValue.Type(), unsafe.Pointer(fieldValue.UnsafeAddr())).Elem()
+
+	// The copy isn't addressable, so that transformed values are not written back.
+	return reflect.ValueOf(readableValue.Interface())
+}
+
+// walkValidateEmbedded validates the fields of an embedded struct. Its fields are named after the embedded struct,
+// i.e. `Base.Id`, unless the FlattenEmbedded option is used. ValidateStruct of the embedded struct is not called,
+// as the method is promoted to the struct that embeds it.
+func walkValidateEmbedded(context *context, source *structSource, field *core.ReflectedField, fieldValue reflect.Value) {
+	for fieldValue.Kind() == reflect.Ptr {
+		if fieldValue.IsNil() {
+			return
+		}
+		fieldValue = fieldValue.Elem()
+	}
+
+	parentField := field
+
+	if context.flattenEmbedded {
+		parentField = &core.ReflectedField{}
+		*parentField = *field
+		parentField.Name = ""
+		parentField.DisplayName = nil
+	}
+
+	walkValidateFields(context, source, fieldValue, parentField)
+}
+
+// scenarioDirective limits a validator group to scenarios, i.e. `not_empty,scenario(create|update)`. The group is
+// only validated when one of the scenarios is selected with the Group option.
+const scenarioDirective = "scenario"
+
+// activeMethodGroups returns the validator groups that are active for the scenarios of the context. The groups are
+// returned as is if none of them are limited to scenarios.
+func activeMethodGroups(context *context, methodGroups []parser.Methods) []parser.Methods {
+	if !hasScenarios(methodGroups) {
+		return methodGroups
+	}
+
+	var activeGroups []parser.Methods
+
+	for _, methods := range methodGroups {
+		if isScenarioActive(context, methods) {
+			activeGroups = append(activeGroups, methods)
 		}
+	}
 
-		field.Parent = parentField
+	return activeGroups
+}
 
-		context.setField(field)
-		context.setSource(normalized.Value)
-		context.setValue(normalizedFieldValue)
+func hasScenarios(methodGroups []parser.Methods) bool {
+	for _, methods := range methodGroups {
+		for _, method := range methods {
+			if method.Name == scenarioDirective {
+				return true
+			}
+		}
+	}
+	return false
+}
 
-		var mostRecentErrors core.ErrorList
+func isScenarioActive(context *context, methods parser.Methods) bool {
+	hasScenario := false
 
-		for _, methods := range field.MethodGroups {
-			var errors core.ErrorList
+	for _, method := range methods {
+		if method.Name != scenarioDirective {
+			continue
+		}
 
-			for _, method := range methods {
-				validate, err := context.validator.registry.Get(method.Name)
+		hasScenario = true
 
-				if err != nil {
-					context.errors.AddPlain(err)
-					return
+		for _, arg := range method.Arguments {
+			for _, scenario := range strings.Split(fmt.Sprint(arg), "|") {
+				for _, group := range context.groups {
+					if scenario == group {
+						return true
+					}
 				}
+			}
+		}
+	}
+
+	return !hasScenario
+}
+
+// defaultDirective sets the value of a field if it's nil or zero, before the validators of the field are run,
+// i.e. `default(10),min(1),max(100)`. The field must be addressable, so the value to validate must be passed by pointer.
+const defaultDirective = "default"
+
+func walkApplyDefault(context *context, field *core.ReflectedField, fieldValue reflect.Value) (bool, *core.Error) {
+	for _, methods := range activeMethodGroups(context, field.MethodGroups) {
+		for _, method := range methods {
+			if method.Name != defaultDirective {
+				continue
+			}
+
+			if len(method.Arguments) != 1 {
+				return false, core.NewError(field, method, context.NewError("arguments.singleRequired"))
+			}
+
+			if !fieldValue.IsZero() {
+				return false, nil
+			}
+
+			if field.Unexported {
+				return false, core.NewError(field, method, errors.New("Unable to set default value of unexported field '"+field.Name+"'."))
+			}
+
+			if !fieldValue.CanSet() {
+				return false, core.NewError(field, method, errors.New("Unable to set default value of field '"+field.Name+"', pass the value to validate by pointer."))
+			}
+
+			if err := core.AssignValue(fieldValue, method.Arguments[0]); err != nil {
+				return false, core.NewError(field, method, err)
+			}
+
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// isDirective checks whether name is a directive of the walk, rather than a validator of the registry.
+func isDirective(name string) bool {
+	return name == scenarioDirective || name == defaultDirective || name == core.LabelDirective
+}
+
+// walkValidateField runs the validator groups of a field against the normalized value of the field. If fieldValue can
+// be set, then values of transformers of the group that passed are written back to the field. Values of converters are written back to the
+// field, or to the field of sourceStruct that is their target. Returns true if a field was written.
+func walkValidateField(context *context, field *core.ReflectedField, source interface{}, normalizedFieldValue *core.NormalizedValue, fieldValue reflect.Value, sourceStruct reflect.Value) bool {
+	context.setField(field)
+	context.setSource(source)
+	context.setValue(normalizedFieldValue)
+	context.skipping = false
+
+	var failedGroupErrors core.ErrorList
+	var mostRecentErrors core.ErrorList
+	var mostRecentWarnings core.ErrorList
+	var transformedValue interface{}
+	var transformMethod *parser.Method
+	var convertedValue interface{}
+	var convertMethod *parser.Method
+	var deferred []deferredValue
+	var ran []*ValidatorReport
+	var started time.Time
+
+	if context.hooks != nil {
+		started = time.Now()
+		context.hooks.fieldStart(FieldEvent{Path: field.FullName(), Field: field})
+	}
+
+	// Groups are alternatives, i.e. `empty|email`. The first group to pass makes the field valid. If all groups
+	// fail, then the errors of the last group are reported with the errors of the other groups as alternatives.
+	for i, methods := range activeMethodGroups(context, field.MethodGroups) {
+		var errors core.ErrorList
+		var warnings core.ErrorList
 
-				if err = validate(context, method.Arguments); err != nil {
-					errors.Add(core.NewError(field, method, err))
+		if i > 0 {
+			// Validators may change the value, so restore it for each group.
+			context.setValue(normalizedFieldValue)
+			failedGroupErrors.AddMany(mostRecentErrors)
+			transformedValue = nil
+			convertedValue = nil
+			deferred = nil
+		}
+
+		for _, method := range methods {
+			if isDirective(method.Name) {
+				continue
+			}
+
+			var validatorReport *ValidatorReport
+			var validatorStarted time.Time
+
+			if context.hooks != nil {
+				validatorStarted = time.Now()
+			}
+
+			if context.report != nil {
+				validatorReport = &ValidatorReport{Name: method.Name, method: method}
+				ran = append(ran, validatorReport)
+			}
+
+			validate, batch, err := context.validator.registry.Lookup(method.Name)
+
+			if err != nil {
+				fieldErr := core.NewError(field, method, err)
+				validatorReport.fail(fieldErr)
+				context.fieldError(field, fieldErr, validatorStarted)
+				errors.Add(fieldErr)
+				continue
+			}
+
+			if batch != nil {
+				// The batch is reported once it has run.
+				validatorReport.skip()
+				deferred = append(deferred, deferredValue{validate: batch, method: method, value: context.Value(), report: validatorReport})
+				continue
+			}
+
+			context.setNamedArguments(method.NamedArguments)
+
+			if context.isNil && !context.validator.registry.HandlesNil(method.Name) {
+				if context.nilPolicy == core.SkipNil {
+					validatorReport.skip()
+					continue
 				}
+				err = validateNil(context, validate, method)
+			} else if context.memo != nil && context.validator.registry.IsPure(method.Name) {
+				err = context.memo.validate(context, method, func() error {
+					return negate(context, method, validateValue(context, validate, method.Arguments))
+				})
+			} else {
+				err = negate(context, method, validateValue(context, validate, method.Arguments))
 			}
 
-			mostRecentErrors = errors
+			if err != nil {
+				fieldErr := core.NewError(field, method, err)
+				fieldErr.SetValue(context.Value())
+				validatorReport.fail(fieldErr)
+				context.fieldError(field, fieldErr, validatorStarted)
 
-			if !errors.Any() {
+				// Warnings are reported, but don't fail the group.
+				if context.validator.registry.IsWarning(method.Name) {
+					fieldErr.SetSeverity(core.SeverityWarning)
+					warnings.Add(fieldErr)
+				} else {
+					errors.Add(fieldErr)
+				}
+			} else if context.validator.registry.IsTransformer(method.Name) {
+				transformedValue = context.Value()
+				transformMethod = method
+			} else if context.validator.registry.IsConverter(method.Name) {
+				convertedValue = context.Value()
+				convertMethod = method
+			}
+
+			if context.skipping {
 				break
 			}
 		}
 
-		if mostRecentErrors.Any() {
-			context.errors.AddMany(mostRecentErrors)
+		mostRecentErrors = errors
+		mostRecentWarnings = warnings
+
+		// Skipping the field also skips the remaining groups, whether or not the group has failed.
+		if !errors.Any() || context.skipping {
+			break
+		}
+	}
+
+	// Batch validators only run for the values of the group that passed.
+	if !mostRecentErrors.Any() {
+		for _, value := range deferred {
+			context.deferBatch(value, field)
+		}
+	}
+
+	written := false
+
+	// Transformed values of fields that failed are not written, so that they keep the value that was validated.
+	if transformedValue != nil && !mostRecentErrors.Any() {
+		if fieldValue.CanSet() {
+			if err := core.AssignValue(fieldValue, transformedValue); err != nil {
+				context.errors.AddPlain(err)
+			} else {
+				written = true
+			}
+		} else if fieldValue.IsValid() {
+			context.addWarnings(core.ErrorList{unwritableError(field, transformMethod)})
+		}
+	}
+
+	// Converted values of fields that failed are not written.
+	if convertedValue != nil && !context.isNil && !mostRecentErrors.Any() {
+		if walkWriteConverted(context, field, convertMethod, convertedValue, normalizedFieldValue.Value, fieldValue, sourceStruct) {
+			written = true
+		}
+	}
+
+	if mostRecentErrors.Any() {
+		if failedGroupErrors.Any() {
+			for _, err := range mostRecentErrors {
+				err.SetAlternatives(failedGroupErrors)
+			}
+		}
+		context.errors.AddMany(context.sample(mostRecentErrors, false))
+	}
+
+	if mostRecentWarnings != nil {
+		context.addWarnings(context.sample(mostRecentWarnings, true))
+	}
+
+	if context.hooks != nil {
+		context.hooks.fieldDone(FieldEvent{
+			Path:     field.FullName(),
+			Field:    field,
+			Duration: time.Since(started),
+			Errors:   mostRecentErrors,
+		})
+	}
+
+	if context.report != nil {
+		context.report.addField(field, ran)
+	}
+
+	return written
+}
+
+// walkWriteConverted writes the converted value of a field to the field named by the `target` argument of the
+// converter, or to the field itself if it's of the converted type and the value was changed by the converter. Returns
+// true if a field was written.
+func walkWriteConverted(context *context, field *core.ReflectedField, method *parser.Method, value interface{}, original interface{}, fieldValue reflect.Value, sourceStruct reflect.Value) bool {
+	target := fieldValue
+
+	if targetName, ok := method.NamedArguments["target"].(string); ok {
+		// Fields of values that aren't structs, i.e. of ValidateValue, have no target.
+		if !sourceStruct.IsValid() {
+			return false
 		}
 
-		if canWalk(normalizedFieldValue.OriginalKind) {
-			walkValidate(context, normalizedFieldValue, field)
+		if target = sourceStruct.FieldByName(targetName); !target.IsValid() {
+			context.errors.Add(core.NewError(field, method, errors.New("Target field '"+targetName+"' of field '{field}' does not exist.")))
+			return false
 		}
+	} else if !target.IsValid() || reflect.DeepEqual(value, original) || !isConvertedType(target.Type(), value) {
+		return false
 	}
+
+	if !target.CanSet() {
+		context.addWarnings(core.ErrorList{unwritableError(field, method)})
+		return false
+	}
+
+	if err := core.AssignValue(target, value); err != nil {
+		context.errors.Add(core.NewError(field, method, err))
+		return false
+	}
+
+	return true
 }
 
-func walkValidate(context *context, value interface{}, parentField *core.ReflectedField) {
-	var normalized *core.NormalizedValue
+// isConvertedType checks whether fields of a type hold values of the type of a converted value, i.e. an int field
+// for an int64. Pointers are checked by the types they point to.
+func isConvertedType(reflectedType reflect.Type, value interface{}) bool {
+	for reflectedType.Kind() == reflect.Ptr {
+		reflectedType = reflectedType.Elem()
+	}
 
-	if typedValue, ok := value.(*core.NormalizedValue); ok {
-		normalized = typedValue
-	} else {
-		var err error
-		normalized, err = core.Normalize(value)
-		if err != nil {
-			context.errors.AddPlain(err)
+	switch value.(type) {
+	case int64:
+		return reflectedType.Kind() >= reflect.Int && reflectedType.Kind() <= reflect.Uint64
+	case float64:
+		return reflectedType.Kind() == reflect.Float32 || reflectedType.Kind() == reflect.Float64
+	case bool:
+		return reflectedType.Kind() == reflect.Bool
+	default:
+		return reflect.TypeOf(value).AssignableTo(reflectedType)
+	}
+}
+
+// unwritableError returns a warning for a transformed value of a field that can't be written back, because the field
+// is unexported or the value to validate wasn't passed by pointer.
+func unwritableError(field *core.ReflectedField, method *parser.Method) *core.Error {
+	message := "Unable to write transformed value of field '" + field.FullName() + "', pass the value to validate by pointer."
+
+	if field.Unexported {
+		message = "Unable to write transformed value of unexported field '" + field.FullName() + "'."
+	}
+
+	warning := core.NewError(field, method, errors.New(message))
+	warning.SetSeverity(core.SeverityWarning)
+
+	return warning
+}
+
+// validateValue runs a validator against the value of the context, or its textual form if the validator doesn't
+// support the type of the value.
+func validateValue(context *context, validate core.ValidatorFn, args []interface{}) error {
+	err := validate(context, args)
+
+	if err != nil && context.originalKind != reflect.String {
+		err = validateText(context, validate, args, err)
+	}
+
+	return err
+}
+
+// validateText runs a validator that doesn't support the type of a value again with the textual form of the value,
+// if it implements encoding.TextMarshaler or fmt.Stringer, i.e. `email` of a custom address type. Returns err if the
+// validator doesn't support the text either.
+func validateText(context *context, validate core.ValidatorFn, args []interface{}, err error) error {
+	if messageErr, ok := err.(*core.MessageError); !ok || messageErr.Key != "type.unsupported" || context.isNil {
+		return err
+	}
+
+	text, ok := core.TextOf(context.value)
+
+	if !ok {
+		return err
+	}
+
+	value, originalKind := context.value, context.originalKind
+	context.value, context.originalKind = text, reflect.String
+	textErr := validate(context, args)
+	context.value, context.originalKind = value, originalKind
+
+	if messageErr, ok := textErr.(*core.MessageError); ok && messageErr.Key == "type.unsupported" {
+		return err
+	}
+
+	return textErr
+}
+
+// validateNil runs a validator that doesn't handle nil values itself against a nil value, according to the nil
+// policy of the context. Nil values fail the core.FailNil policy even if the method is negated.
+func validateNil(context *context, validate core.ValidatorFn, method *parser.Method) error {
+	if context.nilPolicy == core.FailNil {
+		return context.NewError("nil.cannotBeNil")
+	}
+
+	// Nil values are normalized to the zero value of their type.
+	context.isNil = false
+	err := validate(context, method.Arguments)
+	context.isNil = true
+
+	return negate(context, method, err)
+}
+
+var structHookMethod = &parser.Method{Name: "ValidateStruct"}
+
+// walkValidateStructHook calls ValidateStruct on structures that implement core.Validatable.
+func walkValidateStructHook(context *context, normalized core.NormalizedValue, sourceStruct reflect.Value, parentField *core.ReflectedField) {
+	validatable, ok := normalized.Value.(core.Validatable)
+
+	if !ok {
+		// The normalized value is never a pointer, so use the addressable source or make an addressable copy in order
+		// to find pointer receiver methods.
+		ptr := reflect.New(reflect.TypeOf(normalized.Value))
+
+		if sourceStruct.CanAddr() {
+			ptr = sourceStruct.Addr()
+		} else {
+			ptr.Elem().Set(reflect.ValueOf(normalized.Value))
 		}
+
+		if validatable, ok = ptr.Interface().(core.Validatable); !ok {
+			return
+		}
+	}
+
+	context.setField(parentField)
+	context.setSource(normalized.Value)
+	context.setValue(&normalized)
+	context.setNamedArguments(nil)
+
+	if err := validatable.ValidateStruct(context); err != nil {
+		context.errors.Add(core.NewError(parentField, structHookMethod, err))
 	}
+}
+
+// walkValidate validates value. The reflected value is the value before normalization, which allows fields of
+// addressable structures to be modified.
+func walkValidate(context *context, value interface{}, reflected reflect.Value, parentField *core.ReflectedField) {
+	normalized, err := core.Normalize(value)
+
+	if err != nil {
+		context.errors.AddPlain(err)
+		return
+	}
+
+	walkValidateNormalized(context, *normalized, reflected, parentField)
+}
+
+// walkValidateReflected validates a reflected value, i.e. an item of an array or map.
+func walkValidateReflected(context *context, reflected reflect.Value, parentField *core.ReflectedField) {
+	normalized, err := context.validator.registry.Normalize(reflected)
+
+	if err != nil {
+		context.errors.AddPlain(err)
+		return
+	}
+
+	// Values of types with adapters may no longer be walkable.
+	if canWalk(normalized.OriginalKind) {
+		walkValidateNormalized(context, normalized, reflected, parentField)
+	}
+}
 
+func walkValidateNormalized(context *context, normalized core.NormalizedValue, reflected reflect.Value, parentField *core.ReflectedField) {
 	switch normalized.OriginalKind {
 	case reflect.Array, reflect.Slice:
-		walkValidateArray(context, normalized, parentField)
+		walkValidateArray(context, normalized, reflected, parentField)
 	case reflect.Map:
-		walkValidateMap(context, normalized, parentField)
+		walkValidateMap(context, normalized, reflected, parentField)
 	case reflect.Struct:
 		if !normalized.IsNil {
-			walkValidateStruct(context, normalized, parentField)
+			walkValidateStruct(context, normalized, reflected, parentField)
 		}
 	default:
-		context.errors.AddPlain(errors.New("Unable to directly validate type '" + normalized.OriginalKind.String() + "'."))
+		context.errors.AddPlain(core.NewInputError(normalized.OriginalKind))
 	}
 }
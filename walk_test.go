@@ -1,9 +1,16 @@
 package validator_test
 
 import (
+	"errors"
+	"fmt"
 	. "github.com/typerandom/validator"
+	"github.com/typerandom/validator/core"
+	"math"
+	"net"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func testThatValidatorCanWalkItems(t *testing.T, items interface{}, numItems int) {
@@ -106,6 +113,1006 @@ func TestThatValidatorCannotWalkBool(t *testing.T) {
 	testThatValidatorCannotWalkValue(t, false, "bool")
 }
 
-func TestThatValidatorCannotWalkInvalid(t *testing.T) {
-	testThatValidatorCannotWalkValue(t, nil, "invalid")
+func TestThatValidatorCannotWalkNil(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty"`
+	}
+
+	var dummy *Dummy
+
+	for _, value := range []interface{}{nil, dummy, &dummy} {
+		errs := Validate(value)
+
+		if len(errs) != 1 || !errors.Is(errs, ErrNilValue) {
+			t.Fatalf("Expected nil value error for %T, got %v.", value, errs)
+		}
+	}
+}
+
+func TestThatValidatorCannotWalkValuesThatArentStructs(t *testing.T) {
+	number := 123
+
+	for _, value := range []interface{}{"test", number, &number, 1.5, true, make(chan int), func() {}} {
+		errs := Validate(value)
+
+		if len(errs) != 1 || !errors.Is(errs, ErrNotAStruct) {
+			t.Fatalf("Expected not a struct error for %T, got %v.", value, errs)
+		}
+	}
+}
+
+func TestThatSyntaxOfValuesThatArentStructsCantBeChecked(t *testing.T) {
+	if err := CheckSyntax(nil); err != ErrNilValue {
+		t.Fatalf("Expected nil value error, got %v.", err)
+	}
+
+	if err := CheckSyntax(123); !errors.Is(err, ErrNotAStruct) {
+		t.Fatalf("Expected not a struct error, got %v.", err)
+	}
+
+	if _, err := Compile("test"); !errors.Is(err, ErrNotAStruct) {
+		t.Fatalf("Expected not a struct error, got %v.", err)
+	}
+}
+
+func TestThatValidatorReportsFullPathOfSharedNestedStructTypes(t *testing.T) {
+	type Address struct {
+		City string `validate:"not_empty"`
+	}
+
+	type Dummy struct {
+		Home Address
+		Work Address
+	}
+
+	errs := Validate(&Dummy{})
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", len(errs))
+	}
+
+	if errs[0].GetFieldName() != "Home.City" || errs[1].GetFieldName() != "Work.City" {
+		t.Fatalf("Expected errors for 'Home.City' and 'Work.City', got '%s' and '%s'.", errs[0].GetFieldName(), errs[1].GetFieldName())
+	}
+}
+
+func TestThatValidatorCanValidateAllNumericWidths(t *testing.T) {
+	type Dummy struct {
+		Int8    int8    `validate:"min(10),max(20)"`
+		Int16   int16   `validate:"min(10),max(20)"`
+		Int32   int32   `validate:"min(10),max(20)"`
+		Uint    uint    `validate:"min(10),max(20)"`
+		Uint8   uint8   `validate:"min(10),max(20)"`
+		Uint16  uint16  `validate:"min(10),max(20)"`
+		Uint32  uint32  `validate:"min(10),max(20)"`
+		Uint64  uint64  `validate:"min(10),max(20)"`
+		Float32 float32 `validate:"min(10),max(20)"`
+	}
+
+	if errs := Validate(&Dummy{15, 15, 15, 15, 15, 15, 15, 15, 15}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	dummy := Dummy{}
+	errs := Validate(&dummy)
+
+	if totalFields := reflect.TypeOf(dummy).NumField(); len(errs) != totalFields {
+		t.Fatalf("Expected %d errors, got %d.", totalFields, len(errs))
+	}
+}
+
+func TestThatValidatorCanValidateUInt64AboveInt64Range(t *testing.T) {
+	type Dummy struct {
+		Value uint64 `validate:"not_empty,numeric,min(10),max(20),not(10)"`
+	}
+
+	errs := Validate(&Dummy{Value: math.MaxUint64})
+
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d.", len(errs))
+	}
+
+	if errs.First().Error() != "Value cannot be greater than 20." {
+		t.Fatalf("Expected cannot be greater than error, got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorCanValidatePointerFields(t *testing.T) {
+	type Inner struct {
+		Value string `validate:"not_empty"`
+	}
+
+	type Dummy struct {
+		String     *string  `validate:"not_empty"`
+		DeepInt    **int    `validate:"not_empty"`
+		Struct     *Inner   `validate:"not_empty"`
+		Optional   *Inner   `validate:"nil|not_empty"`
+		DeepStruct **Inner  `validate:"not_empty"`
+		Slice      *[]Inner `validate:"not_empty"`
+	}
+
+	errs := Validate(&Dummy{})
+
+	if totalFields := reflect.TypeOf(Dummy{}).NumField() - 1; len(errs) != totalFields {
+		t.Fatalf("Expected %d errors, got %d.", totalFields, len(errs))
+	}
+
+	for _, err := range errs {
+		if err.GetValidatorName() != "not_empty" {
+			t.Fatalf("Expected not empty error, got %s.", err)
+		}
+	}
+
+	stringValue := "test"
+	intValue := 10
+	intPtr := &intValue
+	inner := &Inner{Value: "test"}
+	slice := []Inner{{Value: "test"}, {}}
+
+	errs = Validate(&Dummy{
+		String:     &stringValue,
+		DeepInt:    &intPtr,
+		Struct:     &Inner{},
+		DeepStruct: &inner,
+		Slice:      &slice,
+	})
+
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", len(errs))
+	}
+
+	if errs[0].GetFieldName() != "Struct.Value" || errs[1].GetFieldName() != "Slice.Value" {
+		t.Fatalf("Expected errors for 'Struct.Value' and 'Slice.Value', got '%s' and '%s'.", errs[0].GetFieldName(), errs[1].GetFieldName())
+	}
+}
+
+func TestThatValidatorCanValidateNilPointerInDeepPointerField(t *testing.T) {
+	type Dummy struct {
+		Value **int `validate:"nil"`
+	}
+
+	var intPtr *int
+
+	if errs := Validate(&Dummy{Value: &intPtr}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+type walkCents int64
+
+type walkMoney struct {
+	cents walkCents
+}
+
+func (this walkMoney) ValidatableValue() interface{} {
+	return this.cents
+}
+
+func TestThatValidatorCanValidateCustomTypes(t *testing.T) {
+	type UserId string
+
+	type Dummy struct {
+		Id     UserId     `validate:"not_empty,min(3)"`
+		Amount walkCents  `validate:"min(100)"`
+		Price  walkMoney  `validate:"min(100)"`
+		Tip    *walkMoney `validate:"nil|min(1)"`
+	}
+
+	if errs := Validate(&Dummy{Id: "abc", Amount: 100, Price: walkMoney{cents: 100}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := Validate(&Dummy{Id: "a", Amount: 99, Price: walkMoney{cents: 99}, Tip: &walkMoney{}}); len(errs) != 4 {
+		t.Fatalf("Expected 4 errors, got %d.", len(errs))
+	}
+}
+
+func TestThatScenarioGroupsAreOnlyValidatedWhenSelected(t *testing.T) {
+	type Dummy struct {
+		Id   int64  `validate:"empty,scenario(create)|min(1),scenario(update)"`
+		Name string `validate:"not_empty"`
+	}
+
+	if errs := Validate(&Dummy{Id: 5, Name: "John"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if errs := Validate(&Dummy{Id: 5, Name: "John"}, Group("create")); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := Validate(&Dummy{Id: 0, Name: "John"}, Group("update")); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := Validate(&Dummy{Id: 5, Name: "John"}, Group("update")); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatScenarioSupportsMultipleScenarios(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"not_empty,scenario(create|update)"`
+	}
+
+	for _, group := range []string{"create", "update"} {
+		if errs := Validate(&Dummy{}, Group(group)); errs.Length() != 1 {
+			t.Fatalf("Expected 1 error for '%s', got %d.", group, errs.Length())
+		}
+	}
+
+	if errs := Validate(&Dummy{}, Group("delete")); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatFailFastStopsAtFirstError(t *testing.T) {
+	type Dummy struct {
+		ValueA string `validate:"not_empty,min(3)"`
+		ValueB string `validate:"not_empty"`
+	}
+
+	errs := Validate([]*Dummy{{}, {}}, FailFast())
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs.First().GetFieldName() != "[0].ValueA" {
+		t.Fatalf("Expected error for '[0].ValueA', got '%s'.", errs.First().GetFieldName())
+	}
+}
+
+func TestThatMaxErrorsLimitsNumberOfErrors(t *testing.T) {
+	type Dummy struct {
+		ValueA string `validate:"not_empty"`
+		ValueB string `validate:"not_empty"`
+	}
+
+	if errs := Validate([]*Dummy{{}, {}}, MaxErrors(3)); errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	if errs := Validate([]*Dummy{{}, {}}, MaxErrors(0)); errs.Length() != 4 {
+		t.Fatalf("Expected 4 errors, got %d.", errs.Length())
+	}
+}
+
+func TestThatDefaultIsAssignedToZeroFields(t *testing.T) {
+	type Dummy struct {
+		Limit   int64   `validate:"default(10),min(1),max(100)"`
+		Sort    string  `validate:"default(name)"`
+		Enabled *bool   `validate:"default(true)"`
+		Other   float64 `validate:"default(1.5)"`
+	}
+
+	dummy := &Dummy{Other: 3}
+
+	if errs := Validate(dummy); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if dummy.Limit != 10 || dummy.Sort != "name" || dummy.Enabled == nil || !*dummy.Enabled || dummy.Other != 3 {
+		t.Fatalf("Expected defaults to be assigned, got %+v.", dummy)
+	}
+}
+
+func TestThatDefaultIsValidated(t *testing.T) {
+	type Dummy struct {
+		Limit int64 `validate:"default(200),max(100)"`
+	}
+
+	if errs := Validate(&Dummy{}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatDefaultRequiresAddressableValue(t *testing.T) {
+	type Dummy struct {
+		Limit int64 `validate:"default(10)"`
+	}
+
+	if errs := Validate(Dummy{}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := Validate([]Dummy{{}}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+}
+
+func TestThatTransformedValuesAreWrittenBack(t *testing.T) {
+	type Dummy struct {
+		Name  string  `validate:"trim,lower,min(3)"`
+		Code  *string `validate:"trim,upper,truncate(2)"`
+		Count string  `validate:"trim,numeric"`
+	}
+
+	code := " abc "
+	dummy := &Dummy{Name: "  JOHN ", Code: &code, Count: " 12.50 "}
+
+	if errs := Validate(dummy); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	if dummy.Name != "john" || *dummy.Code != "AB" || dummy.Count != "12.50" {
+		t.Fatalf("Expected transformed values, got %+v (%s).", dummy, *dummy.Code)
+	}
+}
+
+func TestThatTransformedValuesAreValidated(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"trim,min(3)"`
+	}
+
+	if errs := Validate(Dummy{Name: " ab "}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatTransformedValuesOfFailedFieldsAreNotWrittenBack(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"trim,lower,min(3)"`
+	}
+
+	dummy := &Dummy{Name: " AB "}
+
+	if errs := Validate(dummy); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if dummy.Name != " AB " {
+		t.Fatalf("Expected name to be ' AB ', got '%s'.", dummy.Name)
+	}
+}
+
+func TestThatConvertedValuesAreWrittenToTargetFields(t *testing.T) {
+	type Dummy struct {
+		AgeText   string `validate:"to_int(target=Age),min(18)"`
+		PriceText string `validate:"to_float(target=Price)"`
+		Active    string `validate:"to_bool(target=Enabled)"`
+		Date      string `validate:"to_time(DateOnly, target=At)"`
+		Age       int
+		Price     float32
+		Enabled   *bool
+		At        time.Time
+	}
+
+	dummy := &Dummy{AgeText: "21", PriceText: "9.5", Active: "true", Date: "2015-01-02"}
+
+	if errs := Validate(dummy); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+
+	if dummy.Age != 21 || dummy.Price != 9.5 || dummy.Enabled == nil || !*dummy.Enabled || !dummy.At.Equal(time.Date(2015, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected converted values, got %+v.", dummy)
+	}
+
+	if dummy.AgeText != "21" || dummy.PriceText != "9.5" || dummy.Active != "true" || dummy.Date != "2015-01-02" {
+		t.Fatalf("Expected unchanged text fields, got %+v.", dummy)
+	}
+}
+
+func TestThatConvertedValuesOfFailedFieldsAreNotWritten(t *testing.T) {
+	type Dummy struct {
+		AgeText string `validate:"to_int(target=Age),min(18)"`
+		Age     int
+	}
+
+	for _, ageText := range []string{"12", "abc"} {
+		dummy := &Dummy{AgeText: ageText}
+
+		if errs := Validate(dummy); !errs.Any() {
+			t.Fatalf("Expected error, didn't get any.")
+		}
+
+		if dummy.Age != 0 {
+			t.Fatalf("Expected unwritten target field, got %d.", dummy.Age)
+		}
+	}
+}
+
+func TestThatConvertedValuesFailForMissingTargetFields(t *testing.T) {
+	type Dummy struct {
+		AgeText string `validate:"to_int(target=Age)"`
+	}
+
+	errs := Validate(&Dummy{AgeText: "12"})
+
+	if expected := "Target field 'Age' of field 'AgeText' does not exist."; errs.Length() != 1 || errs.First().Error() != expected {
+		t.Fatalf("Expected error '%s', got %s.", expected, errs)
+	}
+}
+
+func TestThatValidatorWarnsOfConvertedValuesOfStructsPassedByValue(t *testing.T) {
+	type Dummy struct {
+		AgeText string `validate:"to_int(target=Age)"`
+		Age     int
+	}
+
+	if errs := Validate(Dummy{AgeText: "12"}); errs.Any() || errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 warning, got %s.", errs)
+	}
+}
+
+func TestThatCollectionFieldsAreValidatedByLength(t *testing.T) {
+	type Dummy struct {
+		Tags    []string       `validate:"min(1),max(3)"`
+		Scores  map[string]int `validate:"not_empty"`
+		Fixed   [2]string      `validate:"min(2)"`
+		Aliases *[]string      `validate:"min(1)"`
+	}
+
+	aliases := []string{"a"}
+
+	if errs := Validate(&Dummy{Tags: []string{"a"}, Scores: map[string]int{"a": 1}, Aliases: &aliases}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs.First())
+	}
+
+	errs := Validate(&Dummy{Tags: []string{"a", "b", "c", "d"}})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"Tags", "Scores", "Aliases"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+}
+
+type embeddedBase struct {
+	Id int `validate:"min(1)"`
+}
+
+type embeddedAudit struct {
+	CreatedBy string `validate:"not_empty"`
+}
+
+type embeddingDummy struct {
+	embeddedBase
+	*embeddedAudit
+	Name string `validate:"not_empty"`
+}
+
+func TestThatEmbeddedStructsAreValidatedWithNestedNames(t *testing.T) {
+	errs := Validate(&embeddingDummy{embeddedAudit: &embeddedAudit{}})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"embeddedBase.Id", "embeddedAudit.CreatedBy", "Name"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+}
+
+func TestThatEmbeddedStructsAreValidatedWithPromotedNames(t *testing.T) {
+	errs := Validate(&embeddingDummy{embeddedAudit: &embeddedAudit{}}, FlattenEmbedded())
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"Id", "CreatedBy", "Name"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+
+	if message := errs.WithField("Id").First().Error(); message != "Id cannot be less than 1." {
+		t.Fatalf("Expected promoted name in message, got '%s'.", message)
+	}
+}
+
+func TestThatNilEmbeddedStructsAreSkipped(t *testing.T) {
+	errs := Validate(embeddingDummy{embeddedBase: embeddedBase{Id: 1}, Name: "Jane"})
+
+	if errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+}
+
+func TestThatFieldsOfEmbeddedStructsCanBeSelected(t *testing.T) {
+	errs := ValidateFields(&embeddingDummy{}, "embeddedBase.Id")
+
+	if errs.Length() != 1 || errs.First().GetFieldName() != "embeddedBase.Id" {
+		t.Fatalf("Expected error for 'embeddedBase.Id', got %v.", errs.ByField())
+	}
+}
+
+func TestThatPromotedFieldsAreSiblings(t *testing.T) {
+	type Credentials struct {
+		Password string
+	}
+
+	type Dummy struct {
+		Credentials
+		Confirmation string `validate:"eqfield(Password)"`
+	}
+
+	if errs := Validate(&Dummy{Credentials{"secret"}, "public"}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	if errs := Validate(&Dummy{Credentials{"secret"}, "secret"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+}
+
+type inheritBase struct {
+	Name  string `validate:"not_empty"`
+	Email string `validate:"email"`
+}
+
+type inheritDummy struct {
+	inheritBase
+	Name  string `validate:"inherit,max(5)"`
+	Email string
+}
+
+func TestThatFieldsCanInheritRulesOfHiddenFields(t *testing.T) {
+	errs := Validate(&inheritDummy{Name: "Jonathan", Email: "invalid"})
+
+	if errs.Length() != 1 || errs.WithField("Name").WithValidator("max").Length() != 1 {
+		t.Fatalf("Expected error for 'Name', got %v.", errs.ByField())
+	}
+
+	if errs := Validate(&inheritDummy{}); errs.WithField("Name").WithValidator("not_empty").Length() != 1 {
+		t.Fatalf("Expected inherited error for 'Name', got %v.", errs.ByField())
+	}
+
+	if errs := Validate(&inheritDummy{Name: "Jane"}); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+}
+
+func TestThatFieldsWithoutRuleToInheritCannotBeValidated(t *testing.T) {
+	type Dummy struct {
+		inheritBase
+		Phone string `validate:"inherit"`
+	}
+
+	errs := Validate(&Dummy{})
+
+	if !errs.Any() || !strings.Contains(errs.First().Error(), "hides no field of an embedded struct") {
+		t.Fatalf("Expected inherit error, got %v.", errs)
+	}
+}
+
+type walkShape interface {
+	Area() int
+}
+
+type walkSquare struct {
+	Side int `validate:"min(1)"`
+}
+
+func (this *walkSquare) Area() int {
+	return this.Side * this.Side
+}
+
+func TestThatInterfaceFieldsAreValidatedByConcreteType(t *testing.T) {
+	type Dummy struct {
+		Shape  walkShape
+		Any    interface{}
+		Nil    walkShape
+		NilPtr walkShape
+	}
+
+	var nilSquare *walkSquare
+
+	errs := Validate(&Dummy{Shape: &walkSquare{}, Any: walkSquare{}, NilPtr: nilSquare})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"Shape.Side", "Any.Side"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+}
+
+func TestThatInterfaceItemsAreValidatedByConcreteType(t *testing.T) {
+	type Dummy struct {
+		Shapes []walkShape
+		Values map[string]interface{}
+	}
+
+	errs := Validate(&Dummy{
+		Shapes: []walkShape{&walkSquare{Side: 1}, &walkSquare{}, nil},
+		Values: map[string]interface{}{"square": walkSquare{}, "number": 5, "nil": nil},
+	})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"Shapes.Side", "Values.Side"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+}
+
+type walkNode struct {
+	Name     string `validate:"not_empty"`
+	Parent   *walkNode
+	Children []*walkNode
+}
+
+func TestThatCyclesAreValidatedOnce(t *testing.T) {
+	root := &walkNode{}
+	child := &walkNode{Parent: root}
+	root.Children = []*walkNode{child, root}
+
+	errs := Validate(root)
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+
+	for _, fieldName := range []string{"Name", "Children.Name"} {
+		if errs.WithField(fieldName).Length() != 1 {
+			t.Fatalf("Expected error for '%s', got %v.", fieldName, errs.ByField())
+		}
+	}
+}
+
+func TestThatSharedValuesAreValidatedOnEachPath(t *testing.T) {
+	shared := &walkNode{}
+	root := &walkNode{Name: "root", Children: []*walkNode{{Name: "a", Parent: shared}, {Name: "b", Parent: shared}}}
+
+	if errs := Validate(root); errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d.", errs.Length())
+	}
+}
+
+func TestThatCyclicMapsAreValidatedOnce(t *testing.T) {
+	values := map[string]interface{}{"node": &walkNode{}}
+	values["self"] = values
+
+	if errs := Validate(values); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+}
+
+func TestThatMaxDepthLimitsNestedStructs(t *testing.T) {
+	root := &walkNode{Name: "root"}
+	node := root
+
+	for i := 0; i < 5; i++ {
+		child := &walkNode{Name: "child"}
+		node.Children = []*walkNode{child}
+		node = child
+	}
+
+	if errs := Validate(root); errs.Any() {
+		t.Fatalf("Didn't expect error, got %s.", errs)
+	}
+
+	// Each child is nested in a struct and a slice.
+	errs := Validate(root, MaxDepth(6))
+
+	if errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %d.", errs.Length())
+	}
+
+	depthErr, ok := errs.First().Unwrap().(*LimitExceededError)
+
+	if !ok || depthErr.Limit != DepthLimit {
+		t.Fatalf("Expected depth limit error, got %T.", errs.First().Unwrap())
+	}
+
+	if depthErr.Path != "Children.Children.Children" {
+		t.Fatalf("Expected path 'Children.Children.Children', got '%s'.", depthErr.Path)
+	}
+}
+
+func TestThatMaxDepthLimitsNestedCollections(t *testing.T) {
+	type Dummy struct {
+		Tree interface{}
+	}
+
+	tree := map[string]interface{}{}
+	node := tree
+
+	for i := 0; i < 10; i++ {
+		child := map[string]interface{}{}
+		node["child"] = []interface{}{child}
+		node = child
+	}
+
+	node["item"] = walkDummy{}
+
+	if errs := Validate(&Dummy{Tree: tree}); errs.Length() != 1 {
+		t.Fatalf("Expected 1 error, got %s.", errs)
+	}
+
+	errs := Validate(&Dummy{Tree: tree}, MaxDepth(5))
+
+	var limitErr *LimitExceededError
+
+	if errs.Length() != 1 || !errors.As(errs, &limitErr) {
+		t.Fatalf("Expected limit error, got %s.", errs)
+	}
+
+	if limitErr.Limit != DepthLimit || limitErr.Max != 5 || limitErr.Path != "Tree" {
+		t.Fatalf("Expected depth limit of 5 at 'Tree', got %+v.", limitErr)
+	}
+}
+
+func TestThatValidationStopsAtMaxVisits(t *testing.T) {
+	items := make([]walkDummy, 100)
+
+	errs := Validate(items, MaxVisits(10))
+
+	var limitErr *LimitExceededError
+
+	if !errors.As(errs, &limitErr) || limitErr.Limit != VisitLimit {
+		t.Fatalf("Expected visit limit error, got %s.", errs)
+	}
+
+	// Each item and its field are visited, so 5 items are validated.
+	if errs.Length() != 6 {
+		t.Fatalf("Expected 6 errors, got %d.", errs.Length())
+	}
+
+	if expected := "Maximum of 10 visited fields and items exceeded at '[5]'."; errs[5].Error() != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, errs[5])
+	}
+}
+
+func TestThatMapsWithMoreKeysThanVisitsLeftAreNotValidated(t *testing.T) {
+	items := map[string]walkDummy{"a": {}, "b": {}, "c": {}}
+
+	errs := Validate(items, MaxVisits(2))
+
+	var limitErr *LimitExceededError
+
+	if errs.Length() != 1 || !errors.As(errs, &limitErr) || limitErr.Limit != VisitLimit {
+		t.Fatalf("Expected visit limit error, got %s.", errs)
+	}
+
+	if errs := Validate(items, MaxVisits(6)); errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %s.", errs)
+	}
+}
+
+func TestThatItemsOfCollectionsLargerThanMaxCollectionSizeAreNotValidated(t *testing.T) {
+	type Dummy struct {
+		Items []walkDummy `validate:"max(3)"`
+	}
+
+	errs := Validate(&Dummy{Items: make([]walkDummy, 5)}, MaxCollectionSize(4))
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %s.", errs)
+	}
+
+	if expected := "Maximum collection size of 4 exceeded at 'Items'."; errs[1].Error() != expected {
+		t.Fatalf("Expected '%s', got '%s'.", expected, errs[1])
+	}
+
+	if errs := Validate(&Dummy{Items: make([]walkDummy, 3)}, MaxCollectionSize(4)); errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %s.", errs)
+	}
+}
+
+func TestThatErrorsAreReportedInDeclarationAndTagOrder(t *testing.T) {
+	type Dummy struct {
+		Zeta  string `validate:"not_empty"`
+		Alpha string `validate:"min(3),contains(x)"`
+		Mid   int    `validate:"min(5)"`
+	}
+
+	expected := []string{"Zeta not_empty", "Alpha min", "Alpha contain", "Mid min"}
+
+	for i := 0; i < 20; i++ {
+		var actual []string
+
+		for _, err := range Validate(&Dummy{Alpha: "a"}) {
+			actual = append(actual, err.GetFieldName()+" "+err.GetValidatorName())
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected errors %v, got %v.", expected, actual)
+		}
+	}
+}
+
+func TestThatErrorsOfMapValuesAreReportedInKeyOrder(t *testing.T) {
+	type Dummy struct {
+		Value string `validate:"empty"`
+	}
+
+	dummies := map[interface{}]*Dummy{}
+
+	for _, key := range []interface{}{"d", 2, "a", "c", 10, "b", 3} {
+		dummies[key] = &Dummy{Value: fmt.Sprint(key)}
+	}
+
+	expected := []interface{}{"2", "3", "10", "a", "b", "c", "d"}
+
+	for i := 0; i < 20; i++ {
+		var actual []interface{}
+
+		for _, err := range Validate(dummies) {
+			actual = append(actual, err.Value())
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected errors of values %v, got %v.", expected, actual)
+		}
+	}
+}
+
+type unsupportedDummy struct {
+	Name     string      `validate:"not_empty"`
+	Jobs     chan string `validate:"not_empty"`
+	Callback func()      `validate:"not_empty"`
+	Internal *walkDummy  `validate:"-"`
+	Skipped  string      `validate:"ignore"`
+}
+
+func TestThatValidatorSkipsUnsupportedAndIgnoredFields(t *testing.T) {
+	errs := Validate(&unsupportedDummy{Name: "Jane", Internal: &walkDummy{}})
+
+	if errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+}
+
+func TestThatValidatorCanFailUnsupportedFields(t *testing.T) {
+	validator := New()
+	validator.SetUnsupportedPolicy(core.FailUnsupported)
+
+	errs := validator.Validate(&unsupportedDummy{Name: "Jane", Internal: &walkDummy{}})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d (%s).", errs.Length(), errs)
+	}
+
+	if errs.First().GetFieldName() != "Jobs" || errs.First().Unwrap().Error() != "Unable to validate field 'Jobs' of unsupported kind 'chan'." {
+		t.Fatalf("Expected error of unsupported field 'Jobs', got %s.", errs.First())
+	}
+}
+
+type unexportedDummy struct {
+	Name     string `validate:"not_empty"`
+	code     string `validate:"trim,min(3)"`
+	ümlaut   string `validate:"not_empty"`
+	internal walkDummy
+}
+
+func TestThatValidatorSkipsUnexportedFields(t *testing.T) {
+	if errs := Validate(&unexportedDummy{Name: "Jane"}); errs.Length() != 0 {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+}
+
+func TestThatValidatorCanFailUnexportedFields(t *testing.T) {
+	validator := New()
+	validator.SetUnexportedPolicy(core.FailUnexported)
+
+	errs := validator.Validate(&unexportedDummy{Name: "Jane", code: "abc", ümlaut: "ü"})
+
+	if errs.Length() != 2 {
+		t.Fatalf("Expected 2 errors, got %d (%s).", errs.Length(), errs)
+	}
+
+	if errs.First().GetFieldName() != "code" || errs.First().Unwrap().Error() != "Unable to validate unexported field 'code'." {
+		t.Fatalf("Expected error of unexported field 'code', got %s.", errs.First())
+	}
+}
+
+func TestThatValidatorCanValidateUnexportedFieldsReadOnly(t *testing.T) {
+	validator := New()
+	validator.SetUnexportedPolicy(core.ValidateUnexported)
+
+	errs := validator.Validate(unexportedDummy{Name: "Jane", code: " ab "})
+
+	if errs.Length() != 3 {
+		t.Fatalf("Expected 3 errors, got %d (%s).", errs.Length(), errs)
+	}
+
+	if errs[0].GetFieldName() != "code" || errs[1].GetFieldName() != "ümlaut" || errs[2].GetFieldName() != "internal.Value" {
+		t.Fatalf("Expected errors of 'code', 'ümlaut' and 'internal.Value', got %v.", errs)
+	}
+
+	dummy := &unexportedDummy{Name: "Jane", code: " abc ", ümlaut: "ü", internal: walkDummy{Value: "a"}}
+	errs = validator.Validate(dummy)
+
+	if errs.Any() || errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 warning, got %s.", errs)
+	}
+
+	if expected := "Unable to write transformed value of unexported field 'code'."; errs.First().Unwrap().Error() != expected {
+		t.Fatalf("Expected warning '%s', got '%s'.", expected, errs.First().Unwrap())
+	}
+
+	if dummy.code != " abc " {
+		t.Fatalf("Expected unchanged value, got '%s'.", dummy.code)
+	}
+}
+
+func TestThatValidatorWarnsOfTransformedValuesOfStructsPassedByValue(t *testing.T) {
+	type Dummy struct {
+		Name string `validate:"trim,min(3)"`
+	}
+
+	errs := Validate(Dummy{Name: " abc "})
+
+	if errs.Any() || errs.Warnings().Length() != 1 {
+		t.Fatalf("Expected 1 warning, got %s.", errs)
+	}
+
+	if expected := "Unable to write transformed value of field 'Name', pass the value to validate by pointer."; errs.First().Unwrap().Error() != expected {
+		t.Fatalf("Expected warning '%s', got '%s'.", expected, errs.First().Unwrap())
+	}
+
+	if errs := Validate(&Dummy{Name: " abc "}); errs.Length() != 0 {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+}
+
+func TestThatItemsOfCollectionsAreNamedByIndexOrKey(t *testing.T) {
+	slice := []walkDummy{{Value: "a"}, {}, {}}
+
+	errs := Validate(slice)
+
+	if errs.Length() != 2 || errs[0].GetFieldName() != "[1].Value" || errs[1].GetFieldName() != "[2].Value" {
+		t.Fatalf("Expected errors of '[1].Value' and '[2].Value', got %v.", errs)
+	}
+
+	if errs[0].Error() != "Value cannot be empty." {
+		t.Fatalf("Expected message without index, got '%s'.", errs[0])
+	}
+
+	errs = Validate(map[string]walkDummy{"bob": {}, "alice": {}})
+
+	if errs.Length() != 2 || errs[0].GetFieldName() != "[alice].Value" || errs[1].GetFieldName() != "[bob].Value" {
+		t.Fatalf("Expected errors of '[alice].Value' and '[bob].Value', got %v.", errs)
+	}
+}
+
+func TestThatFieldsOfItemsOfCollectionsCanBeSelected(t *testing.T) {
+	type Dummy struct {
+		ValueA string `validate:"not_empty"`
+		ValueB string `validate:"not_empty"`
+	}
+
+	errs := ValidateFields([]Dummy{{}, {}}, "ValueB")
+
+	if errs.Length() != 2 || errs[0].GetFieldName() != "[0].ValueB" || errs[1].GetFieldName() != "[1].ValueB" {
+		t.Fatalf("Expected errors of '[0].ValueB' and '[1].ValueB', got %v.", errs)
+	}
+}
+
+type textAddress [2]string
+
+func (this textAddress) String() string {
+	return this[0] + "@" + this[1]
+}
+
+func TestThatStringValidatorsValidateTextualFormOfValues(t *testing.T) {
+	type Dummy struct {
+		Address textAddress `validate:"email"`
+		IP      net.IP      `validate:"as_string,min(8)"`
+	}
+
+	if errs := Validate(&Dummy{Address: textAddress{"john", "doe.com"}, IP: net.IPv4(10, 0, 0, 1)}); errs.Any() {
+		t.Fatalf("Didn't expect errors, got %s.", errs)
+	}
+
+	errs := Validate(&Dummy{Address: textAddress{"john", ""}, IP: net.IPv4(1, 1, 1, 1)})
+
+	if errs.Length() != 2 || errs[0].GetValidatorName() != "email" || errs[1].GetValidatorName() != "min" {
+		t.Fatalf("Expected errors of 'email' and 'min', got %s.", errs)
+	}
 }